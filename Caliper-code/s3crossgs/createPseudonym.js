@@ -18,31 +18,38 @@ const { WorkloadModuleBase } = require('@hyperledger/caliper-core');
 
 /**
  * Caliper workload module for calling CreatePseudonym function.
+ * The chaincode stores a pseudonym once and rejects its resubmitted proof, so each proof below is submitted once:
+ * the worker i submits the proofs i, i + totalWorkers, ... and a round can not have more transactions than proofs.
  */
 class CreatePseudonymWorkload extends WorkloadModuleBase {
 
     constructor() {
         super();
         this.s3cProofStrGroup = [
-            'ewogICJDIjogIjBud0pRT0FWOWdNbDJVbDhzVUJZbUl2ODFiaUFud0M0Y3JpRlJwcHZiZlE9IiwKICAiZTAiOiAiOTJkYnBOYXVoQ0VHSlc5VDBQbGpqQksyOHJVYldmZkhiaGNEYW1rMVdzTT0iLAogICJDXyI6IFsKICAgICI0ejk0RmVySWQxUUtOZmVwQVFhV1N1N1o4NjAvTzlISFRnQms4OHlxZzE4PSIsCiAgICAiZ2ZDVDlRWlV1SU4rSXRqb2YwSHR1WWNtZnVoQ25vSTJyUzNud0lpeVd5az0iLAogICAgIjJzdVdTZkVnakNTWk1HOHdUY2hRVFE1c0JUOG9xRnpnZENnSnJselNrQms9IiwKICAgICJ6SndsMjd3aVNmQ25NME9uVEJYS2tNOXJNTndSQzhERDEyajNUS2lrWWpVPSIKICBdLAogICJzIjogWwogICAgIklucEtwYUoyZG5oVXd1TXdBYlB4c1kvOGNXVkpxZTlEdzNuTkZhREoyMWQ2VVlVaEZEZDZOUDBqNGJKM1BRRmxpaHppaURIZFlIUHk2aHkxdkNDU2pnPT0iLAogICAgIkRPQ29nUllLWDVqQWw1RW9Zd2lQS2Z6NDNueTdUVjJzMmdpTjFRK1VxZGVYYVU2TVZwK3ZJbzVhWm1RS1VKT3FjMVNQZzRkNHUrUU13N29oNHgvUE5BPT0iLAogICAgIkNCazV4dXkrdlROM0FqZjlaN0dJT2puMDZLZUVTRmxJMXdVc0lWRE5BSU1raDRCOGVhUGhyb29RNlVlY2QvajIrVWpSQUlVODVyeTM4SlN3YUVQU2RRPT0iLAogICAgIkVkNW90SFB0bTlEU2kyc1kyR2VHZ3IvYWcwNFJPVXZZTXFrSXY4dFkvMWs9IgogIF0sCiAgIk0iOiAiMTZzSlkxZmpJMTJ1aFIvb2NEUlJtd3dkaHA0RGZhdWJKSGxmV3FnY2h2Yz0iLAogICJDMSI6ICJxV1BaenBnajJQNktzOFVuS0tzaHE4V0p0NXV3YmhOYnpBVml2M3hsWUI0PSIsCiAgIkMyIjogInJERW1kelEzRmJVUkhrL01COG5FU2lualF2V1NYaUQzNnNaRFk4TGJxcjg9IiwKICAiQTEiOiAid2pCU09WYk9kVzhEVVJBa2dGbWxORE94STBFdzJvalJJaVZrSDdxYUwrWT0iLAogICJBXyI6ICIwRUFmVGxPV1VobEpuc2dxbHRMNVZZb0JRbzZWY1ZRc2s1MmYwcHFHaXF3PSIsCiAgImQiOiAiNHZ2Q0NqK21MRk5PaXo2c25rM1E4c204Ykd2MnBaRTE5aU9heisyRE85cz0iLAogICJjIjogInNScW1IVkdKaEEzYnpEOHpTQ3k5bzYrMkNSb1JRY0sybHVGbDVsY09aU1E9IiwKICAic1giOiAiRHFNNGdaY05HcStUR0t2QkJaVVNpWk4rMEV6bXl2S0p1SEUwanhxaENwQmZrYWhGSFJyVnhBU1lTZ1FHa3lkREZNQ2kyajZmQzVWRWkrMGNaVjVtYkE9PSIsCiAgInNZIjogIkh3UUcvWEpYNUd1dTIxTVlSN09wbXlYSUh3S25jRFBrbUEzMmRsRnVXRHpmOWhLNFA5WlQ0MC9NVWdGZko1amV1SGxFS2lvaU50UTNzV2VRTEo4ZDJBPT0iLAogICJzUiI6ICJBbVgveTNtc1pEZDhCUml1dkppMTY2WW41WGh0SUIrOW9zTm9qWnRvMGQ3SEcvOE5wRU5IeEVOY2k1aHJNeWZjT0ZHejVQYlRvUVRMTFNDK3dMZGMzZXBKbE5vRGZqbitBS1lnRHRjUWh5d2RndHhuekh2c2VRNHlnK0loWlQrUyIsCiAgInNSMiI6ICJGS3BGQmJXY0tSUDV6aHNJbnRVWnpBOEk4QmloYkVaOGhxaUdFMC9ERmJRdE9HL1JSZDQxZVZHeWdHdmtSbVY0Sk5SSXYvTHVPcEVUSE96VXJBMU9QQT09IiwKICAic1IzIjogIkRRZktwNzZ2akJoWTR1M1hwaExHcWx0eXFSM3ppckZ0dEVsb3UrbXBzaUdrL2prNzMzVzYxM2dmckdiRVhUQnhSRkRmNmd5V0RuRVFURy9aNUZkazZBPT0iLAogICJzUyI6ICItOTIyMDcyMTA4ODU0MDM1OTM5MDE5MzgzODA5ODE3MDAzMjM4NzcwMjQ3NzAwOTA2MTgyNDkxMjMzMDE4NjY4MTU0MjgwMDE3MTE1ODkzMDgwODg2MzgwMzE4MTY3Mzg5MzQ1NzEwNzc1ODM4NDc1MTI3Mzc5ODMxMDIzMTE3NDY1Mjg5MjU4Mzk3NDIzMjEyNzM4NjIwMjE2NTA0NzM4NTAyMzQ0OTQyODM3MDE5NjQxODU1NzQ0MjY4ODQ5MDQ0MzAzOTE5OTc4NTAxNzYxNTA2ODk3MDk2OTg1MDE0MTU2NTI4OCIsCiAgImNwIjogImZIRjZCMmZNY0oyL0RxaHpXVzZWRjdGVWNyVndWdUJTcXB5Zml4MnZwMVU9IiwKICAic1lQIjogIkZjc2J2UFlXejVKQ041Z0JoY05Pc20zcVA1OW5HVlJ4S1JQTC9vOWljSGlGRDN3MlpSQWwraDRsdXJ2QWlXQkRZMm03U3BPMDgwYnZ1VmMrYU13NGdRPT0iLAogICJzVlAiOiAiQTNHNWlpekRTNWdEM2Myek5pU1czeURpems3Y2VWUy9uUWhvZWwrVjk3RnQiLAogICJzUlAiOiAiS3BQbmVkb1NqV0c1c1Q5dnZuaFgrZFVoa2xDTW5PazVXWXZaYi9OWXpVS0lOV3FBYmNnZjVESVZHSFdueTAzd0hJNEE3NTVLN1FUczc4cXpRT2xvQXc9PSIsCiAgInNQUCI6ICJBYTl1Slo2LzlLR1BVM2pDSkN2blpxc0dBbmJpOGdUREJwZ3pCemxkSW1uWjFmOXJlTUJjZk5WUDZHUFB1K0Q2NzNpUGlRWmI3WlBVSGZKTDQxVDhjbi9GQy9EYTZGWE8vbEZHTFB0am9HbUFkbEY2L1RHV1l6L1RrVmdZQ2JKcCIKfQ==',
-            'ewogICJDIjogImxueDRDMy9scGhKRXdRVk1JQThqc09UMHNlZ21kcDlnTkMzT2FKU2NBalE9IiwKICAiZTAiOiAiWHN1NjFwUzlYU0JsQkpmOS9vSjNST1RrcUlVSHFCN0c2cEE1Nkh0N2Ftbz0iLAogICJDXyI6IFsKICAgICI0RUpvZlFVY1hFWWR3eDVWdUJBZE9sbjBVNDZrZzVhK0dzM0ZWRjRqenZRPSIsCiAgICAibGphMCtnWGFSbkN5ODVveDhkVkwvcDhZeGlQZlVNajl0MFFkS0RvOStCWT0iLAogICAgInIrNmU5MjA4ZDJqRHhyUFhkc0daMHJTN0d3dS9wS2ltQjBaVnNmU253bWM9IiwKICAgICJqQmpBRTUyWHRDZGVOaVZIc0Y0b0xRcXB1WXE1TldtUDBnSE00U1E5aE1VPSIKICBdLAogICJzIjogWwogICAgIkFSenpnZVRZNkl2ZzJ2dmcvS0lidFcrT1h4bG9FTm9pU0JFQjlSQU4xcTg4OEJwcjhvRHFTUXBHRHNyM3BmazNONTVoQjRGajhvRG8yc1FabUFWcEN3PT0iLAogICAgIkFaaUJUMGxvTENIZG10QytRNHBER0tnTmhNdlBxZitKSEMwWStnSE1YRkRNMGVUQjNOYTBneWZlTm1GRnZQVHJVbklYTFJLZko0N09jTnpHSHVhWHJnPT0iLAogICAgIkRMeXovZDROMEwwam5GNEpqcWJ4ZFdNMldLWEYwNVgyNXFBOGJ5M2NLK2ZGQnNMbk1TcmRCdnRQYjdMSmdqZDFETGN4eFdNMTd6ZDVlSTBuY0Nzd01BPT0iLAogICAgIkIzV0lkem1JS0J4b1NvZTBPRWhGcXVLTU5KRlpFTXlDelZSWXFPNTBqc2M9IgogIF0sCiAgIk0iOiAiaGhzQlBIcU1wOVhpSGxpZWRBa3Fvb1d3ME4rNkZZWWlqTmJHOVdFNEF3cz0iLAogICJDMSI6ICIxRnRxMStHV3hybldyWUM2RHh3ZlZudUlQOWkwOGFIZy82Z2RlR24vNm1BPSIsCiAgIkMyIjogIm9RbGRhYzFuZkxMVDA0Y1lTREtMQ1FGbG5UWnZaLzQvK0VmT1hzdms5Mmc9IiwKICAiQTEiOiAieHNDbmYwOXpaRWp5akZ0OFYrOWhVcWxDUjVvTmgrRk1OU0FacjBIQk9ERT0iLAogICJBXyI6ICIzT1ViMjlkaTNCZWZUMjlWNTUwSE1YdjVEa29ScGVHK1BuQlpaTER6bXlVPSIsCiAgImQiOiAiejlHNld0WDlxZ3E2ekIxRC9EckkzaHdQZGZFYitaVUt0SldQbzlOcUptWT0iLAogICJjIjogImVvQlc0NmNMUGhiMnRGSzN0b0lLYXJGWEhGdWxQNStkSTIvQ3pRR0ZhOHc9IiwKICAic1giOiAiQ1pHSGNybXJXRU81Mm9sZWFJQzZYZFArS0k2VmZtNnNTNnlOUURlT05ZR0RzV1Jrc0dRRlQ5S093cFIyYXA0emJVNVhHdDVrQUJUaUI2TWpPNUR0bVE9PSIsCiAgInNZIjogIkJkUUo3bjJ0U2NlQUh0OE5ZTVpmZWtzZ3lOd1FtMGJJenQreDFvT0tWSjVvYmR3b0hEQ2VLek5vTXl5cE5iVEFOaGpJanBWZXBmVFFnQktmYzRGVXp3PT0iLAogICJzUiI6ICJBc0hVci85KytzMzZFeXFNVFZJZDUwWDBuOEpzemVIZzAvYjM1OGNlYnJWUWhsc0ZEdnNLcFA4N25BbGZkMnBHOEloSDRHQzZ2V1MzTFpLdWkzTFd1OElqQU9nSElQcmhWVmhXOTdpNGR2SVdkVUlndWtOMFBndnBna2FDZzhaeCIsCiAgInNSMiI6ICJDUE9FdWZ3S3dZTkxhcVNtZ0p4dW83Z09LVTlqcWFXLzM5UHdoMGdZclAvM0M2aVhoSzNjaG5tbUJPOHY2YjVzR2tPeGJwYlppbmh6WU8yODd2VU9KQT09IiwKICAic1IzIjogIkNhTm5GS041ZHZvM2FGUVNjTm5HMkxqVWdNeXJuZUFJMmpCRVM4b3pqSUxkTFpBWWJoZEcxZG1UcmtiUFBMUTZYNjNFblBYaHR3Q3VpL0FsSXFVMFd3PT0iLAogICJzUyI6ICItNDI3MTA5NjQwMzEzNTU1OTM0MzMyOTU3Mjk4NjY1NzQ4NjgyMjM1ODk0NTUxNTM2OTkwNDc5ODQzMjA4OTk2NDI1OTUxNzA1ODQ1OTAyMjI4MjYzNzU1NTM4OTU2MjQ2MzkxNzk2NDczNjkyMTI4ODM2ODI4NDc1MDA0NDYwNDIyNDU4Mzk1MjU2NTEyNjk0MzYxODgxNTE4NzI3NjE4NzU0NDk3NDA1MzQ0NTg4NTk4Mzk0ODY2MzMxMTk5MDM4MjkwNzYxODA1MTc2NzMyOTg1ODY2ODE1MjQxNjIzMjQyMTg0MSIsCiAgImNwIjogIkFYRC9NRVYyK251S09GSzJyekF3dmtuYnlicU92V3U4U0FhVHZ0QzJkMDA9IiwKICAic1lQIjogIkVZNU1pMGMvY21Gckx6UDhIbm9mNkQ5YXFiQlRhVFU1aHhoYkVXd0dFMGg4MWxhZkZaS0c4SHpENndoSGlqWmg0eWEycTF1QVpmM3RKQ0piL1RHaiIsCiAgInNWUCI6ICJHVytFUG02a1ZMUWZyZWo0b2xyRWd1RVRSV2tGQndtWTJhckpTY2EvdUFFPSIsCiAgInNSUCI6ICJVRUhCUm1MdUdERkprRUhlYkdMNGgza3l0WEN6aE1kc3hQbDN5a3ovU3NRZEQwYmVjbzRkN1ZZclVpUFNFUmJvTzdPYzdKRUtNYzlkeFd2bFcySlYiLAogICJzUFAiOiAiQ0U0WUQwN2V6SXNnTWZQTlhSWngvSGpDcWFBcnZVQm5pS0wwL0pKakZ0cVRpZmlWOFF1aXlpeXk4QWhFbmVJZTNyS0JlL05ta2ZsRnpCR0N1SUJvcjRkT3BMSXV0RldkZWhQaklzVEZZTDMyR0N2QU1MMHAvdENZS2FZd0ZjUT0iCn0=',
-            'ewogICJDIjogInk0eDBUR25vQWh4eWFnR1FldWduVjNoZjE5Z0NjakU3MVFTRmkwK3FrNXM9IiwKICAiZTAiOiAiRjhuN0J5UXNraVplM1pua3czZ2h4d3JHUXA0SnFDamZOellZS0pFUE9VYz0iLAogICJDXyI6IFsKICAgICIySng2ekdQRHF3aXdpNzJrUWFkVFRudjdQWUNndHlTYUMySDl3S2plOStVPSIsCiAgICAibmcxWWE5Y1MvVit2Ni9kWWtpcnJwejR0SVRnMFZ3YVFzc0owdFErS0tkaz0iLAogICAgIjJtUTFWdHVTZzlZK2hxdUwzWks5aDZVRStGdVE4SWFrclFQT01YS0ZrSWM9IiwKICAgICJ6dDdSS2VXY2swY2ZtYnJ2OVZrY0hOV0swOHRNT25HdnpUczcxWnp2dE13PSIKICBdLAogICJzIjogWwogICAgIm1FZWdiWFRmT0xpWXFSZmxFUlZsK2lUUEphWnVHOHNqQWVadXB1UTlVRVZ0T1FDRUdmS25GckpMRklYc01zNFlMa1FqV0ZaVEl1RWY2UGJtcjZ2ayIsCiAgICAidmVJa3VBdytuV3B4RkdNR1lhRUxtZi9RUlZwM2FKZThtSkxHcVFML25TcHFvcjZYZkplYW02Qk1uTDNwcGc1cTc1Qk5SNzVLVmJhYkdiank5ekZNIiwKICAgICJBK0FCK0FsemM1ZWQ2cEx3TU1selhGZnVhRFFDd1EvMjNJTUtSK0hTR2hkTC9zL0ptV2srbllROXBjR2FYZEkxQy94S3FYMU1iNENUK25QckZPQnZOQT09IiwKICAgICJKa2pDTVhHcm9Ea09tOHRaQXh3S3dzT2IrL1pQMDY1TGFWTEtwbnZRVEZvPSIKICBdLAogICJNIjogInpvNDR1TkxnUDd4dThTTWtjQjBSZ2U4eVovNG9QbEw0LzZDM0N5U0JCSTg9IiwKICAiQzEiOiAiNkpDcHAxamVCUW1PRE5WQVA5NFpMQVpNVFhoa2JZMldTQjY3TmFNbk5NOD0iLAogICJDMiI6ICJvV2NralovOGh6a3ZZL0ZNckRxTVNTYmhyZjNSekVLZDdoUFd2S3c3OUljPSIsCiAgIkExIjogInFaeTl0TnlqTWtKSFU0UkxFdkhLaXk0S0U5Q1lmK1ZuU0ZMY09SbHpBczA9IiwKICAiQV8iOiAiaGJSZlNTZ3RsMUZST2JwdVcvL2ZXak5lS2RydzBsb1RUZ0VLcFo2dmhCTT0iLAogICJkIjogImprS0xrd1kzemVmYVlINkdPeWpzc2ZtUWp3dVNYcG9EcllyRWppdDMraWM9IiwKICAiYyI6ICJrSmJVbEdpakxzSXBJc1hONWNkWUU3cjYrdEQ5emFGL0kxeDZGbnZWdCtRPSIsCiAgInNYIjogIkJWdDliVmtMRlZTaDB5Wko4bng0Qmx1S3pRNC85VEVtbjdwY0phdlFMRkUwSHJvRUZVS2l0R2kwTFF2ODAvL0wwdXVLZjVWNStyNEZKR25GeUVxZjFBPT0iLAogICJzWSI6ICJHcE5IMkJxWnkyWldmRkU4bVJOSWk3MU5ROFVuNk82V0hwczRrdnpqemJ5TXZkQm92UjZYbW1OQ1FPRys5REoyM1RIckhKSDJoZ0QvZGlrV3J5dWREdz09IiwKICAic1IiOiAiYTVjNG0wMlp2NUp2RmpaZ3A0T1dEbXNxM0hlUEpqOXJ3L296K2x0cXEwY0djdkhOTXAyYUJMZGxpSGQvUGd5WS94UWFFOWY2ZDh4UTVSdjRlbVg1bGZ2dXducUJsSExmbEtyL2srb1p1S0JXN1pLeW9lWFg0cStmWjlsVm5EVT0iLAogICJzUjIiOiAiQzN2UWVLSHpzUk9mbWpxVkVaM0twUXZ2M21FZzJhMjBVeXpRZUJFZjR2bHhaRGFjZC9SbGltNit6dm1udWpCbFd5L0haM00rZzd5cld1ajIrRjcxYnc9PSIsCiAgInNSMyI6ICJEQ3liUjIyQWt6MTAzOGljU1dFQWh6eGQ1YlkrcmVYVnlGMHlmekpwTXMzb28yU2FHRzdmQzFIaHhSNkJkSEdBcS9KdGdna1g5YWpLaGxYaHc1Z1NyZz09IiwKICAic1MiOiAiLTU4NjM4NTAxMDM2OTgzMzAzNjQxMjc4MjgwMzc1NDc2ODkyOTkwNzE5MDcyNDc0Mjc0Mzc1NjI2MzAzNDg5ODI1NzkyNjgwNTM1MTc0ODY0MTQ5Mjk5OTY3ODIzMDk0ODUzMTAwODk3MjU0NDI2ODk3NjQ2NDU1MzQyMjYwNzk1NjU2OTQ3NTI2MTY3OTcwMDIwNDE4NzAwMTgxNDc1NTM3ODg3MTA4NDk1NDkyMDA5Mjk5ODI5Mzk2MDc5NzQ0NDQ5OTQwMzIxMjEzNDM5OTQ0NzQxNzQ5MzcyMzMwODUxMDA2ODAiLAogICJjcCI6ICJvQU9FYjVZdG5ZL3hzZVhxKzJvZVhGdE5JemJrUi9ZRU5xcXJ2QkhSUEpFPSIsCiAgInNZUCI6ICJIV2tMWXc3ODJOYjJXT3EvM1JEK0ZwZnNIWWZWalo3ejFwYkdSdVVRS1d2Zk95M3pRd041NlhSVmZZOGx2eG9hMm1ITitOZXN4U2JLSlpjQytCaEIzdz09IiwKICAic1ZQIjogIkJJSU9VeXEzMWxSNVBsZ3ZDMm5tY09WMGthcUxPa2VhTnFLampLdzlZMW13IiwKICAic1JQIjogIk0zWFFqeEFTcVJpYXRqZTNMeGlzbXRyazFxQ0ExaFJ2ZksvNXI0VkJ1QzlJUlFOZ1NYME9uS3Z1ZXRHRnd1aWo0WkIvNk1EaHUrdk1rekoyN2dKWUJ3PT0iLAogICJzUFAiOiAiZHhGOXEzaW8rS0p6NFhEVFU4OHlyVGdFR1VmbGdIdkZJWkpOaVYxOFZpNFJza1VSamsvMEZVWGI1SndFQVo4SWgxVnBrdWpHSHBRNDdZM0lJbS9walMxYWpwMUtzQkNkNUs3WXIxZVhUaDFadStTUy9xQ3JiZVZKc2ZkckNrND0iCn0=',
-            'ewogICJDIjogInJIeWJHcWZOWFpUYjFqbWFJaVlNRkNDNk9LQmZnaXZNbDBBMVJzOVZYZE09IiwKICAiZTAiOiAiSVI3NDhKZTJrc01GOG1LczFNeEVxOTZsYzNMcVZwSkllZW9rUTVlZFdLND0iLAogICJDXyI6IFsKICAgICJyUFplYW04YU5nbDVtZGovdDNnTFc1VThjZGpxK2pDdmhsR3FtakE1bVhNPSIsCiAgICAiak5HSDE3TDZUN1YrUy9hM1FZaTI4SDBuSDZkcjYvUHBwUE5xQkdnNG11ND0iLAogICAgImdaS3lUNjd0eW5XNmZ5aGJsUytmNEhKcnNlb0xnc2VPUjNNanpVVEFFMzQ9IiwKICAgICI3UzU4RHQ4SzZCK25WdVlNU05UdTVmSzBSd0ZiTTZERGlpT29RZVVsWGM4PSIKICBdLAogICJzIjogWwogICAgIll1Y1k0MkY0VEdBelY5R2dXUmxrWlZOT2hJNXkzZUZXbkNQazczcUNUSlNWaUw3b21lblRKSmI1YlpyVmZvRHRYMCsrMGtKUkFYUnY4aHVkV1JLUiIsCiAgICAiQXFGRnkrbUtkSWxoK3hFTVRFMThsUjB2MDJJb1Qvell2bk5xWmJIM0lUeHFQMm96SmY3WW5hN0g4d3ZxSWFjdHZiL1Y3QStpbzFDY1Y4bHlFNkF6emc9PSIsCiAgICAiQWd5c0Eva3RxR1JRa1o1emphT2lhUXVkVlVqa2xmT292bS94V1Bub2dNbHBVelRKek5CRzRXNUl4L0N1eUdBUXdkNFF0MW9lVFhTMDEvdzQ3eDQvRHc9PSIsCiAgICAiQ2NFYnpVSVpSL3FGUjZqL2lwRnp5ekNtVlRZbGdGS1ZCN1ozdC9GZWRoWT0iCiAgXSwKICAiTSI6ICIyeU5ZR1JIZ0l2NFJDemVobkpXdmxTNXBBTmduclAybFNTZFBNbWk3MGY4PSIsCiAgIkMxIjogIjNsY3Z4RDZUNEV5ZWVVenYzRE9rRjdGL3RHR1pJWXU0YktxdlVhbUI5Yk09IiwKICAiQzIiOiAicVo4NUU1ZlFmZHpGaW1GaU9Qd0g4VytzcDFDdFBOKzUvSFluWTZHb2JXQT0iLAogICJBMSI6ICJoYm10bnErcGthN3ZUemJSTi9JbmdIN01RY2ZBRm9YcG9FMm01Z05SekRNPSIsCiAgIkFfIjogImp6d29MdmtDbCtpS1g3TUV2OU85VkdqM2ZCYlN3OEhlWHZQd1pIallrZEE9IiwKICAiZCI6ICIzb2djRktleTZJT1JhWC96WEVrdnFSNDdrRHQvNVFIZndYTVd2VmtKVXpjPSIsCiAgImMiOiAiYWUrTHZIS2t5S0xVd3RoYmVMQ1ZZNDd2UXBGRE9ORSttUUR2T3lqeHkzMD0iLAogICJzWCI6ICJFL2tienVENlh2UTE4Z1hDR3JkbU1RV1pLV1JMclRhdHI4SjlyYW5RNERXRjA3S1Y5VVkreCtZSHZSZitrWE5hKzhTZGZhZ0g4TjV2bkdsYnVjNXpnUT09IiwKICAic1kiOiAiQzlxQlRPTmZuYTlwbFBZU0VGbExzbFk1ckcrbVF0Rk1tMXVUVHdjdE1Zb0xsWjFnREZuVU5ySEhRMlFZQm1wWkpGSWxROEh0eWViYlFrazZwUGVac2c9PSIsCiAgInNSIjogIkF2QTRSNUdTVzhOZHdzN3BOV1d6eWN1K2liMWE1bWlGL2g1cm5LNHBJKzcwLzlHVWd3dDllQnNxR0dFbUdVWGVHdHkwVW1mdExNN0hoZStaaEFxaXVlelNoQmE1THAwTlc3RVB5L2hqM0VBMmlzRGFDTFBhbVNjQ21qZVkrNVBQIiwKICAic1IyIjogIkJxRkp1cm93UHhFUVNRZWE4RWxzaEExZXJqd1RwRW9KZW1qbDg3TjJwRlZ1YlJMSWxaL2dydjFFYnJqSXllKzNTWldyblJHbnlERVpDSTEvS2dGbkJBPT0iLAogICJzUjMiOiAiQlB6ZTEveEx6VEVEUytrU1JNL0FidkkrRk85VjVoWGJEMnlsSHFPUEloWm55cW5JQ3l1bUE1Y1dlMUduVGRWSzlEQ0grOWlodHVSL0x1WVFVSHZ5SFE9PSIsCiAgInNTIjogIi0xODkzMTEzNzgwMTY1OTUwODU3NTM1NTk2MTI5ODgzNDQ1NTg4OTcyNzY5Njk3MjkwMzI5NDQ2NTUxNDk5NDE4NjcxMzg1NDc5NTUxNzA3Mjg0ODYyMDA2OTUzOTY3MDQxMDczNTc3NjQyMzM2NDI0MTUyMTMyMzg1MzIzODcwODYzMzI3NzY3MTkyOTk3NTU2MTUyMzQ3ODQ1Njk3ODI1NDE0MjE4MDA0NzAyNjg1NTM4MzM3ODYzNTU1NDgyNDAyODExNTQ3MDQ1MzE3NjQ4NTUwMDU0MjM5OTIyMjQ5Mjk3Mjk3IiwKICAiY3AiOiAiVTk1eWFqeFE4ZG5kWWJ0QmJiL0grRjdWVXVlMk9oR2xvelUxdTYrZ3oybz0iLAogICJzWVAiOiAiQ1dKb0lYVUZSdnN5b1NTamVoSStXQ1c3dlQ4ODdxWm81eEk3TWlPazRHMVVUMzNHbE1EbWljbmpQYVZkczRtT1NXSU9jclVCUUwwMGlVSFQzZGcwTUE9PSIsCiAgInNWUCI6ICJBbFh4VllEVzZjNStqb3RHWG1OQVRrS2ozTTd5cTY4TmxmWTFkdDlpbjQzZCIsCiAgInNSUCI6ICJHOTNnM0lmZ0Yxai9SUGdBR0VzVURtZFFTZXNmWXJMWVJtVDVnTTdhdXdRczV0dUdkblIxSm9hSExxeENxWUJRb0RLbldkQkJXYnNFaHdPWGZFcElPdz09IiwKICAic1BQIjogIkFsT0huVkhsQS94UzZKQ3Q3WGMwNUZwb2lnRWxMWHFxOUhrWTlmaU9GMWVWdXhmaTBQKzJubGltS2VrM3pwbVR6OURsZWxTZXI4OVYyU0FJSzFUbGVhU1RkY3FSVjdNY3N6NjIrS25NV3Z5Z09VSmlwSXNPc0VzanphaDF3MEQ1Igp9',
-            'ewogICJDIjogImdDWDJJZ0Z6NWhxZFQ1aTdrcXZ1UEY1QmRTV09WT040MTZLeS8wRTI4WEk9IiwKICAiZTAiOiAidTl5b1dsK1g1eUYyZzVtN0NqMXdXMmQ1cG1VY0ZwelJJeFMzdW15V0pyYz0iLAogICJDXyI6IFsKICAgICJxUjBmVCtLVWNZU2NUcXljQ3d6b3FtSXBFZUlsczMzcmpMVUFpOGRxd3BVPSIsCiAgICAibi96ZHRtKzg1UG9ybnlxbStJQ3JwaUc3UW9XQmwrR1NmeGV1LzFLcE01UT0iLAogICAgIjAxbWhqc1BjK0Juc1QyK3FVNlN3dG1WMUl2aUZwWnkzUEE0dmdtRnV0d3M9IiwKICAgICJ6U3kreEJhRndEUFFKM25sdnJQVFF3cnFybUwwSjlPblRsL2hUUkJFV0J3PSIKICBdLAogICJzIjogWwogICAgIkNoMEFWYVZCRG5ZcUx6ZmhocFNqb1BuK0JUSlA4blV2UHl3UC92SzBIZkxuZWhCcDZsWTJIK3MxY1ltbGNCdFp4UjNmSUx3TE5YRnpoTENoV2xlOHZnPT0iLAogICAgIkVpeStLZC9pTWVpSDVoUXpLTFhCSFBQSksxYmlodnZpQXBzTllWUjNtaU4xNlZ3dWYzVEE0dUlpM3M1OWNQYU1KVmJMODF5eGJWYUFVaGpjRzN4WldnPT0iLAogICAgIklzUW1Zb1RoaFUvNVZrcDIyMGlycnFOYkVJdGhDeDFKdGlXT3ZieFdUTjJiZE5yTEY2MTZjL1Z1UjBPNE5LaVVzMGsvcDgvV0NOOVFIMUxlbEpHeFNnPT0iLAogICAgIkptTDl4YlEralU2WnlYRVM5aTNtNkphRnh6MEl3S3RWTFhmTGRTbEh3T3M9IgogIF0sCiAgIk0iOiAiNHd3MUMvYTJCQlhyTzdONU40aDFlZ0I4RzM0bHlkcklaQ3BPeG85cFVwMD0iLAogICJDMSI6ICJsaUx6c3lKT09UTnY4WGtsOG9TcUxVcm1iWURMK1RLTm1SUEwyRTNxVEJVPSIsCiAgIkMyIjogIjJ3M3J4ZzdybEFuWXVldDhWd28ydmRraUVhUXZBWHl2OXVSNmdkeCthSWs9IiwKICAiQTEiOiAiaHZiYTFad05JcXRlTjQ4QVNpTG4wZ2pGYjZQdTAwNnVQSDZGbWtIdlJLRT0iLAogICJBXyI6ICI1eTdqQWRyVzAyT1dqeC81cHVvZmUrNmZseGFvNlljbU9xMkNHSEJMUFF3PSIsCiAgImQiOiAiM210WTQ3UU54QU5nTU1jcmgrU0tTem5TbG9wR0hpQ08rT0VKSTlWc1pFOD0iLAogICJjIjogImtnSCtLSXdLaWV0WWhJRWtDTFZleWJ4T3JhVDR4QmZVQVZvYUYzb3p6TjQ9IiwKICAic1giOiAiRGZGb3dPZFZoWWZRcmV0cXQzN3lpTE1zcHgwTzNWVGMzbVN5U2xqRHVtZm5VbWJXek5vN2gxMkJRMXo0NDlEV3dSMDFvY0RLRmVGYXNSUGRBdGhEWHc9PSIsCiAgInNZIjogIkFoMmpoblZ6TUJMWHpERDFnWllmVUFvK2YvQS9vL1J4MnArZzBmUDBOcDFqK0dZSTdrNUFUVTVWYTczT0dZTU5VazB5VkZRMkZDU2s4UUhpYXcvSEVBPT0iLAogICJzUiI6ICJBeE40bFZKTGNpQlQ0QUhBZGlGcjJRN051R1h2c0tmcEpSbFpXL09KdG02NDlRY05lK3h2ZDZiV2lPMm85OTRRVE9PbHFTZWZMMlhTTGc3YWRjdjdpSmJJbGFMTVRodFhtbU16OEt0bzFGakhTMDgvZXZjdmkrUzdRV0ZYbDl1VCIsCiAgInNSMiI6ICJDWTM2aDlOZlAxWXNxT1lUOUl3VDR0NVIrRjZuZDJyRlc0K0FMajd4RlZYMlp2eFFZOEJJNlNwTHk3RXRPN1U3VHFCeFhRR1JnUmJ6S3RndHYzSG5kZz09IiwKICAic1IzIjogIkI3aGxocUI1bHUxbHJoUnZzRHNmaHdweGlmVUJsWmlaVWJ0SXYzU1VGd2syZ2E1b3grQXdROTA1ZCtPaVlMdjE5T0VQTzRTT2I0eXh6TDVnRS9SdjB3PT0iLAogICJzUyI6ICItMzA2Mzg1MDEyNzcxODMwNTk5Mzk1NTYxNzk5ODkwODczMzIwNDg3NjEwODA1NzQ2Mzk1NDcyMjA0OTgwMjcyMzY2MDE0OTc0NTUyNjY4MjU4Nzc0NzEzODM5Mzg0NjI2MjU3NDM1ODM4NDY0Njc3NTMyODc0ODI4Mzg2MzQ1MjEyNTYwMjI4NDk3MDQ2OTU5MTYxODQ5NzMyNjQ5MjQxMzMzMzQzOTk5MzYzODc3NTAzODk2MjMzMzgzNDY1OTUwMTYxMDEyNzY1MDAwNjQ1MTgyODI3NTkzNzI3MjQzNzQ4MTQwMiIsCiAgImNwIjogInNRcjUrUVE4d3RvUHcyRG9SQW9haXp5ODZIZUhlU2VnQmo1Q1ZWd21WZUk9IiwKICAic1lQIjogIkFwREVzQmV4ZURSQmFNOVVqMjZOQjkxQVMvK2VtaER6Um1HZ1ROUVNvZmgyN3psN2h3cEFLQWdablBLL0VMeGZqTlBUeE5zMHpSRHFhRVl1SU83TFhnPT0iLAogICJzVlAiOiAiQlB5YVRRYkRwZUxkRVl2UldWL05IVUFjY1BGMGprbE42NGc1aXdLVkpWQlIiLAogICJzUlAiOiAiTzZnNi8rTFNCNFBYUkM1L3J3dzZmVFRWWFRXR3doN0F1aEVwMXVsT1RYNUlzLzluTWthVWVlKzZFQVVsZkQra2d1Q2xoam54WVp5bjM2Q0l2aFJkbVE9PSIsCiAgInNQUCI6ICJBN3JhcUdlOVFydHRjZ1FVWUhCY1FSTDdCRUgzY3g0NDJEekJYWU9jU0pPOUV5Q3Z0cE5HM25vNXo5bXM5U2JuK1Nnd0d5UnhkUU1qZkJJNkRmbFJqdTFvc0ljZGE3SEZkUXc3NGxiVU0rUXZyK3pYL2RCT2pvc3BYbmFsNkhPZyIKfQ==',
-            'ewogICJDIjogIjR4aUpBUGlPV3BVcFpkRXQwT2lQMkExREJtUzYzRmxlYU1zSXRkOUpMMU09IiwKICAiZTAiOiAic240eHdiVkVMSjZhRC9vYVUxNkRBVWUvQkQ4RzJHN1FuVEMwZUdwR0p2OD0iLAogICJDXyI6IFsKICAgICJvRE93VUdQd1Q4ajJEYjZtQ1FPTmVQdVhHWkRvTmxBekZRdUltWHJsd284PSIsCiAgICAiaW1YdHRZbEJtVVNYU0ZGcWhSRmVmMWpXaWxEUzR6b2k0a3RPbUhYYSs5cz0iLAogICAgImdQVDBvcSszMWlsMU5sZjVDZ3J5aFh2T3RHVjhKOVVSTDVtY3ZpQ2NNaGc9IiwKICAgICI3SFpuVkRwdy9KVCtzaGtldysyZit0VjdTcy9wU3kzd01wQ1I2S1NvS0JRPSIKICBdLAogICJzIjogWwogICAgIkFpOFVac1Q0QlhnOWVBZFlKRkpXRVBjYlJ5UmM5bjdBRkNDVU5NcGxBL05NdlZsTi81N0hRcHVncm5OK25FbEM2em9WL1BHUUlCdlFORmxHQTIrYU9RPT0iLAogICAgIkdIZ0p1NFRjVHhYb2dzZWtvalBGMW9GUWJvUHA2NGxKOWt3eDJXVHJjV0Z0R0UwaG5mQU0wczllVnRXcjZsVnprUGpOOHlRbE9UNzA1VUl6S3hsd01BPT0iLAogICAgIkZ2YlNmbFRSWVkwT2x0NWlUcG91NEk0STYrRlRCdlM5LzlKMnVseXQ1QkVTNXZuT3NyRDNVWXlYaE8vcDFyeWRzTnlYZkhxVGFzUHlxbHZET1NIY0xnPT0iLAogICAgIklQbE1xd2pvOFVPSjkwNHFuM0JDUXV1cE56ZU9WU25SdzZZNzJSTWsvRnM9IgogIF0sCiAgIk0iOiAicFpFZmxVcDBkck9kaDdCaGFYc1ZPSHZVdFFNbTJ1NDNoc1E1MTcyQWZkdz0iLAogICJDMSI6ICJqQzdEMUtwcG1NTDVLZE9QVmU4Q1hjKzQ2OHVaVTRMbkszTUt0T01hSG1rPSIsCiAgIkMyIjogInBiM2tBa0NCT0FJM2FiVmE2Z3k1V2hTRWZjdTBESFRrSlNuaTdxQkVuTk09IiwKICAiQTEiOiAibjVoQytwNmVhcVdIZk04TFovVG1JY282WkZEQjd3N25Xand3dnd6MURaMD0iLAogICJBXyI6ICI3aWNnQXJuMEVzanA1M1V0WW91bTEvbjlKNjVoK2JuNkNDdEU1VmFlVnMwPSIsCiAgImQiOiAia1VwZ2djOUcwK3g4bkczazJwYzNLNnhxK1Jna3JMMW9zQlZqaVlkUE5ZND0iLAogICJjIjogIjdOSkU5aDE5Ly9aczZWZGJ0M0hkU0ViaExyOU1YSU5ENVM2TDFKRjQyaEE9IiwKICAic1giOiAiSzd6MTFZTWFKZUZINzdaazM4amhDTi9zZGhxSlpYaGtzb3VYMWphNFc4UkltUkNLbjlPTk5BdExtTzhSWGYrclpaMCtZQTNWT0hvMzd5YUNQYTl3MFE9PSIsCiAgInNZIjogIkd2RXZhVjBRcFRiWCtON3NxenZRYkptTHZCdmswZnVZNTh4RmtWM014V3ZTVHNnR2dHTFZrN0JyN1BzMWw4UnA1bGNhQVh5THNheHJ5SjNOeGxuS0Z3PT0iLAogICJzUiI6ICJCZlZBMENjd0NyWXplVkkwZnpqZk9NNGQwNlhid1IyZkVkdndqMUxCRmd1dnRBYzZXYlZ3YXJQMDFFVG5QMzNtY1lmL0JScWRHKzVuY25lSXoycUhMYU1wRzhrUnJpZmlQdiszRmVQQTV4aEhPWTlzQ2NQRkUzK1pkb0hVVDFORCIsCiAgInNSMiI6ICJLYWE3a1RPaENOUm9zTm9BOE5pd1VsYkc4RHlEQ0RRZkNURElIcG9HdTBGeEgyZW5pQWhvSjBXN0ptd2tXOFQ1Z2c2cW12L05aYzA3RVlUekZQKzRiZz09IiwKICAic1IzIjogIkgzK01xUm9wTGFpY3BlN25sNlZMdUVZVjJ4c0Qxcko1dkZEVU9SQm55QSs2SW0rMHlFRFlCanZMTjJIQVU1ajA0YU5ENkRnZHZ6YXFTcTVXajQ3a1d3PT0iLAogICJzUyI6ICItMzM1OTYyMzk2MDA2MTAwOTc3NzM0NDM3MTA5OTIwMTM5Nzc2NjIwNTMxNTQzODgxNDk3NDU1NzQ2OTYxNzcxODE1OTYyNDc1NDA4NjQ1MDE0Nzc3OTQwNDQ1NzAwNjIwMjMyMTc1MjI2ODAzMDk5ODk5ODkxMzM0MzEwNTYwNDc2NDI4MDkzMzA2MjIwNzM0MTk4NTU4MTk3NDQ5Mjc5MDI5OTg4NDM2NTU4ODUzNjk3NjE2OTIyMTMzMTIyNTMxNzM3NTg2MzI3MTU3Mjg1OTg1NzgxNDcyODczMjE4MTU0NjAyMDQiLAogICJjcCI6ICJaa0dBc3JmbkVRUW84Yi9RSVAvOXF0Qko4S0R5eDVZM2ZPMllxenlRU1RrPSIsCiAgInNZUCI6ICJDNklaa0g4WEtBRFlLMEdQTHV5aVhjME10c29DaUUrYUpoYjZZNVZ5S1d1dWp3em9QQzYyeWp0MUlOWi94OVRUZTdHZUJXUkh5VnZJdGo2NUp2aW1QZz09IiwKICAic1ZQIjogIkF0UmlYdVB2Ylh3cnNwbExiZ1FQZ3pCd1JFWm9hQkFZRXc1YkN3NjNTTjhpIiwKICAic1JQIjogIkwzWXA3QnVDK0F0Uy91NjZTRmJFeVc5MWpYcm53d1EzL1dMWWhkL3k1SE9DbHdKSjZLNVFDQ3p3Q0F0R1B4VWROckNTdTh5THY2WGxwdURhcGYwa1BRPT0iLAogICJzUFAiOiAiQXBLVXgwdHFuaTJOc0ZJQy90UUNkQURsNjFHcXJiQUFJREZiam1GeVUyVjNsVmJ5aUdXNXRQb0N3aEt1ZnFyVnJnZkt5L1k0aHZ0ekRRSmJWdVl4N2pXVnpabDF0dzI3ZmxTM3hzM28xTlNNU0RqZXhBRGN6ZDBnYm9KRFA1NkwiCn0=',
-            'ewogICJDIjogIm9MWnpXbzNGRXhKNThTQ0swOStGcEtQVldmMkNVeG5UM1dObEpiaDR6NG89IiwKICAiZTAiOiAiU0w0djE2STBwWFVhUlZMK2JDV0pENk05bzMreDNKWGxjNDFDNlZqUjhZMD0iLAogICJDXyI6IFsKICAgICI0eVlXb1g0WTUvWlYyNjhuSlNxUjk4OUcveE14M2JpVFhjc3Z6eUIzR0NzPSIsCiAgICAieGpVbnMwMVJqbmx2VFY1SVBUL29abzA4aktYZVRONU9yUExQREZLMFBmZz0iLAogICAgImlPOU1aNi9sNUFNVkNXLzIzQ1RWV1RVd1lRbmRNMGFqbTVXb2tpVFV5WkE9IiwKICAgICJybU5HN0E2SHYyZ0RldFBEUkdkakh2dldSTnNCT0NEYUppakEyQVc1ZnVvPSIKICBdLAogICJzIjogWwogICAgIkJPaHpRd2VBa2hsb3pPQVF6R3dBOVBHY3o3ajdGL3h0REk3WkdXZ3BuRGtTcnZTUFZGTG1meE1Sdi9KOHhyM2toYWhkMUFrTlQ1cGlZSlJtWmJYMHBRPT0iLAogICAgIi9JaXdYcjY1c3pzbTdNUW5MOU1EZ0Q3bjFJM1RKb2cxdHBqT1hzdnM4cllqTnhqdWJSVUlBeitudTA3UFJsa0VtU2JtT01CMHlMRDZHak8yM3NYTiIsCiAgICAiRFN4VGprS2xoVTNGbVRYSG5ZWEpWenpLNFpRK0pMWFlRajNGZ2ZpN2t2STJmcjQ4bmZzM3pTQ1p6bGJPdnBOOXJxZU9acEQwbUk2dXFOWlVSYWhLNFE9PSIsCiAgICAiSXlNdXVSMENab2laekNaVDlvMjZkTXJHalppbHFOcG9TSnUwcW1QUytEbz0iCiAgXSwKICAiTSI6ICI1eE85d1lNc1ZsN1lJVkVnL3BIdEpTSTYrN3Q5TFhxMVZSaXBKR3FEc1pFPSIsCiAgIkMxIjogInhiZVpySVJyUWlpOVBHZDVIWGJnVWM5eVZEZVJMR083S1FPRWgzRDZIcDA9IiwKICAiQzIiOiAiN0hNcHVndkp1czF3cTVjb0E3Zmh2SzZqUVZGd0xUVGY0QWhKWllyM1h1cz0iLAogICJBMSI6ICI0SGQwajR2V1lOc3NPY2ZhQ2hFaUMzWjBvNDVoWW9QazVsODMxRklSd2xzPSIsCiAgIkFfIjogInd0angxTnRaTHdCQmtodTFocE05cStkT0g5RndVVnNLZFFUL1JHbWV6RDQ9IiwKICAiZCI6ICJ4SVZxWWlNVC9vNTF2N2g1a0QxbkV4YXRmTVVETTJZQ25Yb2N3Q3ZibDlJPSIsCiAgImMiOiAiVGtvaE1ldkNTdVoyK3R3ZENEVjA4OGROaHlxeGxWWVUwQzRrQWlhN0pQQT0iLAogICJzWCI6ICJCSEw3YkZzeHBucVpMb0JpMHdOY1VCTnZRN0RUUUtJaWV0NXdXN05QMzMrcnN6T3p3dUNvd3pIRVN3RVlvL3JwbnhhRXNodE4zYS84RThBSHpONmgrQT09IiwKICAic1kiOiAiRG01MFRyU3F0Q0hyeTFZVXM0LzdwNDRMVE5GcHVpRWNjNFJTUURIYmd6U0RuaURiYXYvZEJDQ1ZMNndDb1RQdlZqd0RGNHhqTjhjSTg1TjRGbkw5S1E9PSIsCiAgInNSIjogIkFlWkZER1hRa09sVUoyRTIwMDdOQlhJbktiUk53UXhSMytWQzBKT1JZUFNyZ2VIdWRPcEVFYTdPZFhZWkxydENadDg2MlloRlJoclJ1NndtU29jOGxLTU1vUWxCRGVoeXNpYmg2Y1ZVazhoSmtzRlFIeFZsWEdSSVRXdDdsQ2tIIiwKICAic1IyIjogIkFjNDc1T2FVYU9jeGNlZzhSUU5oOHlUWjU4WmJ3b05LMVJ1bE40VjE3RmMzZ09XU0lTa3RkUG5FZ1dSMURDR091R0xMV0N4OXRqbVdrazFvbEFzZnFBPT0iLAogICJzUjMiOiAiQlBGZUpNUURjM0x1VVlYaTZKYm1oTkpIVDRFRjM3ZzV1WUpxV3U4WGtFRy9FS1hwVnQ5c3RUK3drVlRoZ0Iwd2RhenpXQm8ra2JaTXpwMkJISU15RGc9PSIsCiAgInNTIjogIi02OTEzMzk2NzkyMTEwNzIwNzMwNDkwMDc0MzA5MTg5NDI2MTMxNDE5MTU1NDExODAxMzg2NDQ2NDE3ODAyMDE1MDg3MzI5MDAyMDE4MjQ0NTg1MDUwNTk5NDUxNTEzNTg4MTAxOTcxNjE0NjU1ODkyNjM0NzA2OTI1MDQzMzk4MDgwNzkzNzYxOTg2NDkxNDk1ODgzODY2MzI5NTM3MzY4MjM3MzAxODMwNjg1MTExMDYxMDQ5ODQ4NTI4NDU5MTI0MTQzNTMyMzM1MjQ5NDE0NTMwODIxODE2NDU3ODQwODIyNjUiLAogICJjcCI6ICJ0YzlBcTBhTlB2VzZFUk5mKzVvU1ZGZXZuakdNc0lyQWNCUGU0T3c1cEFvPSIsCiAgInNZUCI6ICJJWU9GL3lTWER5aWJPdzEyT2tHWU1pSkM5TGNFWEQ5RVVpd1d5Z25oMjNzMWZpa2hDLzJXYWRmYkNjSGRNUmR3aDFETXpJK2N4Y2J5U3lDSERqV3RSZz09IiwKICAic1ZQIjogIkJROE4yTnZWbHJHaWJ5ZW9sc3I5UzZrcHNONzZQQjhHTEIreWZKMWFaZGxTIiwKICAic1JQIjogIlNWekJmMjM0Mk5FMmQ1MnNIOTJWcDhWT0d2cmUzeGdsaEswRkNralI4MlgxL2FXcXkzQTAxU0dYblNSL3ljbFpHcWZ4N2JJQWo0NUFoL21WRUhMMjh3PT0iLAogICJzUFAiOiAiQkdrLy9tUjhuMWNYZThXeGhsTW5SY0YrSDZMOGEyMWFSU3FpVTA3Y2lDNEl0RXgvMUNBcVRrQTlnQmt1V2RkZlBLai9NbTh1dzBzLzJ0VzBtdzVSa0NQYVpiMnh5R1dHUEpKRmxNY2M2bklTVGQySkgvSFNTMnRsTXplWWJnb1AiCn0=',
-            'ewogICJDIjogImo5a1pNWER1U0xFV2YyenhMUFR0NU1nQ1UrYmNQWjFsaEh1akdIalRCTm89IiwKICAiZTAiOiAiVy9pUGlnYnJlNUI1QUhXQlVvYVZCYWw2VjNvVHVlRnFYWHBHTDd3NzNFUT0iLAogICJDXyI6IFsKICAgICIwYU83Y2VqVENrL2VNR21xWXlmNGEyQnd1UmdJUHphVzhIZE5RMCtJVzVjPSIsCiAgICAiMXU3TGw2Qm93NnhTNkdLVitRenpVT3FvWXk2QzhscUZlendiNURncFR6ND0iLAogICAgImorcVM5eitvL0hJcEQzL0EremZPVWJGTE0vZWVob3U3ZjFjRnN3Q1ozR3M9IiwKICAgICJrRmRPcWpWT3A4OG1JMzgwN3pBV3NhdEEzMEZaRENoUDZPbzdEc0tKVlFrPSIKICBdLAogICJzIjogWwogICAgIkE4SVJsUW04U1RTQk55SUwwRUNuenFad2o4U0RCQ0Q4Y0s4OWs2ZWd3RU1PREVQUUFtTkl0MDd5ZnZyMThWZ0xBRG05WnRwSDJkSENVWUVXUHE4Q09BPT0iLAogICAgIkRKVFpnYmhxRFNicDRLVnIzazBrenZ3TXp1bGcxWXd3cXFOcnhVOVBzaFFMNnBHVy9WbVBJQnZ4bWlpRmV1QmJaOXdCbzhTU29Ob3VHcWkvZkN3UVRRPT0iLAogICAgIkNwbWpLT1ZKYUZjdDEwNi9nMHBFMHlwemxRSU50YXRWRTMxYUN4T0lJamlTaWNnSzUvNmEyMlBzSkhRZmUzcWdIc0tSYk5ITktZaVFQOFozWlE4bXZBPT0iLAogICAgIkRSeW0xK2RKQm41ZlFZWTJlR2VFWDhqNWlwbFd6Z09VKzNjcndsbDhESHM9IgogIF0sCiAgIk0iOiAibmdJQ0szSEZ2VjMxbmlsMURjK3FxNFAyMFpqVkV6dnVaK25CYnVYUE43dz0iLAogICJDMSI6ICJ4VWdETUQ3VldwNC9sK0V6NUxiODU0djRwV0tHSXVCQjZNaytzTml5dmdZPSIsCiAgIkMyIjogIjFhQlIwQk1WUmJ6ZmxuRlJFY2R4MU9oN21TSW1BdFhDWE96UEkrbjVma3c9IiwKICAiQTEiOiAiaWNyMldYdWhpVGlzUzZ3OStZOEVmaFZ1VktTNmttZm8yY3BDdFE5cWU3Zz0iLAogICJBXyI6ICJxWThxaXh1N3Mra2pkS1E4RXo0S0g0ekNpSVFBa1Y4NnVjWnozMjVQczNRPSIsCiAgImQiOiAiMm5jMjJ2d1FhNlBZUllWWC9ycWtwQTZPT0RvQjhqbnZ4Z21XMFJRWUprST0iLAogICJjIjogInBWL1Y0YVdGb0tIZHFMZU80V21iTllNd29ZNHZKNDcxM3RPWWZmZTZ4TDg9IiwKICAic1giOiAiQjJZMXJna1I1bzl6UFdkaTZIcDEwMk9kZ0dYcHpDUnVoSkhPTVNLSm5vZnAwZnNLK1FjdnBJTDNWbHlUUmRaSytiQ096QWRUWGpNWjJta2xBZkVxOUE9PSIsCiAgInNZIjogIkJ4QmJGclZsYkFJTUIyQzRJbkJLLzRHYUYyQUgwbERoY2hvMzMxc2gyaVBJejdvaXl6MzU2OWhqUldnZmJyVXZibklCSTVGUEw1akkyM08yTkE1QnRRPT0iLAogICJzUiI6ICJuZ0ZvYnV3RHFvbDR0Y0w4bmdJVGZxNDZFSSs5YnVqUDR3cWc1Q2JPOGwxNEtzT2E1cDg5VDlkdmJqZEJBdUw1Sy84K0orS3Z0djhrS2VZUDYvSVZMK25zV3psVnE4Nit3azFudzBVNzhOYXdjTnVURGRIdXJlUXhoV1l2V1g4PSIsCiAgInNSMiI6ICJCSzAweVFPczZDVk9UUkswRUMyZW5ncXByTTAyQUd3bzl3VG1XK0JETGo5dVNBamF0Y01OUjBQTnVVMklxVU13enpOV25OcHdPWjl3Y0p1VTM2V3JvUT09IiwKICAic1IzIjogIkNpU3FPSC9EZmlveWdnam1BZ1pWMmRxOEtkR0RycHFXaG55NEs1NysrQ1lZS24vWnc5L0hDVWlObFpTWGg4UEl2bnF5bFNYOXc5Vit2TnBZMytRcmVnPT0iLAogICJzUyI6ICItMTczOTUzNjE5ODc2NTAzMjQ3NzgzMzY5MzIxODc0OTg1MTg2NDM0ODU1MDAxOTY4OTg4ODM5MDE3Nzk2NzgzNzAxOTc0NDAxNzQwMjMwNzI5NTU0ODA0Mzc0MzQyMDI0ODI4MzU5NTkxNTI5Mzk3MDA1OTA4NzA2NjAxMjAxNjIxMDgzNzU2NTAyMzgyMzc0NzI2NzMwMzMwODM4NzEzMzcxMTM5ODM5ODU4MTUxMjA0MTUxMDYzMTQxMzQ3ODUyNjQwMDUwMjI0NTYwNjMxOTU2NTI3OTE2NDQ4MzcwMTIzNjI4NiIsCiAgImNwIjogInRNRzJVQ2dRY2lyUWRDR3JCZkNiMXJRa3RUMWRGcHNCaGtsQlZIRjFvc2c9IiwKICAic1lQIjogIkI3aVBkMFdFeEtBWlhPb1BwTWxaMnhqYjZqQkkwSlR2cEJwWm01RFplb2p6NEtHREdvd2VzUm9EQ2tXcU1zUTFQbGlFUDNvOEQzSU9sMGY0ZnBxS1VRPT0iLAogICJzVlAiOiAiQlFFWnV6US96cGs5UG8zYk1uYjNic1lNekFiMG5GTVdEL1B5QWpjSC9vSlMiLAogICJzUlAiOiAiTzU0aUpzZjJEemVFbDhFRGxSV0lCNGR2dkoybFFEOFFJbWVXRWNYYnpEanl1cDdWZ3o0dG1RcWhnVXNsVGl3MmxKRDZKbDgrWFJBWVJrVGsxZnZTMEE9PSIsCiAgInNQUCI6ICJyTFBSYzh0T1JpOTgvNGVDeE1oZVV1NSsxUDhnL0QzVXJ0RVgxTGlNMFJVUTkzSjVWSzRSMjVBMk9adERZbDNKVzdPcTlpMkx0cEtnR04xbFBwUlRhcmcrUmlTaFA2anBCOFp6SUVBWURHL0hNVGY3UW5tak1lVDNxR0EwMEV3PSIKfQ==',
-            'ewogICJDIjogInFjUGdBS2hVQ040UWpSQXJRU0k4dENSd3NDU1k0eFFuSkpFN2VhOEhBZG89IiwKICAiZTAiOiAiTnZJQUtOYmFiaWdaVTFmY3ZDLy91NWhlRkhIdXM1VDZDc0lXc0c5VTM0az0iLAogICJDXyI6IFsKICAgICJqVUJ4OG53RkROeHNZRlNTUGFXTHNZdzNCclBQSjR3akJNWjBSVmxsQUowPSIsCiAgICAiNHZtc01JWG05VlhMbmM3eHpIc3k2dlRNNEhuUkdSaXJpME8rdzVTVjd3WT0iLAogICAgIjZLdGhwTGw0M0tRY1hJRGNjMG52M2NXemExdzl5a1ZnRVluU05JbGp6eEE9IiwKICAgICI1aWU0dDYrS1J5NG1sVkR1Sklyd3pqZlA5TzZmdTBodFpPY1JDdHFUVHFnPSIKICBdLAogICJzIjogWwogICAgIkE0dDVMMXBUUEtOWUE2U1RVeFdpblNiWjVRY0ZUTzJjLzk1OU9KVlczbFNmSko5NmNOMDdTb2VDbytyYVlSSkhURGoxWW4zSk80RHNSemFVRUFxU1l3PT0iLAogICAgIkNkVXJtOEhjWW5XUDMra29teERwTHJ4NU0xWGRCdnpvKytFcGpLQ1JjbWNXd29kRnhOWVJkclJid0ovSTFvdG03MFltR0F4QjVTQ291eitZaUJnd0Z3PT0iLAogICAgIkI3WlJLVmJSc1lMWUUxRzFCMnZGdDA3ZTJvNnZCRlR6d3VxMkszTTBjT3I4QnpZZnpCOFh5MDV1YnVzZ1dsMXFncWc1Rm5RMlU1RVNJUmdCZDNtdHZ3PT0iLAogICAgIkxqWW1SMTBSTE9PaklYRm5qelNxNmpHajRXY05HRlMzSDNjS05JMytOODA9IgogIF0sCiAgIk0iOiAicXFaVE85a2ZlRGEzUURoTVpuZTF3a0lxZk4rL21TalpJekdFcVZBdXhSdz0iLAogICJDMSI6ICJ4RlVPY3pEdjBSV0FKK1hhREJMN1hJYXgxNDdwaWV6MTdWRlRSenZ1c2RJPSIsCiAgIkMyIjogImw1ZzVyTDloeFVsY0U5U1ZEekZSaDMraHBqWjVCV0pzTWhMS0ZHN3B6a0E9IiwKICAiQTEiOiAiMFRuMlgzZXhjSVpyWDlucUs1dVIwMFlhbk94TmxTZWNuVUtWb1pRalBUWT0iLAogICJBXyI6ICJrcDBMTHJXNWlQZUpFUHNVQmNKc3hhb09EVUpINGc4a0JGVjMrajBxNzBRPSIsCiAgImQiOiAicUpheGdUVkJpSTVvcndleXMwVzk0eFdqMVJxY29naFBuemlFV3RKaGpvRT0iLAogICJjIjogInNHeFNFRnRna3FPR1ZXQjRBb21QMlEzYmp6YUorUHJPUFB1Q21NVFo3OTA9IiwKICAic1giOiAiRFBWYnJmNmtuZzhLSTAyWHNpQ284ejBzQVk1ZDBlY0tGYUxkdC80WDhwZmQxMFlUMmV2aFhyTXdaVHhnUzNVK2dSMThIcVM3YVc0WW03Q3djNDZUWGc9PSIsCiAgInNZIjogIkNsOXk5dStBK2VSQk8wMUc3OTJRWkVPQld1UnBXdk1WTjhMYzNEMnFxTTlGODZXREV1YU1CaHcxZTNBNmkvQldvZ28zTVpZMVFBTVdvT2htQXFVUWlRPT0iLAogICJzUiI6ICJCT1ZOWWxPTlJ1OXdxSHRqZmNxdDRWNmVNWDhTNnhyVTYwT2N4M1ZSc1J6elVhK0ludmRwTXd5a1p3UHBFcFIvcG9GYm5HSWRQdFB2RlYyb3VrRWRYSjRRY3pLNCtOUFBqZXYvVzdSS2xqNHdUWXRlOUxqQllYUXBGellHVndpRiIsCiAgInNSMiI6ICJHeU5EY2w1dXVOUUt0ZFhxaW1MN1JlNGVZM3lsK3poa0U0SWFMRXNWb2lxLzJHR2o0ZW1oVFdZM2NqVFBTY0QwQ3VOaDV1RHBsUDhzZDZ2cWtaM3lodz09IiwKICAic1IzIjogIkJDcHdFR3hrNEtmbHhMcytjdlBsU0FIT1ZlbDIwazJhTzI1UnYwbWRNN01pUGZnb1VGc2FsZDZJbEFZR0tESGl5UnovZnBHZVNOVnU4MGcvMndhT3ZnPT0iLAogICJzUyI6ICItMzg4NjA2OTI2Njg3NDMwMDk2NDU5MjI0NTk1NDMzMjk5NjcyMzMwODY4NDI4OTk2ODE2MjY5ODQwNTE4MDYyMjc4NTQzMzgyMTA2NjAxNzA0MDg1MzUyNDAzOTU2MTI2MDUzMjE4MDEyMjA4NDA3OTkxMzE1NzA4MDMwNDEzMzIxODQ1MTkzODk3NzQ5Mzc1MzA0MTI5ODc1ODU2MjkwMDEzODE0MzQ4NDMyMjg4MDE3OTg5MzA3NjA5OTEzODYwNjA4ODc2NDc4OTg1NjIyNDIxNzE0NDM1MTExMDc2NTE3NTUzOSIsCiAgImNwIjogImtySVZQWGxGa1N5ZkdUcERNY1YrNC85WFpFZHJRVU40WkpkWVFtSDFQaWc9IiwKICAic1lQIjogIkNLQUF4RTdqWkVrV0hhcU4rTnhKWjN6czNKKzVONTY4akxJWHc1N01CWEVSQzhNUGtUc3J1anMyT2dOVjVhekUrZnRTa255aCtRK2hXYTVIV2xkRFp3PT0iLAogICJzVlAiOiAiQkNtR3c2elYyY2poMXA1MVpnQ2VuOHVUVTUvS1JZN0ZHMlpON3FCMEhaSjIiLAogICJzUlAiOiAiUFZ1eXQ0Mjh6MDZNc1VsdUxDcm82WDI0WFhWdm54ck53ZHgvalpOTzlyY01jbk1rQXh0dkt4ZUVzRk41WDhMRjFJa0U2by84ZjVvdFlvYlVkeEt6Q3c9PSIsCiAgInNQUCI6ICJCQkllbGF6TDNEKzdJWlJVVWRZTVR5Rlp4dkFkUkYra2ZqNUN6T1RwYi9LZVdudTE5ZWhRMlRhZnFZMEJ6QnE0Nk56YkZNSDBFaTkzcXg0UHJXb0k4STdVdzNJdXpaZFRreitkRmdqZVpBN1VoMXFhL0xQeE9kWUtZUWgrSERmbSIKfQ==',
-            'ewogICJDIjogImxiT2UvUFU1cmZDcjl5QVFDaHc1SkxiSVpYbWNkV2ZoOCtsc1NRNFhLZmM9IiwKICAiZTAiOiAiVjBmUVNVa2VidzBFY0t0bW05OG5FS3BBMy9GZ2x3a0R5eHZhY1RrNmpWVT0iLAogICJDXyI6IFsKICAgICJoSFVFSjYwb0lLM1VVanlWMmNGOW1VZHYyazVtMGh3R2RGMGc4RnNlNElBPSIsCiAgICAiMDkyMDkxazJnQzZoR2NlYXpIYzYrdmtybFhJNUVGK3JId3Exd0RlV3JrOD0iLAogICAgIm5RdnR3VVh4WVpSV3NyaWc5QzhHVlJzcFVWME56MDJURW91RWtSVU9EYkU9IiwKICAgICI2SG9sMG5ISEczNWszM0l2SCs0UnAxWExiQ1doZzZna05iTWdVdXdVdGM0PSIKICBdLAogICJzIjogWwogICAgIkF1a2FQZmk2L2NuQ2JjNVRmOXUvN1lnQXZTVjJiNElpYllyQ0pjb1JVMTR0RGlRMEFuWGtQQ1BmMHhrZWlMcFBSbzJlbk9JUVJTU1FTMmtDYXdwNEVBPT0iLAogICAgIkJBclZBWnpFZFliVklYR05OV0RibWk1THBWcjhXMU5XOFJLejU2K1BQSkJpSElHRzlzb3A1Ymg1Tm93SjMyQnlQM3pnaW5UTmp5S1kvbU9nREU5QnlnPT0iLAogICAgIkRoQUxjVkhld3psaU5WaGlIeWx0RXo3S2pDaXpOZHYxa05VdXl5MVVlVU5qNjZ1Wjhya2NnblN6RDZ1T05NTUprQVdzMUxvT0M1ZFhCdVdXZlRDVmtBPT0iLAogICAgIkFpaXZ6end0ME80NFhEMXo5RDN5SU4vUmpWL1FCcmhoV3hqdFlncHFtSjQ9IgogIF0sCiAgIk0iOiAib3dwVUltZjc2UTRPYmZJcWtBdjJRWFhCdUhiSGtTaXZHaXBrUW4rYXpiND0iLAogICJDMSI6ICIxcUUvaHU3aEl3WUdCVGkxajU0N2dEcW1LWnZGRVN1QURYaWtMeGRRdlJZPSIsCiAgIkMyIjogIndVZGpRWjl5SlpRV3BvT1FwMDM3dVZxU05zdDF0SVVPclB5VFZDc3JDSTQ9IiwKICAiQTEiOiAienpYc2xKa3JZa2gvL0xubERYMG56TnZ2Z1RITmdqYzQ2MEVad1JHRUYvST0iLAogICJBXyI6ICI0Z1JMRmVZODh4TERDWGFlT0FVSHNuSVVLTkJUSksvUWZyZmhtcmtVWVdzPSIsCiAgImQiOiAielRwWDJndWZmY3B5YVpvRXpsS3NVMG9HbmZ6RGYvcVdvbnFUUVFBT2RxWT0iLAogICJjIjogIkJnTlFWVlZwMGthUC9NRXRaS3dDRXZ3SU1EUUcxOEkvM1lqK2FVWFM4Z2M9IiwKICAic1giOiAidmFIVkhPT25SbHErdGxSMFdkRU8rZEpuSlZRLzl0UU5jTFNJUTZ4cDFmR1RPTTUzbVJIK1VDRWh6MmV1RlgxbzdXTEs2VFZLUnVpeHQyS0UxSmRVIiwKICAic1kiOiAicDQ4WWNJNDBQUG1XQW05VU5kYlJpbjU5cEZzWnVwQXIrTkd3RXZyWFhNTlZtSjM3SXJCYjVLM29RSEhTdFlNUUdBejBqcGNOejdYVFBLcVkvM0MzIiwKICAic1IiOiAiS3NZaGpocFA2OGNMM0hxUUpGZ29QbWVhZ2FtRnNGcnIzdi9FRjErZ244bm9nUUloQUJzS3V6WkwzRWpkVkJwbkFwNk84bHpuODNyazE0OTRVcjNxd3ZBVXFBRGl3T0w2N0hWYitDMUtRUTJodStmUCszNHM3NUs4V3lBUjZ6TT0iLAogICJzUjIiOiAiVE5KdmZxT1hyQXBmdUZtSDRoVGJBdmFTSHpITlowelVJbWloWkRpTzBEb0NGRmJxRjUvelFtZU5GbHZNMmNVRHVyUmpNVHNKeGtKU1ZxK0lEVDB2IiwKICAic1IzIjogIkZ6alM3VWl3WDNmTGlRSmV5QTVhZHE0UkNsbURWaUxhN1RPNkwySTQrSEM0SmZYcmp0WHBvS3k1OTlYN2xsTTFlSm5KUHM5Y1U5RDNLd1M2V1lKKyIsCiAgInNTIjogIi0yNzQ1NDU0Nzc4MzAxNzU2NDI5NTM1NDEwODgxMjQ1NjAzMTYwNTI0MTE1NDYzOTYxNzYyNTQxNTQxOTg0NDQyMjAxMDk4NTU1OTA3Njg4ODI0MDAzMzU1NTc1OTgzNTkwMzI0MTk5NjY4ODUxOTQ1NTUwNjQyNDI3OTM4NDU3MDYzNzMxNDA1MzkxOTIzMzA4NDI0MDA2MTY3Nzg1MDg2NjI3NjU1MzYzMDg2Nzc3NDUyNjgwNDMzNDY5MDE5MDg3NDY2NDg0MjY2MjI5NjA3NzE0ODc2NzczMTM1ODc4NTkxNiIsCiAgImNwIjogIlpWVEZVaGZicGpoU05GZGJJZ3IxZ3ArcGpSZHN6YWl5RWdJeWZMelNMdUE9IiwKICAic1lQIjogIkN3ZTdQa3diTVByUWZEV0ZSRyt6RkszYTM4emNQVzQ2MHpOZHVzaGRES3dZNStVVlVNY1VUWW9GaHZMSlFUSnJ0dWlWZjZHZGVhOFphdGhiWHA4VlR3PT0iLAogICJzVlAiOiAiQXV5Ty9WOVRrZFN0eW03NnZJcjZNODNhQTZXbkdDbDg3bnBTdVpwOFNXU1oiLAogICJzUlAiOiAiSVNzNnNjdnowenMrWURMejFIZzN0RGkva2lTdmVnQk5TM2tQTmFmOWYwNmVEbVhZS3B1YXg2MXQ5R1QvdlNNSGRwTjdKVlpSYmdtRWsveTNiY0owTnc9PSIsCiAgInNQUCI6ICJBdERWYTRFaWFnUjVabmFZUWV2RkI2V3k5MVBaRTY2bVNHNHc4eTV0S0dSb3JCbUI4UWxOV2xkMndYWTRWeXdVWlJmWEVUS0diTUhTYk14NVF5c1ZrNnorcjQrWm1mTGpYZlNiNmxEelYxWlBReWRya3pTTHZ0OVUwRmRhTVg3ViIKfQ=='
+            'ewogICJDIjogIjdwMHgxQTc1SVJBK2dqTVVmejFjKzhKQUtUbXFZRlh2QUpBY0N3NE1pclE9IiwKICAiZTAiOiAiV2dBQ1VkaFVuQ3BEZW9rbTRFL2FYczIyRWY0ZVY0UWpGR3JXVEhSVlVFMD0iLAogICJDXyI6IFsKICAgICJ3YzM3SDZrTUh1S21LQkYvUzR3YzlGSThrM2NGa1I1UHBJa0hJSXR0N01ZPSIsCiAgICAieEdBRExRSWpZN21JcEZZR2o2TG10eVpkVEtsbjdFaWVwTEtCVXp2TGI1OD0iLAogICAgImdqaVlQZU5lcnJWS0pqemhrcnUzRXBBYzVDWGx0cFNjTGxUUFAwZlJiMTg9IiwKICAgICI2RFdWQnNjc1orQmxNOEd2ZmNTbDIzMG5XZGhYVWdqU3AzeVpUK0tLYVljPSIKICBdLAogICJzIjogWwogICAgIkRJVkZXblhvRlpVR0R1L1dXanAwbm9XZTRQckpGeWQrRllvZzNvYjJDMlZ2NnBFRmZGd0lGL1FDdUdOajFpb3FkZ1BqUzE2di9WY1FLTEdWZ2txcWhnPT0iLAogICAgIkNnay9CK0theGNCSVh6SkJzVmZsY0dmSmdGVWdPUGdacVFwRmRLWHRmZHVjOEZYajZIUVV2SWNOM0xrV0R5KzdGQVBpMVQycXdqY3NUNUtWYzQwTDh3PT0iLAogICAgIjQ4NCtVNGF0UHdYZDkvSHNXRHR0Z3JYdk1DZm1yRmViNXZzVzUwR2xxNW1OM0o2dHdQbWlyV0tnM1plZDdBWHFEYWZCaUJvSWtxOCtwMnBUd0t3UyIsCiAgICAiRmdYa3pqK0ZZMFUwSlVscDUycFlxVlFzT3NwSDhaZllPYTFLUjRqQ3pXZz0iCiAgXSwKICAicXVvdGEiOiB7CiAgICAiQyI6ICJ4VlBQQ1o5WFJEUmdmMWNsS2MrdUpoV2tzdk81eG5LSnZtc2hXN0FzbldrPSIsCiAgICAiZTAiOiAibTRjWGhLajR0bXhRU1p4dFViQkpMay9ESDAvWVJOVDVVQzM5c1d6UE1Dbz0iLAogICAgIkNfIjogWwogICAgICAiaHQzUkZFUjA0SUplNGkwOVNVdHVZUm01UDcrNUU2YzlVZlVOd284S2VDWT0iLAogICAgICAiMTF1Q3BoUnJBcVhhNHBkVVdwNjVUWG9zMEp4U2NlaUFtOEcvREU3b0l0QT0iLAogICAgICAiM3hwMHlNUGFCdWU5RjdzWlNKOEpDdEhxdmcrSWJIaVJzcEgreVB1SEJyTT0iLAogICAgICAiNkFKVFBjcnArWHFFRnBkNmIwaS9OVEZoNjdFZWVmb0J1VCtuZngwY0xBST0iCiAgICBdLAogICAgInMiOiBbCiAgICAgICJIVUw0TmM5U3M3MHE1dXhrSHJkZXh2czZPUStSeGgrZFFyOW8xTWxpZ0JzPSIsCiAgICAgICJNQUdOWEk5Tm1pOS9RSDhJL04yTUtaeU9CN2pRMWFtdWtrRzR6NXRsV0FjPSIsCiAgICAgICJLcXA5QmxHMWgzM3c4RmVJby9UemF5UHRybVUvbnN4L3pFRnU2Z2JObHRzPSIsCiAgICAgICJHRmdMNkJicmJTVG1INkR2Zzk4OGhNdklyeFVDQ2wxRUF4RGpYVHN4TmlGblBWemc3czY1bEhmVlFsRGx5TWJjVEsyaWNkWW5Tam5TTGgxcmhpMndidz09IgogICAgXQogIH0sCiAgIk0iOiAiMW1mZm1uRk5aMWszaFA5VzJRWWt1a3RVUnpCT2FoQlRrbjVPTXZPTEF1ND0iLAogICJDMSI6ICJ6c0hZQjNNbmJEYnRLUmFQVEpRK3RFZzl1ODBLcDlxay8wZTRaUHo4QStvPSIsCiAgIkMyIjogInh1Rko3TUhwdlN0bXoyazh5R3VsQVFGK20vbHZiUWpwUUUxVysxSDV5Lzg9IiwKICAiQTEiOiAid2h1YmprRFg3NHgwUTdvSlBEWVJqbkluOFNoOHNKTTZhTExmVkJPV2hEUT0iLAogICJBXyI6ICJqTHFucU1IaTdjOGR6UVlwQ0JaYWtQVzY4TmM2dktIWFF1SSt0MUNTVkZRPSIsCiAgImQiOiAia2Y5Y3gwNmNCcmFvZE51ZC8yV3JZYW1HMjUvQmJiM2tCR3MzdWdUaDdmWT0iLAogICJjIjogIk0vVnZHUWM5NFpzYzBrZnZDWTcrTXJXT0tjZ2dyTHhwY0ZNWWloOTVnWVk9IiwKICAic1giOiAiQ2JOSGtQVjBLNjU4d252bXZPZWJMMHhKYm1HcUc0bDVGWEM5WFd2RWhnUTBvYnZiZkZnT2x0TXFwTTcvc0dkUEhLVDI1MUVGbGFDN0tmak9Pc1pNVVE9PSIsCiAgInNZIjogIkErTThkMzZvVjhMeWc1YXVYWDNEVURqRlhGdGdmK3JwYTFvbHkrTUQzd3VXR01FRHZsMEdISVBoY2JibDYwaWFmd0k5SkxjN1FkY0FOcDRsUWRmU0N3PT0iLAogICJzUiI6ICJGSlFzdEF5eU5seFJTTlRJWXFJS2RvbzlocnRmS21URjluZUlJam9iWnFvSlk2S1NRWU9MYTBBd3lUOCt0S2I0WGpBZktWYWFkbTd4M0tVa2xSZVR1UGNKVkY3ZzNnMVVmZVVGc2dHb3F4Qk5zWXJOTjQrZmdSUTlsZzQ4Zm9JPSIsCiAgInNSMiI6ICJDRUtkaXZ0cVBzNUlxcm45UlFGSEM2dzl2TXltLzEvSjJMWUt6b0FUYnRONHJPN0FJQzNRNFc2L2xQL1dDbGVoMjdVM1gyelowNUJ2Tm1YcDlaTlVmUT09IiwKICAic1IzIjogIkFjR1VDVEhOZkEwSHEwZFV2SndiRnQ0bEg0aVBBbnBLL0c2NHg5S3h0WFBIRktYNWJqMUdNaDlONldwRjRqdE1SOXJOTVRuNGN0OUYxVHhxeVFNcW5RPT0iLAogICJzUyI6ICItMTY5MzE0ODIwNTIxNDIxODU1NTg3MzMxMTM3NTIzOTI2Mzg1ODk1MTQwMDc2NDg1OTI3MDYxNDk5NzcyNDI1Nzg3NzAzMTExMjIxMzAxNjk3OTY3NzU4MTI2MTQ0NTgzNDk1ODQwNjUzMDI0MjMyNTA2NzMyNDMwNTczODIxMzQ2MTI0NDU4MzIyNTY0MzcxNTcyMzg0NjAwNTMxNDAwODMyODYzNTY0OTY2NzA2NDc0MjE5MzI1MDg1NTk0MDYxNjI0ODE2NzY5NTc3NzYyMjMwNTI0NzMyNjAwNTQ5ODIzNDgxNCIsCiAgIkNxIjogImhjUmFlZ0pWby9mRnp4VTUzQXozVEZVemxzempjLzJLN0dnalhvYXNBREU9IiwKICAic1EiOiAiQTAvaXBNbVhnalhMd29scTBxeEVkdk5VQUVURldNZXprZlkwS0ZmWHdETDQiLAogICJzUlEiOiAiQ0UwRUNmc3Y2VHhzRzBEenkxSTd6d2FTZk5Kc004dDVPL0lOelpGZUxXUkIwMWhncGtZWjl5bnRxVzQzSEdRQkJIcDFGQzZUeFZZczhKMXVSUXlZb1E9PSIsCiAgImNwIjogIkNXOFMwMXBXUEpuZjZ5NTZ2NU5UTjFGV0ZFdFE2Rm0wRE13YzhjcGdBQ0E9IiwKICAic1lQIjogInRMTXdxOTFETEFscEZwd3Z4Y2QwYXg3MkRQQ09jRHJNT0NqVEkxTE4weDk1WXZLdEZsd3hFUmNDd25nNzAzaG55bENaYVVIZ0ZPSWlOWDVYcDJtMCIsCiAgInNWUCI6ICJUQ3pLVEhHNmRMVlpoTC9heU5uKzg5LzFrU0NoYUFXdWZSWEpZQjMwdm9RPSIsCiAgInNSUCI6ICJBMnlaS2NQcjhiYmZtOUxwOFZRcm1LVSthWkJhOWkzL0hkTTJ3MjNLNHVQc1V2end0a1Z5THVZcktQR2d0OFlHSmlkOXk4Tm5RRHhrbkFWTHNvQXdOUT09IiwKICAic1BQIjogIkE3eUQvbmRQZWxpbjY3aFIzcjFMWm9qOElsV21XVVR2RE5MM0MzbGtJNFgyRkcvU0FrUzg2Wi9MVHFMd3FMTTFIVTV4N1c1dzJjanM2aDV1T1VKSkJPaDYzQ1ZvZzN5Mzhzcjd6RlpnNlI4RXppSlIvSTduM2FwZTlJaitXaEE9IiwKICAic1FQIjogInY4YnpIUXdEQ0Y2cDd0c2pYWDNiajVnOTFud2c4Tjg0Z21uSERlUHBRK1E9IiwKICAic1NQIjogIkIwc2k3am16WE5NM0JJcjZvTDZvS2dYVndQSnJwMWp2YTEwTUNGenpTMnUxMmprT3QvanVZdGVXT0dSUXlzc0FjN3B3TU1QTVhpQnhhSlBoYmZEZElRPT0iLAogICJzUlFQIjogIkFZSFVDZ3pud2lrSkVhSDJMRlhuOUNadjJBODNZb3ZOUUJuZ3hta1p4UHdkSU4vSHNpSjNEL3ZwcGg0Sk1aUzRHR0hwUkgvS2RUZDQ3Q1VvU0xycGtRPT0iCn0=',
+            'ewogICJDIjogIjU1MlpBclVWZEl1ZVR4cjZGZU9EejNQSGo1RnVVWlQreFEyNW9HWUdqVGc9IiwKICAiZTAiOiAic01RdzdPYVZzMnlQUVY5QnE1NkpvcDQ4dTFQY1JickVHVHpJYVhrVm8wYz0iLAogICJDXyI6IFsKICAgICI1b1lDQS9GWUVZSm43dnpFc3FPSUxucy8wZUZtU2RQSm1vRGdJOWoxS244PSIsCiAgICAieG1vbytqZm5aRmlxOUYrOVdkc0JjVUIwQUh4dFJkNWwzRHBDYnVYRGxXOD0iLAogICAgIjVBRXlvcmpUWE1yUXVDUUVUUUhweEdjRXhIbzNWc2JKZW5KQ25JNit5MUE9IiwKICAgICJrYm1TLzl5N0JsVnFuanJkT0Y2cSsxZHIzOFhDL3QzN0dUc29wd3I5T0lZPSIKICBdLAogICJzIjogWwogICAgIkRESHdVcmNhcFVnWHBzMFRWYTBHZ000bzlONWk1NE5EZ0dRRUI3cDI5SERMeWJuUUFSR1doT0JtOUtUSU02M1FJR1dTNlViTlh6OXVwMDVmcEUvYVd3PT0iLAogICAgIkhWbHB4ZXBURnEyWlkwOTBVa1hJOHF5ZWwvN3o0eVFtTENtZVVCdTlxblhSc041UXd0T0NJN3NLVmpkMXNyQUNDeGJxS0J4SXdGS3loVlA0d01aekd3PT0iLAogICAgIkMvTGtQNjFwdTFNbUZLUkRSSWw3UWxJMk5qNnI0SzY5MlloaEpYc2xCYzJ0R2NMdWIyd0doTGdGWEt2YTJIbG9oc1FsZ3pnNDJ5bmpMOXNXcUsvRUNBPT0iLAogICAgIkFWcTVhRktzNER6UUc3RUFpQ1Y0VWRRTFI3bWpFWWFIaEE3U011U1FpZUU9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAiMnJvVDd0NHptRENvTkNmbjhUV2pUVTBEVS9yZHVlMGxqeDRWc1FTV3dtbz0iLAogICAgImUwIjogIm5oQ2h0UEhIM0hFVWtzY0dQYU9ZT296dzhOdjFFNzVGQTcyUWVTU3N1Mm89IiwKICAgICJDXyI6IFsKICAgICAgIjZqOE9OaUh0U0p4T0hENUxwZ1IzK1EyWXBIOHk5aHZVbi84V0xvUHREOEk9IiwKICAgICAgImhaSHlzNlBXblZ6WE5BMCt6WkorUEkzMmI0STlpdVVSbGcwK08rTjYwTlE9IiwKICAgICAgIm1OQ3QzUnRGTk1icWNOQmJXQXRDZEZrNG80L2E1NnQ4WjZaYkwyOWFTTmc9IiwKICAgICAgIndIMUpYcWxCY2RkYmljYkJkeE5kblpUUU1jckswcVBKbXBLNmswYS9tRHM9IgogICAgXSwKICAgICJzIjogWwogICAgICAiSUdUejRSSHVnYnNNN3lhQ0tNOWh0SGYvRit1ZjI3S3hlYnRwSU1xVFhYcz0iLAogICAgICAiTE9sa0phcFI5MUY4bWRuYTE2L0JOaGthM3k0R1hSRTVJOXV4c0tNc1NwND0iLAogICAgICAiR1JTdkJyMHJGa1NRTmt5MjcrZjMxa2pnS0FjNE9WcVpDMTUvMkR5OC83VT0iLAogICAgICAiRjBzeW1WaWZkYXRwUUdnVGVTbS9pNzZyUXgyd2pWcU41bGFPTXYyR0ZRTTF5a2hEcVpDUTVtN0lIVWZaN0pBTE93STJPc0FKajlzcnptWkdKZkg4SlE9PSIKICAgIF0KICB9LAogICJNIjogIml0OVUyZS9uUjVhTWovTGJPaWtzZHV6L0JBSDdTcDdVTzcyK0lCOVZTbDg9IiwKICAiQzEiOiAieFN0RU52RFRUMmtudU14N2hXVnBTR0k0M0M4VTVQZVkvaVQ3MHl3UEhUYz0iLAogICJDMiI6ICJtRzBRaXZVcHVndFFRUlpRZWM0UlovZG9zUXFSSDVSUzZKYzZPZXRjYjJjPSIsCiAgIkExIjogInhDZ0pnQmJ4dkk4RElDQm9BcTNCSWZ4TXlsK2EwYXhHVk13L2RVMlFXOVE9IiwKICAiQV8iOiAia1A3KzFTYVVvZW5QMkFKZ2Fxc3dDOUxJTjZ0bmtpSjlBdHBZN3VmRnZvTT0iLAogICJkIjogIjVNdXoxdlduMHJxY0JGZFlLblBnL0Y2R2JyYXVXMEg1WHJHWlJKbEhxRzQ9IiwKICAiYyI6ICJqbzY2WXpUQnNDT2hsZS8rK1gyM3p5VENMWUh4MDZjOVpvVDd5WS8xNE9ZPSIsCiAgInNYIjogIkJMSU90c1hVczZGajFDZ1hZMElPS2NSREJQVDdySEhzNzJpSEw4WEcwejh2ZnRFeWM2VkVZTU9GYUVGTlJ6SzdOWjNFbVl2MVlpdWJ5QkdrRktEYlFRPT0iLAogICJzWSI6ICJEVlVaamNUcTdJMmhzSWRnNXdVK2NWNkU4citkVVdtdVRvSnNQKzFXOGgrL2NUcnk4RkRNaWU5VUg5MnZIMlJtdXNJaDVyYmR5TzhVMVZqc2JlM056dz09IiwKICAic1IiOiAiUGpISEZPZ0psRTIvTkpTQnF4a1FEMHZNNmtiaExEd1BEM1Q1L1d0WVk4YTJvQWM0VEhCKzdaOFRMazErM2NpTjFkeTEvZGY5OTljYURxdUFvK2xGditScXpLY1FhM1RqQkYxSkg2Wm5USGlhdUIvbHgwanpWNzQ0NzZSQ0t6Zz0iLAogICJzUjIiOiAiRVFjNnU4dHc4d3oyTlY4YkNTY2pNWTJIbDZhKzhmRy84eTZBaE1oQTVnSkRMaXRXS1I3cG90UmhoRmYzUDR5c0dCTmFTcVBnVDlqamVBT21BZHBJUFE9PSIsCiAgInNSMyI6ICJDaUR6NFJlTCtiTFNaeFZKNDlaTUs3Z0d4SkFJR3h4bTMrSmtLSmdVcXhEZkRtN3JnYXB3MGZlTkt0cVlidFNHc2hMZTFNSWJHYytBOWdXSC84ckZqUT09IiwKICAic1MiOiAiLTczMzcyMDk1MTcxNjg5NjU3MTc4MDM2NjkzNjY4NzI1ODAyODc1MTE3NzU4MTM0MTA1NTUzOTQ5ODk3MTY4OTY3MDQ3MTQwNzMzNzg0NzEyMjE4NzI1OTU3Mjc3ODg2MjA3MzE1MTMwMjc2MDg4ODEzNTQ3OTA4ODkwNDI1Nzk4Nzk0OTA2OTExNTgzOTY4NzA2MzI5MjQyMjc3ODI4NTYxMjczNjg0NDQ3NjM1MTQ0MzQ0Mjk2NjQyNTYxNjg1MDA2OTE1NTc2MjUzMjkwMTExMjY1Njc3Mzg2NzEwMTk0MDE4NTEiLAogICJDcSI6ICJxWUhiK08wK1gwS2RxT0pHQ1hRK0lBU0FxL0djQlpBOUtSVjBLbC9mNzJvPSIsCiAgInNRIjogIkNSRUpIaitPUlE5TmtTVi9kTEd0Q3RSbytVR2tQaEI2UCtaQnBwWEJUTEQxIiwKICAic1JRIjogIkNJUDcweEFFYlRlWGtLc0k4dCtKN1g5R2lOYWxnb2pXNTNHSWp2cHVMVDdJQU5RdTJiSjMyYzVuYklITFNtdzRlRlZpTnJlTFYyd0FQanNqeEVEOG1nPT0iLAogICJjcCI6ICJUeE1QaTRuZThBR1QvNUF2TkZhN05BVzhFSW9IQTBXNitCZUJWcWlnWkJnPSIsCiAgInNZUCI6ICJCMlV4a2cxL0kzTkgwNjF0RkdIMlN0eENCRkhSd1l6eEU0cnV4Y1dwWjI0aHUyVjRDN21yb1dscnRHaFAzaHZJTEptK3Q1d3hxalhTeDhVT0dleVVqQT09IiwKICAic1ZQIjogIkFqR1BqaUdqYytrakFVSC9VZGFKQkVKQjVIY2RhN2tMejdZT0hWVUlPdHJWIiwKICAic1JQIjogIkd1SVJqVkdWZitEZjgyZmdqNUlYSmt0UWVvTTU3VGw0akhxK1gzdEpaYmQ4bWFrS0xiY0dXdjEvTDZMRlRFaWlzZ2o4YW51ZnhodDdRc3FMaGVOZU9RPT0iLAogICJzUFAiOiAiSW4rUVNBc0ZkNUgwNmJ2S2ZjSUFHZmNoM1pScm84QW81bnJXZFlUS1ByV0RRSXlEcnNDMW9qamJkcXdqMndUK1dueURKODIwaWp6NDFVU0RNcGpCQXBGaXNyR1hJMmNpR3VsNWVWbU9VTHl2V0U1bHJLb1RUN291ZkNqaERybz0iLAogICJzUVAiOiAiQlFvOTVBT1V0NHkzb0JEVDVXY1ZkdXIwOW5VVVVuckZCZVVBU1lncVdXd0ciLAogICJzU1AiOiAiTnFyV1RsR2JPejlQbW5KbEFJNnZqWVNoSVRORVpEYUFPcVhNZHZPVENxekQ4czVnbnFxZDlqcXNYRzZMZExSdk1JMHpFOU1RS3Rab0JNSXpEalRyN2c9PSIsCiAgInNSUVAiOiAiQkxrMEJWR2RUdEg3cXNHcUM1bFBlOXhaSXBpUFhXekJtWDRIWVNtdUN2cjFyQ0V0UzlvRzlLUFh6L2NFYTQ5SkVUVWp6aDdSRGlXZ0FYbEc0MG00SEE9PSIKfQ==',
+            'ewogICJDIjogImljVEgxQk9PdHR2TThPb25GWGl2R2pZYnpjZFp3QjhFVGRnRlB6YXBMQ1U9IiwKICAiZTAiOiAiZTd3N0IwMWhqcDZjNWVPeVd5Z0RrdEo2eG4yR3ZvUGluVnRid09wbXh0az0iLAogICJDXyI6IFsKICAgICJsTWxBbG9VR0tnNURUVCs1cjdkek9tYjlMSENsMENXb2NnemRGTTVSdFhZPSIsCiAgICAiN3FSMkgrQWZiYU53UEVkSENxUTUySXJ5MTlwR2drcFpudGdQQlM4Wm9YVT0iLAogICAgInJ5Vmpjc204UnNCcWZDUzFnQ3NFc2Z4WHJuLzc1Z1U5TW1oNTZmcmZHRE09IiwKICAgICI2S3R1OXMyWmRvbjNZZXNvVHRrbDBNZHJGSVVDMENzaUc1VHltd21CSDFBPSIKICBdLAogICJzIjogWwogICAgIkRRbzN5OUJIUk1qVXI1UmR5WjJXZ3pCSG5RK1liaXlwaU5LOTFsK2EvcTRqbjVqVUVMUG9peGVuNXc1VFVlZEFON2J5czdZNUJCeGdyQm1IQzFkdjB3PT0iLAogICAgIkNYNHJIQzEzNVowUzk3R1hUM0N1Tno3ZkFYR2RBN2JsZEl1bjdubXU3UEFlaUZFeVA3MzBxUFoxb0dIdTVMalNwUVRDUmIvVlFrWHJiUGh5UGtsTlBBPT0iLAogICAgIkRncGF1b3hMZ1VrVGwvZjRLcUNLSXhxSEZRNHFLSUNSWi9mN1lZMFEyZXNrZkNpODlYMThsVUpLZEk3K0QvRGY0bUJYanprOFd2b2pud1FZckwydVJnPT0iLAogICAgIkJrTjZ4VmtKd29sZG90QlRGd0xZRXFRc2pRUTNlVDlqajRLU0cyYm1Wbm89IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAid1VEaHFFekVJSHI2azhoT3hCM20xRCsxL2VpM3FKR0lpaWRzam9qeVZXdz0iLAogICAgImUwIjogIlNIT3N3RFZidksrZWVzQ0JuSzFsYlhmYVdNZFFHTlhCNFVEL3ZQRXZOZG89IiwKICAgICJDXyI6IFsKICAgICAgIm9STDVIY090UVB3U0hTVkUzejBYNFM5S1NvTGpDNWpseE1sb3hJb3dGQnM9IiwKICAgICAgImt2Rmt2T1dMZVg1STdCRlU3bHVpWmVSWTFTdHhxaFB3ck5HQnZLNHpFa009IiwKICAgICAgImdMUitNTy8rQnJUeVN3YnlRb3cxeUJKdjYxQkErUmx0Q2Q1MnY1Q1FmcUk9IiwKICAgICAgIjcwWFo1bUNoMnNIbFZRR3hHcnQ0ZVZsL2hkOUxMZGh3T1VMdHVPeXlpTUU9IgogICAgXSwKICAgICJzIjogWwogICAgICAiRzhDb3NjRFFkWkdsbWxZQ0VJUXdla25SMUFqWncyMU9ZMXNNZGVvSXNXVT0iLAogICAgICAiSmFhT3pFbU9lUTQ5Q25KL1BOc3o0Y3JPUmNFSmF4dXhFUldDbmliUDhLST0iLAogICAgICAiRmNYT2pkMUx0MDNKQ2VjdjN3emp3Nzg1SmtZZ1FUTzZGOGU2TkdjTWNhcz0iLAogICAgICAiQmdkSzd1aEFTci9ZT3BTeUxnWGJyeHBHMW9raGxIQ3NFOUxtSkJTRGRsY3ZjeisxT2RWYjB3cUY1bHNQRnJoWEVtZEZQU1owWWtNVkF1aHlnT0dSeUE9PSIKICAgIF0KICB9LAogICJNIjogInFWRTVxS3BiYTg3bTZsczVkY01aeWc5RmtNYlhFRDhnZW5DTTB1V055dVE9IiwKICAiQzEiOiAibE93dUdQWTlpWlNjQ2JjTGZMZm12eDV2bnVnNDFDNyt0SHVvZmZISnhWTT0iLAogICJDMiI6ICIxRFdydjRaQlNncy9ObTNPb3JKNVR5czBrRDdVWEJJNlQyU0IxSTZCSVQ0PSIsCiAgIkExIjogIm53U1VTYVl3TXBnbGhVMnNld052ZHpOUUlXcHRhV2ZaV1lRS1VGalA2QTQ9IiwKICAiQV8iOiAiNDZHZ0pGaC8ySlhwM0tLNkZSM1lXQWpGWUl4T016amJHVDR0L2VBbUs5VT0iLAogICJkIjogInFGWVJqSG1NdlFQQWJkSzE2SWdCRllkaU1mU3lJRWwxOUZvRmM3RE9BT0U9IiwKICAiYyI6ICJWSEFJMU5jZUhaYWZaYXhPd2tJcThLNW1GSW94YTdRZEt5UngxSTdIdExnPSIsCiAgInNYIjogIkIxclovRHdTNVNBMXk5QnN4L05lcUNMcmZFWVAvUGpSM3doaWE4dGRING1HaUJpMmZPRWhwTGdhZDl3cVF6V3F4NUN3eFRhZ3VrN0xtR2tUcnNDbTd3PT0iLAogICJzWSI6ICJDN3lQMXFDQnU3c0hoZnRXVU5aVEwyQnM4TmFWTnJraTJ3T2JUcnZxdWd4YlZOUzNpa0M3WTMyVGU0Tmc4ZXRpLzB2T3VyU041MlI5WUNMUEREOFVMQT09IiwKICAic1IiOiAiVE5ZV1o1amZyMjh2QmRsSE1nUDVJM1lLZFlEcXVhektpbC9PZm1mQk9vWjZtS3BvUG1KS1dwcXJBcnoyU0ZjRVQvWnhxUU5zNmdKdG9QNG1hYk5senZGZVgyUGtxTWd6VEk4cWJ5bmtUeHBvOWtEbFZEc3Yra29oMjZjWG5aST0iLAogICJzUjIiOiAiQ3psWkgvQWthSWxobFhET3FVMXYweE43UDV1MnIxVER6TzNDTVNHNWlITzFXMDJaK2UxSzlGN3pVRHpoeWo1UnpjOG5NM1JWZ3pNdHBWNzQzTEVJMVE9PSIsCiAgInNSMyI6ICJDM3F5KzdkT1o3SlJCT1Yvb1d5WXZVMEZBd2N0aUt1YlhzTEpNdDlOS2RrQjBsekE2ZjE3aVpzTHZvQlR2Ky9rMTVmUUEyWFJwdG5ja096OGRXaWZvQT09IiwKICAic1MiOiAiLTkyNTM4ODQ4Njk0NTI1OTgyNzA0MTIzNzIxNTcyNzUyNjA4MzI0NDE3ODIxNTE2MTExNzQ3NDkxNTAwMjcxOTUyNTU5MjI4NTIzODYxODI3NDc5NzI3Mzg2MTAxNTkyNDIxNzkwMTUxMjYwMDI2MDUzNTU5NTEwOTgyMTY1OTExODgxOTY0NTE5NzcxNjk0ODUwMzQ1MzQxNjQ5MjAwMDkwOTI5MTc5NjQxNjc1OTkyMTMxMzQ3MDk1NzE2NjU2OTgxODg2NTczMTc3NDUzMDU0MzA3ODM3MDk1Njc0MDYyNjU2NTgiLAogICJDcSI6ICJyYzMxQWZBdlBDK3dpRlorV2sramp4M0dlNHBkcHh4bVJUM1BodTh0eXhVPSIsCiAgInNRIjogIkJWMU1CVE5ickNXdXYvbDlHYUFOU1dLbkxyRUVteU1leDRsRFdWR2lEaEZxIiwKICAic1JRIjogIkRubTM2U3ZGemtZWFhpeVY0YmRjcUh2TUxUZ1Vmd2c4b2FRTDdjeXozUVFCb2FXTldLb1RlTm1KMzcvUU9iaUZLNUV4Tkloekd0b0FWSzBQL0tvTkhnPT0iLAogICJjcCI6ICJuTzhZOWFiTXFGWUhxT3dWc3JDZW1ueHkvNDkwaFRtanNaODJobms3NnRvPSIsCiAgInNZUCI6ICJGZEEyMDNZM1lpa1FjeGhuc2s0M01La1dyR1dhRm8zUlhWNTdDK1l3Tzk2WGRkcHRnbGVEREhHVzAveTZtLzE5R3hydHBzZTd3UFR6UFB0WWwrQUo4dz09IiwKICAic1ZQIjogIkJIRjBNRnJzSVhuMVhYdXJZdU9Hd1BYa2FhZ2tZZllQRkZ6OXoyWHNJSitWIiwKICAic1JQIjogIlNZMjNJTitINnZ3TjUvOXJZMVV6ajRnMVVpYmpGbmZPZTQ0ZXQ0N3dtSER4OFlFdVRzdHNHNDZaU0MyRlFxbnR4VDdmSG5BQmFZZFNocWs5bmNiaDZRPT0iLAogICJzUFAiOiAianM1bStMelVNN2RVb3FJdnhpN0QvYVNQZUljR2ozcFZ2bFU0ZzViV3NybFJ3RktEclcvN3FoMGdyaGxCK0xXekFrMDg1Q3MzMmpyd0RoSlpISFhldnJKU01aNlVadFBCSUNTcTRvMmRPdEdhRFp5QlVJWmhTT0lkRlZmZCtaZz0iLAogICJzUVAiOiAiQ2REZGtPSlZKS0dEVklKaVNuQis0QXhpc1JZcTlzL3B6dU55MWduYnBrNHQiLAogICJzU1AiOiAiaWFCL2VZUHd0bmN2WVdsdkMrZTF4akFZUUhFWDh1N1lLTHQvNksvTXMxazY1dkNuL0FTL2FUQnJyNTBDVzFPTlJvTW5JMENLckYvb1hmaE9iRzB5VEE9PSIsCiAgInNSUVAiOiAiR3VkZVh4ZW5FMGtzUzYwUlpiMlp4elRKcEU3K3ZqVVpkK2kyNHV6VVdnbDBnMXRCNkExZkhreTJPY2xCcmlrR0swK2UxaWdmMHN2c3FweXRSNEJTYkE9PSIKfQ==',
+            'ewogICJDIjogInhpcXRnb2lOWjFyQWpJSG1COVRNL2ZnREo5RDFVV1hDVTJ3bkhXZW5zUGs9IiwKICAiZTAiOiAiMC90REFzVExubmEvZUlQZGVFR2p2bUw5cTdxYXowUmYrMk1Zdng1YXFoZz0iLAogICJDXyI6IFsKICAgICIzQm4vaGZSMGtQSXVMeXJkTm13NytCL3lWbVhEUi82YzlEYTdBS2EvcVpNPSIsCiAgICAiZ08ySTBYMlVXTVJmSHRReFdnV0M4U1B4VFRvKy9HWnBhMXNhaG5xNmFGZz0iLAogICAgInF6QnBFREhiSnpuYTdKa3krclpsQUZLWWtqd2pGTXFuQW5tQXBUWldHNTg9IiwKICAgICJ5RG51N1lGZ2dZRWtRSEk5UUdFdzhrZmQzR05XSGVacllKZzNKbVhTbmZZPSIKICBdLAogICJzIjogWwogICAgIkd5RXBTclNtV1N6dHJiam9MQ2FxMzNpNStqanZweGdtUzZINlNhdVVsUnBtSnp2RThWN2cxc05KU0NwUE1nVjAyZlp1cTZVeTc5TmNPS2lXTHdzZTN3PT0iLAogICAgIkRpb01KeVJUZm1ISU9ySnlkejZ2MGNQczJzUVhrcTExU2owd1NDY1JUSWVNb1BYYTRPeWFsUTNIdWcvWFdXZUJiSHpWSVNHaUxLSDVIam93OGVxTWVRPT0iLAogICAgIklNWi9TeER6aVN2aCtqdk9JWG9JMmhlaVp5ZFRlMjBrbjd4STBLcWw1Y1didStMdDZBaUFma0FXOFN5WHQvM1JvQnp5UGhLMU1oVC9xajQveFdSWk9BPT0iLAogICAgIkNJc3RzU1h5ZmVjd2drSzgydVRLMXpBdmNMOWV2UXJTZ0dLdTBDbDhNbzg9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAibTNnMk00ZnJVVXJyWWVua3YzVHdOdndkTzdkcmo1L0cwbFM1QTVPSDJJcz0iLAogICAgImUwIjogInZ3YktBdWVqZVl4bFZIUjJkM25iZ3NlUUNIOGQ5eWdsS3pQQ0t5bDR5NFE9IiwKICAgICJDXyI6IFsKICAgICAgInd5US9rN1FUK2RtOVBpZnJVSCtoQkZSdjVUTFAwdjBwOWlCMFJORkgzTlk9IiwKICAgICAgImwwL2ppWUkzcGdPM1hYUDNiZnhmY09qeDJWWUs0VDYzV21JSnN4M3FYZmM9IiwKICAgICAgIm1CNjhoWExaU0VNeXBhSFBPbTAzQVFYdTM0citiVmFXQnFNa1AzRUdhUG89IiwKICAgICAgIm1FWnZrL3RSK1V1VjFxY0pXSlc4aERRc3BYNVduR3hWZjdCWEtaYy82RzQ9IgogICAgXSwKICAgICJzIjogWwogICAgICAiSk0xbDJZSGxDN09yeTJlRE1YRzVrd1VmS21DeHg2bjlzSWJWWUtBRmdSTT0iLAogICAgICAiSU9tTVJZaU01S3RNYjlwSFdNNVltQ0hnMGY0ZUNCOUhEODI5YnNINEtWRT0iLAogICAgICAiQnJpNHA2RTBmU2tqMnRKWkFoNEtUak9GbVYwTGxJY1d1MlM5dDByVzFxTT0iLAogICAgICAiSUdwY1oxUzNZc3k5SlFjeE9LY0c1NmM2SVZyYXV0ekYxR1ZWTGZpOVovYjR1MkRiQ3d3dnZmQ1Z4bFhrdFFRdkdWVVRJbnUyL1N5cUZvaUxjNHV5eUE9PSIKICAgIF0KICB9LAogICJNIjogInBhTHFVeUtkbDB2WHc1YjYyaDgyNGFBNzZYL2N1UlcxMHlBeEFKZWpYVkk9IiwKICAiQzEiOiAiNzBkWVFaK2RiSTVNWlJnZFk4cWF1SFplaTVLYmp6ZjkyOGVGeDkzN1ZPZz0iLAogICJDMiI6ICI0M1Rka2NuWUFEaUtZdkw2K0o4dmxwdUV2bFhaY2RXTWlSYWFOcE9lUTBzPSIsCiAgIkExIjogIjMxWjhoNnM1NjJvRVhxdmdvUGdPOEtVMUlxMTlxR1VwOEk5RlRaTWNMRnM9IiwKICAiQV8iOiAialFUVEd3QmxtRTVaekpnRTNaUDQ4WVVpelpPRzQ3aENEZXdvMkN2QnlYdz0iLAogICJkIjogInBUOFpaR0hqNnkzdWF0amNDUnFXQ2lrZlltSG5hby9ieWNrVlJUQ1ovK2c9IiwKICAiYyI6ICJ2QWh6MnV4MEpJa1ZEcmY2Q1lsa3phRkNPak56bU5aR25HQzRxaDhrZWxnPSIsCiAgInNYIjogIkUwd01UVTl2S0VGNitqM0NzckhJSkdxUWlDVkZBMUxsdkl2cjBRbDBGd2pUZEdZN0VtTisvM2NvdnUrejRnRTZuK0NsQVJvbDdRbGp5elVrWHY5aXpRPT0iLAogICJzWSI6ICJDUUN2YkMxRzBaa3RmeFpkRTV6UUhadm9hL2M2TllSajArZXAwR2w2YlBOV0hObWRMdDVVWElmaUxrUERsYm50VEJhcW1hcFlQR0R2MlF6T1dRcy9kQT09IiwKICAic1IiOiAiblFWaFlFS0tYKzU0WUZnSUtXSzhkYzEybXRPSWFIZHpheHVndk8zbTVHaEZ2ckNmR1N4UFprUXl0Y3cxcVNUcGtjbTU4S3VKeE1BZmplNFZaMDVEL0RZOUEra2xwMTlPTUQ0MFJ5NUhpZkV5ZDc5Y2ZMVDVNYXN0WmlnRnVScz0iLAogICJzUjIiOiAiQ3JxckQ1eHJwdHNId295aHBVbFdUbElaV2hZWVpoOUtDVmJXTElVMHBGeVFaSWkzb25tZ3N5WFl3bWZZcjNyY3NUbWRoUjA0MlkrSVl4bVRsVk1EQUE9PSIsCiAgInNSMyI6ICI0bXZKM0VjcVdZQmdOSE94QVIxa2dWcjJRWC9Ya3FSb2hqMS9ub09TVDUvR3Y4M0pJczlBUmtkYk8vMGNsTUVkQ3NueXZUQk5lYTdXREFSNUo2M3oiLAogICJzUyI6ICItMzA2MDU5MzUzMjY2Mzc2NTk5Njg4NjgyNzUxMDg5NTIyNzYzMDM0MjE3OTk0ODk4MTcwNjM2MjU3MTcyNzA5NTU2MjUxNzY5ODE1MDAzMDk2NTk1MTA4MzM5NDA0ODg3OTgxNDUxODE1ODY5MjczMTM4NDcyODQwNTE2NTUyNjUzNTMyMTI0NzIzNzgzNjE4MzcwNzcwMDg5NDA1MTAxNzIyMDM0OTUzNzE1NTAwMzg1OTc2OTUzMTM2MzE2Mjk4NDg3MzY1NTQwNzAzNTkzNDMzMDc4NDY5ODg0NTQ4NTQ1OTkwIiwKICAiQ3EiOiAiaVZDajB2TVFjaXFtNXo4bzZkdjNZS1pwcmhuSTBHb3RsMFduUTdLUi8zOD0iLAogICJzUSI6ICJDOTJ2bERualYxcDdWRVR1QkxHY1JremFYMW9reWcvQWZPNlNnbDhCdVFqUyIsCiAgInNSUSI6ICJBMXl3b1RHUFFiSElEYW1GU0lScU8rMkdGQjdWQjgzVE5TNll1bjlVQ3Y3ZHFvWW1vRENkUTI5N1RnaHppK05uZHAvbjlQR3JCTTduMURXOEcxMDd6QT09IiwKICAiY3AiOiAieDdYSjRZUHRQWjYzK3Y0ZW94c1pURVZ2MmpOb0RtZkI0UTA3Y0VjTmtPWT0iLAogICJzWVAiOiAiQ1kvUEpwWHNUeUROeFhKTmQwVlBWUG40NERHbGhRZDRvc2h5YUEwRTBQUEZzMXB5SWJvOUc0RGZzZWRBdGlyVVJNQXRoNzlLMlhvNVd4WjRaTnBvbVE9PSIsCiAgInNWUCI6ICJCWnZMR09PWExBdlRFM1gvRWNFMkc2TFJ4NHF4aXFuM3pqSW1WMmgycXIzRyIsCiAgInNSUCI6ICJUZTRremtOcldVZVZLakdIcGFPSUdpaDFWY3Z1U0hwWTBXODlQWHBnUTFGc0RNYUxKUHZYTW50bE05VC9GZmpibnV5c2x0UFYraXZxVmNCLzg2aGJsUT09IiwKICAic1BQIjogInBzV3drM0hObHBrQUhZam1ka1BrTWxYU2NwMzJJMlN3NmpTdjh1ckIwN0dLYVBjMW9SV2lsQ1lXdkZjcWlPT0lQMUVlcUJjeWdGWitjWFh1NHRlcWxkTUtWcjRyeVF3MEZPUjBSMFVDSGRTR2NicTZWUUxHYkw1S1RhZGhxV1E9IiwKICAic1FQIjogIkRKZTBBTkN6RzhpTERHWG56ZzlTUE1pbXgwUEt0ZVNuTjVZdUc5SzluUGdSIiwKICAic1NQIjogInBVWW9vNENlemNJT1BLOTlkbmpzZ1FTbTI0STJxN3lrSlE1OGtXdytURUlFdHZyMURQN1RGb25lamljQkRlOFJTeE1IZGxzYmUvbm92N05naUU0QmtnPT0iLAogICJzUlFQIjogIkE1SWowZGNmTlBUbzZPb2txZUpQSmRyaFg4ejFHU1RBZ2dZRmIwRnlwaHl6SzBkVWNScWRWaTZ1QzNRdmdCL3F0WG1IVmpzT2lRaWdWb1FpTzFKdDJBPT0iCn0=',
+            'ewogICJDIjogImpHTHVUOEJaTk9IMklhT09sYlNwa25ENU9rc1NpKzJIWG9tT1JsdXdSYWs9IiwKICAiZTAiOiAiMGRIRi8zVFVhbW4vN1p6bk96Z0xSRy95UGVpOVJadWxIeVJyTjJVcUpXQT0iLAogICJDXyI6IFsKICAgICJrSDNIZTRHZ2lTV3lRZE9VODVJZEpsbXk2dnNMM25GNnkxY24zVy9KaFVrPSIsCiAgICAiM01OUEo0K3dDY0dYRFhtOEdvc0kwRmdKdzAxQXhUSlZqZkwzNk1raXZSMD0iLAogICAgIjRDQVc4eWtRRHA5S3lSWjVGRUVsK24rQVViNEluUHIwdzBzaHpUNzRLRGs9IiwKICAgICJybENBY2liUlhPYURqZDF4dVlNeUt3UStld2docDBHOWliYk1Sc0gwVjFJPSIKICBdLAogICJzIjogWwogICAgIkJGTVY3ZWZvekhtN2pJQ3VPdkpHeENlTHhzalA3OTJMRk1kV2krbkRUZTV4MWRtM3J0dGJVTmpsR2VkVzYyOTJteXhTemozVkxYL3Q0aVlpSWFhczZBPT0iLAogICAgIkMybUdLWDM5bzAvaTVnMzNLUmtnblBqRGtxK1U3bzQwWDdpbEVYeWhlVStiUXQ1N1dpbEtnOExHc3BnTURsalFZanRlVDVSdUc4dy9yNkwzYk9tZ3ZnPT0iLAogICAgIkpWMHlpd1hXazVNNVJxUmJCSDBhcWQxVHRkYmZaa3IzaDNnVFF0WVBjYURMaHMreTZaQm9mVnhNSlkzRkR4ejJnUDBxa2gyN2VMS3FkL3pTUi9lNnhBPT0iLAogICAgIkVLOXBwM2J4UTRMS2QrekZuQXZML1QvMjBiLzY5V3pPR1lYZkhPOVBzU0U9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAielpBWTVRVmxQcE9pUkY5SXlFK1NpeDU0NmdWY3FiWWFaQ3FoWVFmRWZ4TT0iLAogICAgImUwIjogIitUZ0cyemxFUnNmY2owZlM4RDhsVXBER0Y0Y1pneDdlK3ZmYUlXdm15d3M9IiwKICAgICJDXyI6IFsKICAgICAgIjZFczlUck5aTUNoYzk2eExVTEZxTEhmMlNJblRwdUI2RE1PSUw1RFVvRVk9IiwKICAgICAgImpNK2pqTlA4VGRZVktWTTFXRmw2cVBiUGlSVlBaR1NBenV0VXBmc1pNQnM9IiwKICAgICAgInpIWjQyUUU3dUxlSGwrM25GSEtUSWVzazQrYXd1M0FGUEhEM2R1SDJtMm89IiwKICAgICAgIjZLQlRodE5CZzNmeGVFdC9uRWdQN2FZRGN3WXFDeWpVeW1vdHZYT3FzbHM9IgogICAgXSwKICAgICJzIjogWwogICAgICAiR0k3RmF2S0l5cnpFN1I1WXpsdFJGV1VDeWJHNlI5UjgyL1VQWUU0MFpnZz0iLAogICAgICAiSUVSc2pONWRXZktMSFhicUdlUlo3NFdlQldBUkpWdHMwb3FHNnNkVmlQST0iLAogICAgICAiRUFNNHJtTVlOeWhQbkhyd1VVZ0h3RmZpSGlrbVVwZ1E4aTJYb3lDNThudz0iLAogICAgICAiTE9jRHV6MW5aOGFNd0c2OHoxWUpVUTNmNElaWVVrUVBaTlBrZmVQdG4xNEp3cStIbDRYT2NCb0l0c3drcHYyb0lMNjkzb09pU1NFYjR4THVHdnIwcVE9PSIKICAgIF0KICB9LAogICJNIjogImpDUHA0d09BZmQ1cDFtUkthTU1tZmpUMDNMNExHM2EvTDdCYlRVN09yTlk9IiwKICAiQzEiOiAid0JNc291RW4yR21zeHVHcS90NTJDVkh1S1hmaTBSU2dqdGY0by9MTEpJcz0iLAogICJDMiI6ICJqN3FBbUZWWllVa3VET1hvaFREUUpCM2VqMW9rUXJKVHBkUFhlK2p1VWtVPSIsCiAgIkExIjogImd0RTNvS3NNSmFzV3hGa0sxZGdwWTc2WE0rYW1JZGh2aitwWnhKd2NRVlE9IiwKICAiQV8iOiAieTI2dFFaSzZBczB2dUIzeFR6ZndHa1RLck5ZK3huT0hzUTNWT0RIVnBMST0iLAogICJkIjogIjJnT294WmQwaEF3TVJ3QmR2czUwTUZWaVpyZlh0VThYM2F6SXZrTFI5ZTg9IiwKICAiYyI6ICJtM3ZmM0JXMEVhVTJmbHJTbi95Uk5NaHdGaEs2S2VRVUpPNFpQWTVEemhnPSIsCiAgInNYIjogIkZKWmQvS2V4NExneXJyUU94ZmhjSklhaCtncWwxRGp2WmZKcFdIdXExc2g1MzRsWXhFSzNvM2hRT3hxS2VmZ2pzd1pBQXdESHZ6cnpOWVhkUkxKN1dRPT0iLAogICJzWSI6ICJETXlTbm1IbmEwNGZ4N2c0SEkxMjdzbjJtYmViMVFBQUY1enczT2l1ZWpBSVNtZUFQMUpPMUp2WncxZUEwV0RwQlV2MkFMbFZpTzRRZi82bFNDRUJVdz09IiwKICAic1IiOiAiQS9iMkxoajhMUFBqaUM2T1dFdXA2Yk1WWENyVTNSQ3BFL09CUVRGQ3JXc0ZYck41Q2lJdHFBVVZiYWQyV1lRM016ZE8rUHY3TGZGdE9vcExacmVKTEJnbkhsVDFacVFtenUyUEg4bzBZRU8rTHBvQzRsdzIvZURKTmhEVURlclEiLAogICJzUjIiOiAiMWRZVmxiVW1VM3VoazlaUStlT0srSytscWYxWldlUkRKVW1xem4wSG54RnRRSE1kbysrTFo1TnhMYVJkUjBhV00xV1g0VERuY3p1TGJUUThXcUZ6IiwKICAic1IzIjogIkFkZWJ5OStXQzVMNnJFQk4wbUVkWDRZdkNIUnZuUnFNQ1BaMUFQVk9oYXMxNFA2ZGUybjlmYkRFd21TVlhCM2U4VHY2cmtuK1Rlb1VzaDY1K1I4MHV3PT0iLAogICJzUyI6ICItNjAwMTk3ODc0MDUwNjY3NTEwODcxNzc3ODg2OTQ2NDU2MTI2Nzk0OTY3MjgwMTM4NDMwNzMwMDE1NzAwMjY2MDU2MDQyMzA4NjIyMjA4NDIzMTQ5Nzg1NzE0MDYzOTQxNTgyODMxODc4MjUyODIxMTE5NjA4NjQ5ODIxNjA2NjMxNzI2ODU2MDU3MTQ5NzAwMzk0Njg0MzI3NzcyNTUwMzQyMDYzOTY5NTU4ODc5Nzk4MDc5ODQzNjQ0MzI0NTQwMzY2ODQ4MjU3ODk3MTEwNzM0NjY2MjYxMDg5MDk5MDcxMzIiLAogICJDcSI6ICJ6cVQ0SjlBK1JNYlVBb1pvMmt4OFVaWXNGTW81V3ZUNUlxUzIxUndBVU9nPSIsCiAgInNRIjogIkNiNjZta0k4SEV3R1JqSWFWOG0wNmlJUEI0Y2NjNURtdHYxR1lINm9nNVBKIiwKICAic1JRIjogIkY2cjRsUEFHeEZpeWhSU0ZhZzBjOVpxdzJCYTgvMmc2Mkp6SlBUUytFTjhvY3VSK040WTFXM3o1My9LblNSUEt5ZUtNQjZGc3c0VVJ1Wmdvc2FXeWh3PT0iLAogICJjcCI6ICJ3b2VPOTlwdTJhNXVzRW5GUmpOTDYyWXg4L3d3a1NLSi9mRThmaFRNdDhvPSIsCiAgInNZUCI6ICJFQU5sbEJ6RkxwV3BiNlByakdQUHIydDh1dFdEcHRNYmdrdDNyL2g2RWZvOUFxQnlKOWNscm9Yeng5Z2JEdlF0dkhySzNUaFlrbXl5TWtmOW5oL04zdz09IiwKICAic1ZQIjogIkJWaDdEMU0vZWIwSS96VlJXMXBMTTg4eUdxZjNIUXVIeXV3Q3lyRVNwWERuIiwKICAic1JQIjogIlNWSTFVYkF2dGt4b0Y2bXBHdUJMZ1FGZFo2YVlKQ3lxTGEvYTArTXJkdGRRSHQ0blRjR096cmZhOU9IQkJRMUp2MW80WnlPbENDL0N6M2o3a08vVlN3PT0iLAogICJzUFAiOiAiQlBYWG5kYmpiZDAzdXVpZ3prc0lndjVOMzd2Sko1OGtkbXNZVDREc1pndUo5U2JnZnBCVUdCMjBEL0F1R0tNVTkrT0tGUW1sUllERFQxek1ZT09xZGhkT0NrakVrdysreHhlY2pLRlcwMmFGcWdwekZDUUpEUTdzdnZhd3JXN08iLAogICJzUVAiOiAiRERNdGtHRmxuUmpLVGh1V0ZiVVV6dUlGL0dyQXJhRWFsZG13Zy9oTkFLQ2UiLAogICJzU1AiOiAidFhGTTlYaGtQa1FrSWg5WEh6Z0lZWnIwYjlBbDREQnJTUzRjVmdoeStTQXZiQWtUSkEwaTAyR0NvUDlOaW1YMUhHanhaRHBQallWUis1RGpKUmlhN2c9PSIsCiAgInNSUVAiOiAiSFp5Rk4vdmtBK2o0NHo3SEVaajBzS3d0VGRhdHBVWnA4Qk0zNzY1ZDN2TUR0WnJ2VWczbkxXWTZ5UmtGTEF3NUlzR2t4NXNTSHFVbWtXWVpoeHRJRmc9PSIKfQ==',
+            'ewogICJDIjogImlQL3lnNTVTd3BuSFVJRzFlZGIyRWxhcktKNFVXNXkzVjJCZzVwTjBxN0E9IiwKICAiZTAiOiAiV3Z4SVU4MG8rcU9EQUxFT3p5ZVprcCtXR053SGVSNUJFZW1aNXNvUnF6ND0iLAogICJDXyI6IFsKICAgICJnTHRYOUNyUndLZy9heDBuUjl0SSs5RGFaUjNvbVkzcVVWUnppUzJoWjZJPSIsCiAgICAiNWZQZm1zU3EzbVRzZFFIYWQxUk8xQWJCYjVId1JrM0ZoRmhuMjllenBabz0iLAogICAgIjRxSlh6UDlMdE1EQ0lqaUJyMlJ3dUlISnovSUtQVDArR2Z6NTY5Q2tHdUE9IiwKICAgICJoNFlmZEJGUzczOGRwYitBemhUL3VMNys4MVRwRFRleFNocWh1NGtqN2pZPSIKICBdLAogICJzIjogWwogICAgIkJjV3BoOHpzQk9MUkZWU3ErZElXbXU1SnZrN3JpMldBTlVGRCtyYVQ1MkhUbXZoa2VnYzBYZlNEY2JveERVQ2k0UmJRK2hsbnZaeTZ2Nk4xb2lNbllBPT0iLAogICAgIkRsOTRLcmk1dDcxY042dkZDTmFKVnJPUFpSUEcwcVJTa3BkeTBBYmpqV0tyNStoUGl3a092R3ZDNEdPc1RNVWp5NjUwR2tSayt3dVgzT1Yyb3UvM2FRPT0iLAogICAgIkF2WjE5OGpkSi9YbGVsZFU0b2Q0WStxcDBTc09jQ0JvK2p0RDN2ZlBFdFV6RVUwQmVkbFFGbTNBZjVDTlF6N1VWVzluTjFiOWlJVU5qd0VmLzJsR3B3PT0iLAogICAgIkZjRHh1Z3AzcEt4UDA4T2Zmc29XTXFFUzBBNEpaR05wYnRGTGJyUEpBWEk9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAiM3ZIUmY1WVlpNFVPbGhKbCtFbjh3WG9oakhueWw2NDZqRWVialhnYVY3WT0iLAogICAgImUwIjogInpJUUo4blV1QURQUkZnZFBOenFKbWJsQjhCL3NVdm1abVFUa0w5czNxMVE9IiwKICAgICJDXyI6IFsKICAgICAgIjNVYS91VUN5eDhNY2l0YTM0citwTDVqMnRvRHB5eDlmajF6MXZGNkZ1Tjg9IiwKICAgICAgImpySXBNaVAvTytEbGludXVJc3JIK0VrWlo3R0FMU1EzYjBGazBXa3hEQ0E9IiwKICAgICAgInpMNmd4OEt0YkVvVXhTSHlrVGtCOG82WFFLRGpvN2k1dmVKWm1SaHlBVVE9IiwKICAgICAgInF4bnU3b1dhWlNSUHF2S0xQVlU3ME1BdmIvYWNzMnd3NHFnR2lvUUhlZmM9IgogICAgXSwKICAgICJzIjogWwogICAgICAiSkRhalBPNll4cWZMMWx4Nk14VlBZcGZOdkJ4YkdDdWkyNlV0c2FRWjE3RT0iLAogICAgICAiS3ZUUXNabTVleUFyVzFDR0MzNnkzQUUzQVF6R0tPVXpMc01IUTZ1bGtGbz0iLAogICAgICAiSG03ZzVXUmpJR0F1VFJwYW01bEI2Q3h0Y2dTV204Y2RrOG8vQVAxSS9adz0iLAogICAgICAiQjlra1Bwb3lma2lZZkJVb3ViUmtXaUNmTEJpbWVkNHNxdjN1cWRjVjY4cGR3VURSRzRZRVBueGdOWXVHVEZwb2gxWVJkQWo1UXFlWDVzWmR3M0ZINFE9PSIKICAgIF0KICB9LAogICJNIjogIjJTakdmTXZzVyszU2oxVDJhUUFXUDQrdmwvNW5WcXoybUdaQnQ1alFZTlU9IiwKICAiQzEiOiAiM3pZUmJSalV6Mk9laWNOUmJ4RlNZaCtKaFQyT0hBRlRNRno3b3lZMVJ6OD0iLAogICJDMiI6ICJoRlJoN3R4OUlhbUF1cGRFcjNQM0xQNWhFMVNlTGVJNDhkcE5mTy9zemJ3PSIsCiAgIkExIjogIm9DWW80SW1iTE5aYTR5RlJEOVliUmpLYklBc0tYQzJqQlpZKytRL2FaZU09IiwKICAiQV8iOiAicWpkaTlhcjZ4VVRvYlBiamw4T1NleGhOK0pvUktJS05tSmVZNDlpK2N3Yz0iLAogICJkIjogImdOcVpCRjdFS1IvWDZFRkd2M0Q2OUxMS3NIMGk1OFNYYWpDQ1hwTG9pN3c9IiwKICAiYyI6ICJNdXcza3dmRkd6TWJBK2dPenhNdW4yN3RoSDhtNFV3cXh3a0NVWmJ5b1RJPSIsCiAgInNYIjogIkJkek81NEhGSGpBaENnN3FiVUhYak9TQjJoODZPNnkzQ1RjcTZmaHVNK0M4NXF3cW15SERDS0hOTUNqL3RRanJqdzIvVU9UcGxuZmFCSzFGVXBSbERBPT0iLAogICJzWSI6ICJBWXVHK2VYY2h5QzZzaTBUdVR1d1lZZjFJZ09BQnRmdmhjVUp0eHJsQ0tlYS9nalVmSUdRbzF5cVNYZitoYWFwUmp1a2trOCtETW5EOFRRU1dwWHhDQT09IiwKICAic1IiOiAiMmwyb3dEZXMwajlyb0t5T0R2MUljeGVjVzF1N0FYSG5VdEFpbE41eStsOGxNSEdIUzJ4LzBoakpER0ozejhyMTM4b2dWc2RZYm5CQUc2YTNaL1MyMUxMYUduOTFJQ20yVG5Wd01heUV6aFEvM2VwQmZmMnpYNVNOQnhha1RNcz0iLAogICJzUjIiOiAiQkNoRGhtN2JmQTk2eGVuWlJhY2tiM1c2WThZZ0I1dWg3cTZmWGRaeXpSVFdXbkw3blNqdHZQbSs0M3F2aml6eUt3b3Mrcm1pWllsT2puVG0xM1pMY1E9PSIsCiAgInNSMyI6ICJCRWN6WFpiYURyYmFrN0tzcnRCQVNzSzRTS3N5cjNReWlNRHBRdmsvRTNSSUJBdXluRFVaWFJiYlhoOG1ueDlCbW5lN2RBekJIenhoN2VEN1J2enJ2UT09IiwKICAic1MiOiAiLTIxMTgxMDgxMTkxMjEwNDQ1MTYxNjIwNTE5OTg4NjI5NzI4ODEwNTAyMjY5MTkwMTMyNzMyNDc0MDcwMTU5MjI4NzYzNDI3NTIyNjU3NTM0ODYxNjY2MDc4NzQ4NzM0OTU5Mzc4NTc5Mjg4NDYwOTc3NjQ1ODI1ODc0MDUwNDc2NjIwNTI1NTU1MTI0MzQxNjA2NjU1NjcxMjcwNzIyOTYyNzQ1NDUxODc3Mzg5MTQyOTI0MDE1MTUwMzQ2Njk3NjcyODE2OTU0MjA5MDI2OTA1NzM1MTk2MjM3NjA1NDE4NDYxODkiLAogICJDcSI6ICJyVXBCaWVjUXdOeXhQK3FDdWZmYkJiczRTZjYwcFNLUnNuaGRZZEFDZHIwPSIsCiAgInNRIjogIkEwYVJPMnU1N3JTOUlMZ2FYaFAzb3krVmJ0UnRYemdIaHpxbmQ3VC9FOWZSIiwKICAic1JRIjogIkFna2xwR0xGSmwzYzNMZTBFNTJ1YmkyVUhxbmliUWx2azhnVVJmenBVT3o2T1ZiaVhuV2NINVZybW04eFAwWXljOG8yK1VKVzlONkxYMGM5SGxkNzJBPT0iLAogICJjcCI6ICJXR0JJYkNIbmpEWkZkZkE0MmZtU2RkT2VCNXY4MHFNdStRQXZTN09XUi9RPSIsCiAgInNZUCI6ICJBcTV2Yy9rYXZhUTlGRjZZQXdSY2RkRDMrR0pxK0N6OWtWMndxZ3oxVER4ZmpabXcyNGMxb3hoNVZRM2piUFpWT21kTFVSWnJQc0NiTTVyclZJYXRrdz09IiwKICAic1ZQIjogIkFvdlZTMXVHYXJkUG5IVEY1VzMwVGZGUlhwUlZEYlB5NHNGc0loUk8vVTBSIiwKICAic1JQIjogIkdTVkMrODdlUUczZ3Zib0tkeUdWQThKeGJXM1FzWHBZMlJOcFdDMThsSXNUODhhTHJxK2xMMThCeUdlVkJLbjhMSlpnMjl5Q2RpclkwSHp4Z1ZOa2FBPT0iLAogICJzUFAiOiAiQVhyNUJYbDlLRzg4SkVXaWErbGczRTVFU3paOE1NRFNLeEhuV203cSs0N0lmSjFGRFY0SnUyaUVNVmYzU2ZlK2tOOEJiWmc1RUlUSVBmTnNzMzlPclhnNXhkVTVYdnNDSkp5OFZNQUpDSzNpd0hzdE9KdXFWQ3VNZjFHaDBycFMiLAogICJzUVAiOiAiQlpxTmhHRDJlODROazlYUzAvUkJtNDg1Uy8walVtL21ZNjBYOEYwQ3MzRWYiLAogICJzU1AiOiAiS0RPZEVtbENRbVVMK2g2M2p2V1NaR0pSTjZNM1ExMUJIUHdxQk53eEZEVjVNc2swM29HNTlCQ1ZpU2tGZjgxUWI3cWlyb1ZhandjWnFyMUxVbGVEVlE9PSIsCiAgInNSUVAiOiAiQTRoeW8vc29ibXJTYWxwRWVWSlFyQkRLbW9FWmxsazIvSFNBRjVVMG1HYVhHOW1hNmFmSUphK1dVSDFsV2t0T0wyeE1XMjZkUXhnbGplSXJPOTJDQXc9PSIKfQ==',
+            'ewogICJDIjogIm5oWjcrdkp3TC9yMy9wNFMzNEFFVVBjWDFTT3VYcWFDTGwxcW01SnBqTTQ9IiwKICAiZTAiOiAiMXV6amhZNjh5UUtPYUh4R2Y4THZrdUQvTEt5QmxlODJBOHB0QTZqU2ZRPT0iLAogICJDXyI6IFsKICAgICI3VTF6Zm1aazIrTFEySlZKamxZVlM5eUE3OTMzUHNhaFl1MmdVNFcxS3hnPSIsCiAgICAiaTljSlM1ZEFmeWtDNlpURmVLSktYbnZ3SWtjM2c0WUxFRXQ3dThabFpYST0iLAogICAgImxtekZuaGYrRG9Qb0VUbkwvTGVBeW9CdGoyQlJrV2RlS2pZQXhYd0J0ZDg9IiwKICAgICIwMko1RG1zRzdDbUl0bHQ0K2F3MkNEYUM0dzJzOXlVZkNMZ1krOEc5L2tRPSIKICBdLAogICJzIjogWwogICAgIkMySFVpUUZSVHN0bElmOVFISmMyZnAzUVFPYlNpa2l5ME9Rcm8zYzA3Q1VOekEwM0lpbGJIb3BxZU14RmEwU0kvcDJSbU4xUlpiYWZMQVlSSm51eCIsCiAgICAiSnFQQ1ZadW5VVmlxem9Lajd1RlhDZUFMQ01zendyT3o5Zk5lNGk4ekNzeTFHNyt5ZjJuSW00MUFiUjdRQVhFQ29JZHZ5UFFUejdaTHdZRXdnVkJ2IiwKICAgICJFM3pGMkdkZVB3MnRpWnBBVlBSWFZFcTFlWmtmbFh4Q2pCbkJWVS9tNVgwVHJPajQ4WEk3OXZaUEdMa1JZayt4cGlzcXhIeXhsSTlXM2xwcTFVZz0iLAogICAgIkQ4MlRmbG93Qkk2NDJybGx0NVh3UDh5TDBrZHltNklXMnhZRzJpK3FiZVk9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAiclYwMUhaSUxDWkw5bm4yTUJrcDZtZjcwZ2dsRUt1WkV6eVA5SDRLRjZzND0iLAogICAgImUwIjogIlZwbkRRcVZIZEUxLzQ4WVZGQVErSW0raHV5eEdEZ0ljV0x0YWkvVHZ0TlU9IiwKICAgICJDXyI6IFsKICAgICAgIjdJamFYdVhSTDBWSFFxaVN4cmxEVXdVQXg1dC9ZU0hlalU1WEx6Zjl3S289IiwKICAgICAgIjdpbTI1dDNuQVdaNGtWZVBscnc1Z1Myam1KV2QxT0JjNGZJQ1pWck9jMlE9IiwKICAgICAgIjJocmxjY0FnQlNVRzQrek5pYTZITnJOTitJNDAzQ0JoT2tyNHM1ZGY4b1k9IiwKICAgICAgIjJudXFLVnczbXhDbG1KZUpzdEdsb3BkUHBoZ0NPeVQ2cEh2SlhjSjBXWDA9IgogICAgXSwKICAgICJzIjogWwogICAgICAiSVlwVE1lUm1OUXV0MkZlVEFNbjVVbk5NUXNJNStNa0wzd1g5OVkyQkF3OD0iLAogICAgICAiQXYwMW1RZE1yMVJxdDR4VHFhWkdMMld3ZEoyK0FIOG1PTEhXTDJIU1U0Zz0iLAogICAgICAiSXpHNEd0dDRhdytxbkRhbzI1UC9OaVZsb2x3UlM5N2U0ZGo4N3ZaVHpxTT0iLAogICAgICAiQnJWZjc0T2lxM0Q1SWhXV3lQRkhyWTNOM0ZNbzNlU0txZk9CbS8wZ2dkWnJJb0hBNDEyd052Syt6ckRTcGpjZVVvZ1JtMzE5c3lYcnpLaVhzaGlPbkE9PSIKICAgIF0KICB9LAogICJNIjogIjEvMlFDcmVTUExpUEZiZ2h5SjYxZHVKK1F1cGtDZzlrSytFb1p5dTgwanM9IiwKICAiQzEiOiAieXBLNm9MMWZiNzU5d05jMWU2RnZWR0ZsbEU3aGFibCs1aWZwOEtlWjJ6WT0iLAogICJDMiI6ICJwUTJkVUhXY1BTbWlES2JzN1A5S0VoYWtJWmh3SUVaeDJoVFJWc2dUZHpRPSIsCiAgIkExIjogInJuVHZtZWh2YU5SVTRMNmtXYkIydWRrdFlGbnNhVXRJVWoxTUtGOXJiTkU9IiwKICAiQV8iOiAiN0diNDZ0UDFCMStXK1REZjVwR2ZHZ0pSR05mZGV0R2szaDIyTk00bGp1OD0iLAogICJkIjogIjNYcGVHTEY4NWo4NWxqMmo0TUxQd2hkbjhtUEZFZ2NTTXpEVG9WRHRpeUU9IiwKICAiYyI6ICIwSjhibGdWSU4xNVY4a2dqcnJGTTQ0eCs4bDdvOFlMLzV5TStNVi9RRTE0PSIsCiAgInNYIjogIkpqcTdpelZ3T0tqVHE0dDR2QTAzbUh4cUw0K2VKYTJpcWJ5Ylo1cm1GNk11dkFQckJacW9CSmJQT09ZRzV0UExqeENlbXZXcUt2VHBhZDJuekx6Smt3PT0iLAogICJzWSI6ICJCUzFlVzNCRy80d25QcDdBQTVHOUxsR05BTmZkcHVRU2NxTUN4UG1JU2F1cjErc29OYXBLbThIQWZpRm9pZU54VWZIYVVxdWJCYlo4ZVd3Wk50WnZmUT09IiwKICAic1IiOiAiQW5JTXVqM2UrN1dicXhqVEprSUJmMHcrKzdOenRXcW9wemUzU3c4Qkg1VStwbTNZZ0xkVFVaU2I0clJqK2VuZmlabGt3UnFHaXJ2d25VN0VDUFVib2JIcGRMQ29wUjFpRVIvcVFDU2R3R2p1Q1FFa2JwdnI0NklMZ3JJUXlqMDAiLAogICJzUjIiOiAiRTdVYlV6MjRLcmNqRENnWFF5eEZJUmM1S2o0c0xyY09SZG4zMDBkdHErZkQwWW1UVjlFTlpZOVp3YTNYQmRFZ1JLY2JGZ0V3UzFOcW9tazJNQTd1Rnc9PSIsCiAgInNSMyI6ICJDSVRCR2EyV0pETVFqSWhQUjRSS281ZmFkSDlXeVRQT1ZrS1hkY1RGdUlaVTR4Myt2dCtLTCs5Um5NWDVFOHRnc1M0OHV2MHhzSzhwT0xkbElUb1dpdz09IiwKICAic1MiOiAiLTQ4ODAxNjgwMzM3NTE5NTQ1NDk4Njk0MDM5MTg0NDA4NDAxMjcyNDE2NDg2NDE5NjA0NDExNjU0Nzg0OTAzOTA4Mzc0MDE5Mzk3MDkwMzk1MzI5MDcxNzc2OTMyMTEwMjQ1OTI3MTEyMTU3MzMwNjk5OTIzMjQwNjk3NDc5MTYzMzE4OTE2OTQ3NDIxMzYwMjMyODg1OTg1MjgyNjk3NjY3ODQyNDEzNTIwNzY1NzYwNTEyMTM3MDc5MTk4OTA5NDEyMDA1OTM4NDExNzEyMjkwMDg4Njg2NDg0NzQwMjQ2OTgzNzYiLAogICJDcSI6ICJoQjRWK3JKTnBCSytvaUU4U25XQ3gxbFFkNW54eTV6b1VCMWluZ29FbWl3PSIsCiAgInNRIjogIkRSa2hpc2loYjROd1BMM2lZakNMdGdPOUVQYkZKQ0JIQlNMRlhrUXAyaEpQIiwKICAic1JRIjogIkRoUXRMNmpOVXZCYlpUVjdjc21lVUI2UDFuREo4ZTJOQi9TWkd0N1FLT2FhTVhOUytZVGZack13MVNlM0FUUkpvMUFpS29yZWFFekhobHZwMFJjTndnPT0iLAogICJjcCI6ICJ2ems4SitmdTJveHVRQ1V2aTF1Z2ErZ1k0WkhpUDFsek9pMXo4VHZSZkwwPSIsCiAgInNZUCI6ICJCTDdXL0prUS9DSVZUWFltK1Fqalc5eG1sVGpubmlYSytXdC9qekVKS1kxT0pIUzh2aWtRemZnbGovYkZPNVd5bFZBUG5kSjhvaG1xY0piWk5DYy9XZz09IiwKICAic1ZQIjogIkJUM2VSOXQ5RDdENEUwNmhIMWkwQUtBRXJjU2FrSUxVdGZuZ2pSQXYweHBFIiwKICAic1JQIjogIlRZeGR5MjE5YVlvdm1iUW5VWDBiSVp3VFlDdWlmVWtSaWdPZDdmemEyc2N3d0QwS2s5alRqSGlwWjhFUmlHU0xUeU1OU0syUjFkbG5YLzkvb25CZ1d3PT0iLAogICJzUFAiOiAiQWozWEorcGl2MFIzRDhNbnJWanpTZ0o3ZUtweTBOY01tNTVrNnFhSVZ0cjMvTmNsd2lERnRaa09sK2NDSVNCczdvNzZLdFBFdnJ0RldKWGRRSlB1MzlFU010VXdrSU1aMDJNeWJWRSsyTHJDSGtEZHRKWFVnT1lsTmczZ2FFL1EiLAogICJzUVAiOiAiREFxTnhqVnJhRGJrazkvTDdwaEVGQStTRGF5eGE0b1htN2dtT2w0MFA3OHciLAogICJzU1AiOiAiaU1YS1RrbmJSeUZhQnROOGM3YXhUZ3VXM0xxZ1EyWFh2WDVzK0l0WG8wRmpGUzM0VlN2NFkrWW1CTHdDdjUxeG15a0ljQng5OGhRc3BNUjNzUDBIMWc9PSIsCiAgInNSUVAiOiAiRE9lYmFlRUxGVm5PK0JPL2pES29oaEYrdFpLNTV6OUllYm53VUw2VWRMMStvTmV3MEpBR1ZlNGQ4bDlQTC9SQi92ODVZSVYrZFV2ZTlFU0pqSmtsTXc9PSIKfQ==',
+            'ewogICJDIjogIjI1NnZzTHFCWXlEOVNxcnJ3R3ZoOGVrTlcyTkc4S2tob0pRNVc1dFhhRk09IiwKICAiZTAiOiAieVMvVVl2UzdJNjBMWE4wSGtMcDZselgrUm5oOEdxSm5LQkxONVJRd2VOWT0iLAogICJDXyI6IFsKICAgICJ4VDFRODhDUExvQ1BhdmVTYlBzY3VwMEVsci94cE5ZZHlySEVabXM0NTRRPSIsCiAgICAibDlONWpQaEErUVBoODB0aGJndXVWVHR1b05MYWlVQVhuUkRrS2NJaklZQT0iLAogICAgIjVyRmQ4bU1hUi9tTXlRMThqa29WaTdPMW00UzhDZnRiOVQwdXV4RjVDVkU9IiwKICAgICJ3bHpoWnpIZ042Z1ptQmdmWXNIUzltOWR0NnlrWFZ0OTRXTkk2STR1ZXJzPSIKICBdLAogICJzIjogWwogICAgIkJheURZaUNmZUdFQjNiUE1USlM3Ty80SVMxMDQrK3JjZ0VOVW1kamdZMHVWNHNqUmNGNlRkUXBJNUpyRU5DWUhTSU54WFR6S3ljbjU3YUVlQTAwSHpBPT0iLAogICAgIkZuaDM1bHJFMytWcHZsRHJFazJNNXNaSDBYeWN0ZDlyRkUyMzlTakVhcUNLREZMWmFhNmdieXFncDRSLzJXTUt5aE0yZXZxRzJYNnJldldWcUI3bXlRPT0iLAogICAgInBRazAvYkNFYzZzSWQ3WVpIK0x2Qm9pd2RuUUY5eHhQYURMUUp5SEhFUmFtR0lETWtaSSsvTVo2RDZFRDIxY2tacGpqTU9Kb0JKR2JPQlhkVVRNZCIsCiAgICAiTEFqenVucHAxWW8ySmhUTTh6cVgxcWEzeHlwekYvWUYvYlpWMFQyZEs0dz0iCiAgXSwKICAicXVvdGEiOiB7CiAgICAiQyI6ICJwR1dtYmltaUpjbkFPZnZtMWtrLzRlL2JhMEtFampZT3lNYk1yckJjQXFjPSIsCiAgICAiZTAiOiAiWlU5YUN2cVRLbWtrTTBYTDVxVlIxZThuYUFkWXRsMG5xSHhDeXlMWURSVT0iLAogICAgIkNfIjogWwogICAgICAiakJZdG5nRVlRQzZoTHBka0xKRCtCWmpwTTBMTVVNMHlRUnpiOEdvWnVTcz0iLAogICAgICAicW55VjFNbGFzenhJMmI3U2RKQ2ZOdTVLVnJwRGQrNDVmWWp4WWpFZ2luRT0iLAogICAgICAieWl6YUdBcDRsanNncWZsVHlFUmU3MUw2NlJiU05YT1lJQTlYWExvdmcyVT0iLAogICAgICAiM1B1TTZqa29YcUM1VWFhaW1RUFJITDhNay90R0xOK3dYbFRQc0h4a3ZJdz0iCiAgICBdLAogICAgInMiOiBbCiAgICAgICJEeVdLOHp2TzdlV2dPbHYrTE5TTFNJUnl6L0RyOThyZ25hbXBJU0MwVTlnPSIsCiAgICAgICJITzdXTDRQSitLR28zbTM0RW91RTlSM1FJWm5nYzd2OFJFN1JIcW92N2c9PSIsCiAgICAgICJDQUgvdEJ6NmIrLzBGeUV6YWRRQ2U0YlppQlVzcFBNZFJ2S05QYi9yOUdJPSIsCiAgICAgICJCdG9GNjVFd09hOWdKNjJ2dDZLa3YveUpzNnkxeUovdm5BaVNvMktCN3RlN1R0V3FMMGw1SXVrenVORG1GUFdrdFdsZ0taS2JHOWF2c0I4VURDb0ciCiAgICBdCiAgfSwKICAiTSI6ICJuZ2RyeHdtd2hmdVp5SmFScXpLS2tIYWJic24wbC9tWnhUaTRZMjZseHo4PSIsCiAgIkMxIjogIjVPU3c3aGRsaGZSZ1U3RlNoOEtzNUJyU09VYnJnemJnRGxmWlNmSGsvYUk9IiwKICAiQzIiOiAiZ3ZzaDZOdnVXbE9CWjk0YXlTaVJzRU53WVNSSVhaRS9ocHlZdG9aZU1QST0iLAogICJBMSI6ICJ5N2RWcDJVZTB1bzBUVzVBd0hVcU9YY0tHMk8zZGRlL1o5K1JSRDlDQXVJPSIsCiAgIkFfIjogInArd1R0TmhNY1Y3N2lKMndZZWNEWHozTytlT2JxQitMRFdBalJiRmxZeWM9IiwKICAiZCI6ICI3cStXbEJ2c1ZNdzc4K2p4NnFyMndNRU5FQXFtNHJPZTNKL2tQL2FwSnNJPSIsCiAgImMiOiAibEZoSXlwYlFJejBLVXJFRnZRblV6N1lvWExkMCtrRlhzYXhxSThWakRrND0iLAogICJzWCI6ICJFUFRZOUIvK1pxVjB1ZjhsWTArMnhhbnhyWGNBMDgyK1N4TW5oR090WWZWZVVaekRaeUdJTXdJMjFCU1Jza0h6S3YwMExpVFo5Yi9SaU5LZlVJZXdxQT09IiwKICAic1kiOiAiQW1Ndnc4Z3JhbitveDJSOUxpRlV0MFpIUmpwVnhtalFEM0ZIU1ltMUQ5VSt2TGY4WWhNbGhaUm5XbDQwZHc1NmswbzlyRGpiMTZWU0xCRU1uWVZ3QlE9PSIsCiAgInNSIjogIkF2U0JpNXdENjh4elQ0SFExeC9zdHJlVml3K0RmTCt1UC9QcCtHcFBnRXVnd2xvNG9IUkNGS1hLY0VXaXk4WFlndXdVaXM0RXhDNjduNUl6SU1BSTE2dThlQjNyQnNtdHZ5TDdNb3Y4VCtveTkzbTQ3ay9QczlpNjJ4RGc4aXM9IiwKICAic1IyIjogInVzWHhudTVlblNjYWF2djhwRE50TWhwQTN1NitDZDlMMERZbUNCTzZPdEZBL3BSdDgwbVNzbEp2LytMdnhHcWcyRFM0TVF0VCtOYXhGd2ttd2xWRyIsCiAgInNSMyI6ICJCWnJXeUZaMHJDdFZlTWVaMXlkNEtaUlNJZ0Z1bjdEY2pSWGJmWXFwMkZQOThqeFVyU0N6bVJUK2xYSEsvVXpSWC9oNStQVFcxMUozT0FkNVRvNXBSQT09IiwKICAic1MiOiAiLTE2NzE3MTQyMzg2MDI1MjcyMjQ5MTQyOTMzMjc4MDQ2MjU2NDE3MjMwMzAwMzgxNzM3MDU4NDY2Mjc2NTY3MTg4Njc0ODUyMjQxODU4NDA2MDg5MzM1OTQyMDk1NjAyMjk0ODYwMzkzNzE0MTA5MDA3MTgxOTYwMDMxNzExNjM0OTIxNTY0MDk1MTM1NTU3MjQxNjk0NDc3NTU1OTM2Nzc2NTA4NTEyMjk4NTc5NDA1MDAwNTU4MDc0NzMwMDA5NjM0ODc4NTc4ODA0MjU5NjEwMjE3OTA4NDEzMDMzNTUyNDgyNSIsCiAgIkNxIjogInFiL2JPOFVjbVRTZ3F1WC9lajVmUC8wQ3Z3SEZlLzFwUGZXOE9RdnZ4c2c9IiwKICAic1EiOiAiQ1V6bm1MWmswLzNtTldRNy9zMDFTTElDaitvL3poVlJLT3haeGxOMHJDb28iLAogICJzUlEiOiAiREg5bWplcmoxQkR3dmFNUTVzZUJya1Nqek45ZmNqZ0dac2lTVzBMQUNCeFV5NDlMV1E5VjNYYTFyalBmNTRvUUw3WVV2eStyeFJYR0RPSFVVQzZiT0E9PSIsCiAgImNwIjogImhRWldycmV4VWlOT0NsSU53bUNsazJIR3VCd20ydTdXa2NJTEc4UDB2TE09IiwKICAic1lQIjogIkFpUVJNamo0NHc4SGtLbSswVDdhbW9WMUgvV3hHbXRGaSt0TnVqMkFYNmR2cGJoM2w2WDlpV3ZNNUNjKzZsQ1lvUjluNDlBcnR4ak9LamJPN2J1cEVBPT0iLAogICJzVlAiOiAiQTh5YWN2YW5LRU1KTG9hRHpYVVE4VHRoRExxYzVoWUtBQ0htWW9JNGN1ZG0iLAogICJzUlAiOiAiSDE2TllOYWxvc0M1SHlKdklJd2U4RjF6OGdLSnkzWlVJd0l5VFNmbWZERE9nSitmVjU3b2tYMTZxVWdmRGxybHVLWkI1SUNHanFtU0ZrSjNzRnN6Q2c9PSIsCiAgInNQUCI6ICJBcVpoQkV0VFZrdi9haEkyZ2VFYk15NWVDbW5uSVAzNm9XQkk1bm9aczR6Qjk2bUg0T0E1VmtveTBWMVFldWUrN3BGN3dNN3JzM1NjcEQxYURQbXJlYXlkR0RCTnA2VjBTOFlKZ2pVZkJ0WUxyUWVLQ3Awb3pOUWJVd0RvckJnPSIsCiAgInNRUCI6ICJDSDNscUlwMlhIYnQyM0twVXVpNUdSV3Q2ZUlIQUhUejdJaWJPdjI4cGQvbSIsCiAgInNTUCI6ICJUblZLcWxyQlpVQjN1aDlYTzFMQ1diWE1hbTFwZm8yY1JoNU5uTHdRcXJhelZXQ015VXpWanh1RU5pbUJCQ2RjcGx0T0xVUVZRTVFwTi9rVlFSRitrZz09IiwKICAic1JRUCI6ICJDelQ4dFZDSU1rL3k3QUhUbzBhNmhEREgxMVFTNFlxUXpOMXRTM2RQa091bklIekE2OHg3Mlh3RjJMZ0poRlZscGRZdVJpaXY4VjEyYUNDS0l0OUFZZz09Igp9',
+            'ewogICJDIjogImgya29HWG5YcGFObk9WV3lJV3dYUWdwVGR1ZjJpV244YlgzaEk1VVJUTVU9IiwKICAiZTAiOiAidTkvOTBGVENkUlVybCtnTWdUSmhNTGtjWXJCVVZOYmhrYUpLMW5iR2htMD0iLAogICJDXyI6IFsKICAgICJvOStWRzR6Nmx1UFM5NzM4anJWU0ZKRStMclVHQUJ3Zk1kU05ScitqaUlNPSIsCiAgICAiNGJxQ3QxOVVHQ2JndnIzemMxVmlJbTA0eE5TdWRVVUhjQXpjLzRJVHpBZz0iLAogICAgIjdFTVhjRlYrUEpQTi8xdk80S29PK0c2Yk5ZbDRabVBFekFCVGFEYUNkTk09IiwKICAgICJ6RjNUbDRjYzM3TUxZUDZacGxoYnhvYnRVei9lczY1M2U3MkFBSTZJMWFZPSIKICBdLAogICJzIjogWwogICAgIkZsdEpCdDlqdEJuVmIxRnU5ZkI0Ti9vUVJwKzVBemV4M3luWVlxcURpQ2FQUXpGTkJiamlxTmtjWTVGZ1ZRbXF3WVpobEhFMXlvdG85Q2IwcEZqdHZ3PT0iLAogICAgIkl5VkhQb2M0b1pzNGJsV1VCcVNFZVoyNDJ5ZmdmTDN5WmQ1cVRGOFlla2syOUZXeW40L2pITW4xV2NTdXlVTVZCNzhoU1ZzeVBjalZRT2JTSWUwVXFBPT0iLAogICAgIkVKcHNhY1JqM1pkNE43bG9pTnE1bE9lS1dsbkZUbzZmQ29nTDMwM0ZZTkZHVkZQOUg4MzdjR1p0ZUZTT1dnZEZhT2JjZjhrZ1FEZDFqMUQwL0daV3hRPT0iLAogICAgIks5TW9SMmNkTS8rZm5vWWpDZVNOMGs1OS9SMVorTWN6K2dPQm5yeDNVekU9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAiaVoyU1Y4SlF3S0luZlV6OU1TM3BzT2xLbWdpSkRrWUhjK21aWkpiYWhPZz0iLAogICAgImUwIjogInlDcTdYcVEvaUlkeThOZmdRNTBlOHZCY3FtQzJqYVgzS0N6QW9SeTAwRU09IiwKICAgICJDXyI6IFsKICAgICAgIjVqdXM2SEU3SDREVm1MQzZtZ0lVSk5SZHJhM3V5aDVaYVl6TnNZWFJJM0E9IiwKICAgICAgIndDc1VtTWJYOTc0azJ2SVgyZ0NVMzlPWGFxSkVVNmhkU2t3bHlvSk15aUk9IiwKICAgICAgInl0ZlJOYXA1WDExU2VEMTdoTmxIbUNrc0xOdU90bWlQYkNOVXJFMzNjRGM9IiwKICAgICAgImxXTnVBZ3hsZFFIUitNS2JzRkZqdEsxQk9TdGRFZ3M5dXl0NU1abklycG89IgogICAgXSwKICAgICJzIjogWwogICAgICAiS0xLaHhXd0Z1QUpaQTBsdkVnRm1xVVJTSGJtazMrcjhmSUlQOWd2QzZUND0iLAogICAgICAiQ2JBOXBGamdVaG5ma2lHUnVYMml6aG5QelpMdzhVOWR5Zk5ad3R6Ujl1TT0iLAogICAgICAiSWt2bStjczUvZTBVaWpvWlMwY0s4VHNGUGhiOThNMDVCS3Fodm9vVkU0ST0iLAogICAgICAiRE05VjNXbWVMOE42WVRITWZRVkZzQXRrL08vYWZsU1AzbVhySktGQVFxTysxZ0h5Nk9YQ0k5aFNLczBuQ21sOUQxZUtTNXlzZ0VSZ3c2ZGo3WDRFNkE9PSIKICAgIF0KICB9LAogICJNIjogIndCVFNoeU9KT1h6VDJza2pFZUVJcVJmc1lYeTIwTFU2N0RWc0IyT3RWUTg9IiwKICAiQzEiOiAibjJHZDFNUXVHZGVvKzhWN0pWYkVEZTdoTXQ5QVZjZnRUVHFzRWN6NStmOD0iLAogICJDMiI6ICJtUWVMYU9HVVpqcENhUWREM0RVUGxvaW1xNVB4a2d3aGtoeGlwMWVVWE1BPSIsCiAgIkExIjogIm1kQ3hQUExvNDVFR2k1U05qR2xQM1RDZTZETUxlVTlwK0JMR3JJc0k2c1E9IiwKICAiQV8iOiAicDMycWR4V2txODVDalJRbWRDb3RVb0laYkdUQUo4N3dtRXZnTFdzS3lHQT0iLAogICJkIjogIm5KdzM4YkNTcHhNMmllNVFrUk1uZmVlZGZOZTUzNWJpTWpBRUMySzR4OFE9IiwKICAiYyI6ICI3MFZtTEMxK3dpK09vbEVJajVHRTNDdVpyWDdLUjZiMVpxQnNPempnNWQ4PSIsCiAgInNYIjogIkZ0NFp1cUpQS2NNdWg0eExWQzFJQ2FjSmlFVkRvanZXelV4YjZlaTlWbWlYcnM3NUR2dUlpaE9sclI3ZWhqVjl5Snkxeno3T25IajBmaFhkVFQvMWVBPT0iLAogICJzWSI6ICJEMTM1VUNFOXBXN0x1a3RwTDVJdERKNlN3eHVaaFV3RGVER2lNYVVHcVZaaE1OQ0wyemZaYm4xdjI5bDJzZEl2ZFk2ckFQWEFzYzJTdmZaYTdLQXR0dz09IiwKICAic1IiOiAiQVZTd3ROWVpIZjJIMzdnUktobGNEay9sRGdpMWRMYlVoVmZocFEvMWk4emtVS3NGUkxMSWROalFqYW1QNWY5WmVCWmQrQy8vVndORTVIZnZFdDdURmM1UjNzQW1NMlRKdDU1OXZXM0w4WlU3M1JmdDRYWVBvVFVNMXE2RlZDQXkiLAogICJzUjIiOiAiQmhHeVdrSzhQZ2lMOE8zbVRLQk5hU2thV1R0bjZNbVRqK2RPL0kzYzVBSURXVU93b25XS1U5QjVibm9wM3pBQkN0VlkrRWtGa2xQdlBoVXBnL2lBZEE9PSIsCiAgInNSMyI6ICJKZzlIT1VZa2ZucllwekgxSU9NRmhxYkpDekJ2bUR2TGQ4UzdSN2FUV3dIWHp4bXl1a2MwMlo5cDdQYjlyMTNRZmZFVzd6L2lQWDZPcGZMTWRDNk5JUT09IiwKICAic1MiOiAiLTU4NTQ2MDg5MTY4Mjg2NjA4OTUyNDY1NjkzMTc4NzcxNzA1MTUyNjg0NTcxNjg2NDY0Mzk1MjEwMjM3OTc2OTQ3MTUxNTE2MzY2NjkyOTc2ODAwODExOTA2NTc4MTk1MTg5NDczMjU0ODEyMjUyNzg1Nzg2Njk4NzMxNzM0MjA5MzQ1OTEyMTkxMjk0NjEzODU1Njc5NDU1Mjc3MzA5ODI3OTUyNTk0NDQ1Mzk2ODU0MTM0NzcxMDk3Nzk0NDYyNzE0MTMwNzE4NzY0MDEzNjEzNzc1ODQ1MjQxMDg0NDQ4MjYzNjIiLAogICJDcSI6ICI3MDlEYWJOS09mQjFRVlFmcjUyZE1FWklIcmdWWUlkdEJ6SHBjVnZTaHVjPSIsCiAgInNRIjogIkR2M0x1dWxHOVY1UjE1ZFU2Tm5mcGdiU2M1b013Y2tsd0kydE1mVEpoQVFmIiwKICAic1JRIjogIkY4blZUN1hQamR1ZmR3eE54all1SlVETng2UUdPTTAzQmJZKzIvMng3Q1ZST0RubjJsUFFDVDdiQlh4YjYyc25qK3VXZUhBbC9mMEVRNTR3Wk9wNVBBPT0iLAogICJjcCI6ICJaSFlNMGRCeHg3UWV0dDQ2MEpibEtWZ0w0QkxJZmhzcFZTdE9yNVdhZVowPSIsCiAgInNZUCI6ICJCbk83SWN5NTRMNTBkb3M5ck9pU1lFK3pTVG9leHhrRFRBcjd1c0JwUEUxaE9SRE1oMnM0WEJaUUNobGVNRFM0ajlBYk11aDZNczFpOEVyMFoxUVRQUT09IiwKICAic1ZQIjogIkF0WkZPU0d3b3FvV3NjNTh4RFhaeEZZL2gzcDNnUnVkN3J3L0FpR0x1dnFVIiwKICAic1JQIjogIk9TaWVxVHg5aVhwd1Vuekh5eStmUXFsTGlWUTVjYWp5am1UZ2dIdUZRSVVvbXB4QUR3T2M1ZEd3OSszak0zZmlJS3ZvLzdrenF0ZnZMNDh4QmpJQ3VRPT0iLAogICJzUFAiOiAiandzV2tnRUplcTZEU0hwNzJWZWNETUZZMFFIajMwb2hNWDhuYmdFcUJQYmR0N0NYa2JiUFNZdmlYRWRNR1oxaTV2MzRtV0ZxVXAwWCtxTktkaTBFUDNZelRZMk94Vnc3ajZEaHViQS8vM2EydjZvbkdJSEdTRlUwenRhVG52UT0iLAogICJzUVAiOiAiQmxya1c3N0QyWC9JWnpGbWQ5RnpGNGxTU2hEUHdOUXAwa0d4Sk5RTHY5QmMiLAogICJzU1AiOiAiWXlwU3RZZDlKMHBReCttNXNHSGJHWHZsM2V3WVNHWHJXdTdrdmVESlFwVzM0S2paSmtEZzJqWlBPV0NzRGE3b3J4Wi9YRXBzTkM0U29seGx1NzRvVUE9PSIsCiAgInNSUVAiOiAiQ2Z6bHBnOXVkN1pYb015cVB2bzNSdWlCL0cxeEtwelMya2dXRXRzSUxzVlVWeXNRUkY3NFJWaDBDTXYrY3k2NFo5NDd1RmRpd1IrTkJJUEVRQW1ybUE9PSIKfQ==',
+            'ewogICJDIjogImxiZ0pDbksybFRUL3JzWktVQnpwS21kUG8xdmhsbW9RMXBKZVl5aUJjeFU9IiwKICAiZTAiOiAic2ZBa2d3QnpZemV4MUllaTNBbzZQS0Z4VjZGNEpGVHcvZW9DNmdrSlhucz0iLAogICJDXyI6IFsKICAgICJuOU5Ra1dwUnJYbkZLOWFHRlE4dk81QWFhSHRXOEVGcEtNSzdRZ0RlTW1jPSIsCiAgICAiN0RCUytoMEdIdTNOS2JoMXhXVzk0cUJmSVpYVEdUMWJnK1VDalRRKy9yST0iLAogICAgImdIbUNJSERldGIyL3ZSa1RDNi9lYmdWbWdVeUE0NldoWWF0TWRRUzM0WXM9IiwKICAgICJpYS9YVDBjN0xCM0lIREhzRUNOMHFYQUtLNzBXMUswT2Y5WXBNTkJuZGFvPSIKICBdLAogICJzIjogWwogICAgIkFaaTg1bTBIVU9zclF1blBLK2NiUlZ5eHFkRlJSTFExNzFYWGg4cW1oYnNQZjhXY045MmNCRWI0NTlhTTdDdmVTT1U5QXJlYmJaazU0bUdwTExoUzNnPT0iLAogICAgIkc0YXZZdWZCU3Z0YU5BdkpabE9MQ08rTVdOR2MzYm11VElDMGx6QXRleHJzQ1NicGVUOU5WU0FmWHRINlFHZGFONkRoTnpIOE9MZXpoaTdNbU9DUzFnPT0iLAogICAgIkQwN0dSbFdqNmV6MXZWOVR5RE1VTVhHUHJlS3gvYnFkaEFZMmw5bmU2Y0NUcG9JZi9tU0ZGNGpNTEY5YnR0QXhLdU1FRlJSbTJYK012ZDRjcG9VQlN3PT0iLAogICAgIkpRU21ZOHF6OGQ2VDZqNkkveS9RZDZ6V09FMEdTSXllZCtQT1ovLzRjNFk9IgogIF0sCiAgInF1b3RhIjogewogICAgIkMiOiAibXg0QkxldGJqMHBlR2tjN0ZrR2xzdGRrV0g4WkVIQUY1SmlYVHNnYUIvST0iLAogICAgImUwIjogIkUvaFVFbmh3TGxMaXBobEJxa0dtWldtSU90OE9ZTnBpTnRhQndvRTRsc0k9IiwKICAgICJDXyI6IFsKICAgICAgIjdPc05YajRXT0JpeVcwa0plT25BclNhaVFqNHJuWTJvSkVaakRHT0V1L2M9IiwKICAgICAgIjRIdTFUMmtYZlJlR3lNc09BbWtjZlFkQ2dWNEJMV1VPQ2Y5ckM2Rm5RL3M9IiwKICAgICAgIjJXakY0LzV6V2FoSk1vcDZWOEc0TExZTVZXTW9lUE5yc2Z5VzR6dVpJNVk9IiwKICAgICAgImhDalI5ZHF3aVMxVVh2REE5bnlDeEd0MEpwV2ZXQlRQUGtHemh2SWVwTTg9IgogICAgXSwKICAgICJzIjogWwogICAgICAiSmtJQWphNlhLaHgwTndBMk1qWnFMdm9pTTZKbDRjdjlSSkVuSFhOcWdhdz0iLAogICAgICAiS0V6MEJVVDU4ZmdCMnhMSnowZ0FoL1Z2TkM5NFd2aVdlbkljcHFCWmZqZz0iLAogICAgICAiTEgyNHdkcngwaDI4K1hjL296MzBKOXA1dldoWVlRNTY1SXJBM2hrUVlhZz0iLAogICAgICAiQW9OK2YvRFVZRGVCenRvTFhac3hCa0ZsUWxlQlJHL3Ntcy83eUJpWWg5cFJhNnZ4YjYzQ0l6UHBZV0M1Zm1FTDJXTkdXMy92QW5iS2RIU3lmY281Q2c9PSIKICAgIF0KICB9LAogICJNIjogImdTdlEzZnJwSXBxaDVzRVA0KzdQZ25HSzZFR3dPc3FabE5tb0REUm1XMmM9IiwKICAiQzEiOiAibkFEU3BlRE0vUkdPYWVBMWxkZ1hicjlaMlJkeXkxa2UxQXJJb0M3UWQwcz0iLAogICJDMiI6ICIzT250UTg2Y2VrVDBLQ3NwQ2ExVmtkUGZnMWM3cTJvYVpobHZJL0xvRGRzPSIsCiAgIkExIjogImh2TlpGWjROVzlxdEZkdnB2WXNWdjVxTHlLTHV4VWo5aVVzUjVOWGthbU09IiwKICAiQV8iOiAiNUtMVWhEWGZWNEJDbDJ2L1YraUw2WFlIRXo4aUpTV1g0ejgwWC9lZ2U4cz0iLAogICJkIjogImtkU0xDZEdvNkRtZDVCczB5ZllkQTl1ZXc3SlpjRGhJSm5nVVVzSGRmZjA9IiwKICAiYyI6ICJydTkxQ1JvTDFSeHNuRGZWSm54VmpIelJ3VjI4YkFTNHhVNGF0WWJ4ZFhJPSIsCiAgInNYIjogIkZ4T0Y1YzRXd3VLbnArSnJUSFE5bXJJTCtVeVk3OFVCamtRM2hPaVlBSHRxaTgxMzhwL3ZtZmRJcnNrWW9FT3QrYXNIWW5pZ1NpM1JoSGRscXczcm9nPT0iLAogICJzWSI6ICJLZkdQVGp3aU53QTF5T1RmSzNnVmZEUlgwMkdtbC92STRNdEtEZUkyZUVndkVydFVCaE5PY3p4S09QQzNQaEZ4VjJTeDZsd0oyMDMwZnFVcGN5c0QiLAogICJzUiI6ICJCTEYyRGlzdVNleHNacS9aRkp5dmIxTGN1dStxY3p1QUhzMllDZ3hBcTFFWllmK05HUVBoN20vYzJqSW9hZHIySlRPR0oyR1hDbmdVbFhHMjUzekx0d1h3aGxLOFovN1pNMUFiUitQTElaK0ZaaHBvMXIyWHFGb1lseHVIMzQ1NyIsCiAgInNSMiI6ICJHVGJOVnJySGZwcXhvQ2VueEpCV2poWTZFbDhLbEZIOEMxSlVoRW1NNkFNdXZobEtWTTdzTk1OZmhHZ3A0bjZDU1hwOUhUalh2MWtlaURhY043cmRiUT09IiwKICAic1IzIjogIkdKN2ZxTFNGb3kvem9oWmpnZXdKTnVpbjVkalZUSk5ERVMxaVJOVk02cGtPOWFUclZjRGhFZFpLRFFmRnNKcEdLTlE1SStscUJRbUJERjZIM1RHQW9RPT0iLAogICJzUyI6ICItMjE1MjA5MTEyNTgwNjk0MzYzMzU0MzU0MTI4OTIyNTg5Mjg4MTI5MjIxNjkzMjA2MDkwMjcwNDY2NzU4MjM0MDg0MDM0NDIwMzU0Nzg2MTcyOTM0ODI1MTI4OTk5NjQ5MjQyNTAwNjk1MDEwMTYyMDM4NDA5OTg3NDEzOTk0OTgzMTA3NTYxMjk5MTI5MzA3NjM3MzY5NTgwMzc2ODk3ODczMzcwODk0MDk3ODY3NTA2OTIzMDYxOTY5NjMzNzc1NTY1NDk5MDA5NDQ0MTk5Njg4NDMyOTAyMjgzMTcxODg3MTE4NzciLAogICJDcSI6ICIwNzVsL2pDSTFydDUrL1Q4bE5XRFRlTUNJYVc1YWpFdDhVa1dIZzNhdHJFPSIsCiAgInNRIjogIkN2aXJ2UmdwWkFmaThUZmMzQzhLNnZiK0FFdG11YWlHZ1dLWWY2Nitkam93IiwKICAic1JRIjogIkRQSzAyMGVSSUgwTExGZ2NUVElJRG9GZmxwa3RaK3JmQ2NuRjgvSkdsWXliN2JQUzYvOExJNys5ZVBGTE9JNFVuZnh6QUM2ekZzME00elZmazVTM1JnPT0iLAogICJjcCI6ICJvKzJpMzZGa1llK2F1aXN0OEY0NHQyellEd3FKZm84L2dvQmtqTUhzWTljPSIsCiAgInNZUCI6ICJKMDN4Q0x5aG9RNVI0dytqbDBZeFVyYW5YdlRWMEl6OHNMV1B1VHZtcE04bGs5Y2c4Y3pqY2FZdkVFU1I0ODJKWFdVam5pd1ZhTEtWK1paV2ZMbUciLAogICJzVlAiOiAiQklvaUNPSFJaVlcrWVRyekdMWmtIL081L3M3eXk1Q2tXakFpVlRUQUFxcFYiLAogICJzUlAiOiAiUFl4NDBhMzlxMnBKWTU2ZzQ2M1FFV2xrdG01MGZlM1hqc1htUWNBNGNjemVIVVFuSEw0QUdrd3dJMDFhVENxSXg1ZkgvSXFiVUtkWGtFNEQwdi9CZFE9PSIsCiAgInNQUCI6ICJCR1hkTGx2bmppNElEc0R3TUg1alVzeGczQlpUQlYwOEIrWUtxNTdGdVVlK3hTS3QrWkw2NDh5Y2F1aW1YZHZueDBxMk1XWXRWUkpGWUU2WTZvakh1NWFyL2htY2VhWjY5UHNFazB4NVRpZ3NUMlNoRWcrK05HM1FhZ0VqRnQrUyIsCiAgInNRUCI6ICJDbE5TeFRjdGdPTGFSVklXRGlHRjZ2TG8wbmtqaHc0RlBaRDg0OUUvN1lNZCIsCiAgInNTUCI6ICJlQmFleGJLbGJYd0F4ZU0zd1V0SktralVOTWt0cXJNWWYzYVl4MWJoTExQaG1tN3pDMFU4UjhUdnBIVEl5Q3FLQWlOMEJRVU5OYWcwWlhYWkxCSUR1UT09IiwKICAic1JRUCI6ICJEQ0lrU1o2dWVZUllHbjZycFNlZnRnUkJRTzJ6QWJESldZQ1JrL3VRdUZnVnlRb3dqa29JL2t2SElXT2VheWxUb2ZRWEt6c3o3VGpmWm9CME5LNnBqZz09Igp9'
         ];
     }
 
     async initializeWorkloadModule(workerIndex, totalWorkers, roundIndex, roundArguments, sutAdapter, sutContext) {
         await super.initializeWorkloadModule(workerIndex, totalWorkers, roundIndex, roundArguments, sutAdapter, sutContext);
+        this.next = workerIndex;
     }
 
     async submitTransaction() {
-        const index = Math.floor(Math.random() * this.s3cProofStrGroup.length);
+        if (this.next >= this.s3cProofStrGroup.length) {
+            throw new Error(`worker ${this.workerIndex}: no unused proof left, the round has more transactions than proofs`);
+        }
+        const index = this.next;
+        this.next += this.totalWorkers;
         const request = {
             contractId: 's3crossgs',
             contractFunction: 'CreatePseudonym',
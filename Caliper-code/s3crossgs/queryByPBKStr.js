@@ -24,16 +24,16 @@ class QueryPseudonymByPBKWorkload extends WorkloadModuleBase {
     constructor() {
         super();
         this.ppkStrGroup = [
-            'qWPZzpgj2P6Ks8UnKKshq8WJt5uwbhNbzAViv3xlYB4=',
-            '1Ftq1+GWxrnWrYC6DxwfVnuIP9i08aHg/6gdeGn/6mA=',
-            '6JCpp1jeBQmODNVAP94ZLAZMTXhkbY2WSB67NaMnNM8=',
-            '3lcvxD6T4EyeeUzv3DOkF7F/tGGZIYu4bKqvUamB9bM=',
-            'liLzsyJOOTNv8Xkl8oSqLUrmbYDL+TKNmRPL2E3qTBU=',
-            'jC7D1KppmML5KdOPVe8CXc+468uZU4LnK3MKtOMaHmk=',
-            'xbeZrIRrQii9PGd5HXbgUc9yVDeRLGO7KQOEh3D6Hp0=',
-            'xUgDMD7VWp4/l+Ez5Lb854v4pWKGIuBB6Mk+sNiyvgY=',
-            'xFUOczDv0RWAJ+XaDBL7XIax147piez17VFTRzvusdI=',
-            '1qE/hu7hIwYGBTi1j547gDqmKZvFESuADXikLxdQvRY='
+            'zsHYB3MnbDbtKRaPTJQ+tEg9u80Kp9qk/0e4ZPz8A+o=',
+            'xStENvDTT2knuMx7hWVpSGI43C8U5PeY/iT70ywPHTc=',
+            'lOwuGPY9iZScCbcLfLfmvx5vnug41C7+tHuoffHJxVM=',
+            '70dYQZ+dbI5MZRgdY8qauHZei5Kbjzf928eFx937VOg=',
+            'wBMsouEn2GmsxuGq/t52CVHuKXfi0RSgjtf4o/LLJIs=',
+            '3zYRbRjUz2OeicNRbxFSYh+JhT2OHAFTMFz7oyY1Rz8=',
+            'ypK6oL1fb759wNc1e6FvVGFllE7habl+5ifp8KeZ2zY=',
+            '5OSw7hdlhfRgU7FSh8Ks5BrSOUbrgzbgDlfZSfHk/aI=',
+            'n2Gd1MQuGdeo+8V7JVbEDe7hMt9AVcftTTqsEcz5+f8=',
+            'nADSpeDM/RGOaeA1ldgXbr9Z2Rdyy1ke1ArIoC7Qd0s='
         ];
     }
 
@@ -0,0 +1,53 @@
+/*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+'use strict';
+
+const { WorkloadModuleBase } = require('@hyperledger/caliper-core');
+
+/**
+ * Caliper workload module for calling AdvanceEpoch function.
+ * The chaincode opens one epoch per EpochSeconds, so a round of more than one transaction only measures rejections.
+ */
+class AdvanceEpochWorkload extends WorkloadModuleBase {
+    constructor() {
+        super();
+    }
+
+    async initializeWorkloadModule(workerIndex, totalWorkers, roundIndex, roundArguments, sutAdapter, sutContext) {
+        await super.initializeWorkloadModule(workerIndex, totalWorkers, roundIndex, roundArguments, sutAdapter, sutContext);
+
+        // AdvanceEpoch needs the s3cross.epochAdmin attribute
+        this.invokerIdentity = roundArguments.invokerIdentity || 'EpochAdmin';
+    }
+
+    async submitTransaction() {
+        const request = {
+            contractId: 's3crosszk',
+            contractFunction: 'AdvanceEpoch',
+            invokerIdentity: this.invokerIdentity,
+            contractArguments: [],
+            readOnly: false,
+            channel: 'mychannel',
+        };
+
+        await this.sutAdapter.sendRequests(request);
+    }
+}
+
+function createWorkloadModule() {
+    return new AdvanceEpochWorkload();
+}
+
+module.exports.createWorkloadModule = createWorkloadModule;
@@ -18,34 +18,37 @@ const { WorkloadModuleBase } = require('@hyperledger/caliper-core');
 
 /**
  * Caliper workload module for calling CreatePseudonym function.
+ * Obsolete: the proofs below were made for the static nonce of the first chaincode, the chaincode now only accepts
+ * the nonces of its last epochs (derived from the AdvanceEpoch transaction id) and stores a pseudonym once,
+ * so they are all rejected. The rounds of config.yaml using this module are disabled.
  */
 class CreatePseudonymWorkload extends WorkloadModuleBase {
 
     constructor() {
         super();
         this.proofStrGroup = [
-            '5wGdTlUjACa+Xvu4nwG4Qj1dFHPylgNNFj3sIz1xYbLmS0xrByGgJf/y8OO2m37UNTpKrj0GAgo6+HNL0dW0FgIQ3+LQNMzlP0v1OQPGPb+6bsIMZmtXs8jtgwePMyMS0qg7fWaF5yjdhZapha3A5prViWqFKzXSn+O7slcxUDcAAAABzdt/qV+3fHzH1lRxF6q/AbRXW5PsoQVPDeniQpZGHiKD+qf6QYSBohU75i3LgJIuqysNCuLRtl9YAnjTVHA9XQ==',
-            'r9qYpBPfbA3bybRbH3TXXMbnpWxlrAL7FaGfImyYOWqU93auZalFKvzzt+viq87wzQNDCfRyJ329bm+YpjJaLSECHgHYVdozntw0DhHfKE4Ws93BvByZ7WDHCRPeum6szjbN4C/RwiYoct9oZxHZstCnPb0k3SP3iU+b+cxvc7MAAAABpOmZGAJh0CWxaP/7upGlYD56uRAKwIv5oPj2r83H7PWlLqha6YMPZ3kVG+oilI+IcLk97FqVrRw7og2rszBGHQ==',
-            'xSetjvXKouqPVMQ9PSvRnnIPGk/3UoDFXUAUDyyCtfXLn5vFz/iwV8+RB0oPu63jFJFX4Pfc9Vba3HROgVwH7wst5kMClTQ1qimByW/yG2xx8qXlGlNp6xi+Lx/KDN1SqnT+21TvOOCptELzRXN5XbSQgwjDnA1gMIC3zZ0F1K4AAAABoi0jSDXkEfYx6HyXL8EG7QDw8GzT9N8e0LPhEOnTVzLoEJEPrXt7tzZTyn950buM1ufv/LtZa8ZyIk2XJ7Sg2g==',
-            'yganyFKN8Vifjbz1nZ9mNaHuXqA2P1F9Ue3UVV3yedHYMmct6ImYiYP4AdKoaxWQYnSiBzOnwfQOE4y81H422iz+xdoii0yI47e6J0RuZijxr3g24JfUPoFZQFfaTI/tl6w7RAA3mFDhFyQ4YQ6U9UwxkmXawHJfAsPPss8WQB0AAAABhoaySxPlvh6ZdYUMyZlc0U/H221VNDjFWDNNVJk81yqAcjMCJ/9NZbruOAZKhwbVBPOZyNtvAxLy3rUXeCgBiA==',
-            'oJJZ48OiKbWX4fIjNnlHwS4px7OpJMge5xrrbk6gPX7SIxxlK9NbLVvg5GDUHliKfZQ8p7lof9MOzvjzz7smRyIbpXSJn8LifzG0zUdFB5iP5pqc4u0E1JzkzxkkbgMsmVMggo9F8BjBLRwjLxxT4saVxfMBvKSa0ncKoOP//IEAAAABw8jS70w4WtFvdnvDBKm1lhQ69XSS5lfFxmNB1gpIBX/SGRgW0YUbqJxlXKjb5HcFFdksQRc30rigRROPABMxLw==',
-            '7jxodisWR57iYLNgyVuCLSq7R/RlxjmDeHL4rbBYM2aSUZb2F8Vcdp7BEwfxTDYb4ygxwxtddPaei5ZkL2/VUBdy/12Pp1D1EucdhSdi2gJbljfeSEFvlsHLUWhRk2kA1NnHU/ZVYt6yHSLt4QrD03cujhxRfFR45dSeGJQFIaUAAAAB3p866+UBYQsDVCRPxLETifz1KkvDIXlLLneq1KeNzpmmicokwidOmhE1ixnUiywasw58gpFjF8RCqsc3fWodBA==',
-            'zfTz4XVkz141jRNPqpn0N0E0hUnTFS2Ywy77VcnQ+bzhIbP0ffzOZvRiuyhBzkF06StO3Ufc9GDB/lujVAoywi6QDfMh4k9zUDxAQgPnBlQ6KkELclZPKV7XmtoiVAaK0jPQJCfTXJLJ1OrwOe3HwCVWLNAwd7xQPEzFccioY9kAAAAB4D08fSiVAyzev5cNBD47m+Y6jyXrHYo2SjlyJKAuyIKTMsxrRCtBwtMY45z9xABbxyEhmUNY8Fwknxr0ikf1Vg==',
-            'ilh6sT19QbJr4IDbsGJcJ/oXMD0p4+6leM9Dj7jhDOKAvAeXwkK7HuhVIMHoMHhjTb/yEBdjzAEBOl+sPf1dkw6PEECMR3VtwsLDtcLdb4AMaknCB/LH/NfrTY7jhEeir9kpmm2xI+IcgU6/NeHTtgdbd2jBkfHH4KbcmOpfb6QAAAAB6uqvw2X2oMiz1a0c0qErJDth8KODJeKUtf9GyuVBggLN+KGG0bJJuK8E6ndRBfixkKhNiwQ3hLjpfhb6xZRK9g==',
-            'odGFPFXm5lv6BaodPhYrzSukzgkGbSCsFUKq8o1OyKvkgA+9j90KVwZAlB2CsE85sheE/Gu0qPyUoQvoF8lj8RWi1dkLu35lga+hS+CgDJzVZnkyc3Uj7eVvguEp6FF0x/w7+BO05b+t/cqKl+Xw1yLabKxV90bU+kfGc8jeylEAAAABoKK1cmnYvbZ3p0OvwLbtKMTZ6xj75CEVIoCmJ/sFMjbeljbsj79OZO4u0TUIRcnUldAlev5kWLprPEM9M7Z4JA==',
-            'nwN6KdyhZ4eVyiOuD9fopSNWGwKWJKacNr4sbP24MTziFie5L2Sfx44Yp9H9JIkh+7FhK4B8RRHUiPUp8JYiBwpCdsJNuFYf+I7BKl0bTEvBURVtXL/Kj91jklr1CfAU5Az3jTTWM3v+EceXOoKZk7uaIcbL+GZmQOV1irbdX4IAAAAB6sgNrC/Sj4rALpRt+Oifav1/zjsbyMRHviBTSialtc/Ug0gOPW6tyQMVE4ud2AK8nYxmh7de6Nw+R2Nce03Gfw=='
+            'y5ah03oYeXIPhsZ2oJRIfvprroLhr/J/EdV5tlGjj9+bVhlAnhawaSWOPM68gGrQUMgxgxQyc4K4OeYag+bXECYjmFCfhjNJgSdb6wo2k/yO8k7tMiaLy1sO+b3r0Qyo2xzL1AbXoaIvERWm2wNyS2zTCQweCzLxZT4Eusf5WpYAAAABhjBYqxoQjKMLgS1dedhL9UXgZgRXDI8PzZq+QQCEZ3nbIloe/QEvxqGt5efvePD2K5S8iRV1iRE/Y4NqFPJ4mQ==',
+            'pcYi4HYAa0HU3r6t+bb7Ll2uJCgpS8jP8ZMPqs8VEYOpxlzy17C/j9l5qItmV40dTWHHIrw5EWAVF/q4AMQk/gqirouTJ/8npCGj5v5JWj9GQJXzyhBBUlmPhfhj5L0107K0UHs5hEU/IxsJBOx4wFf2bmhGAUICQjyyyLKd4/MAAAAB3P/NL7P44yigHsIzvreugxm0uRK1XjizhQ2+rqxT9Cuj5yogjnIRhuaG7n/nEnCuRxkj6w7YburNmn8QWhQdRg==',
+            'gt1A/aWiHK6HZY6ho+YhRq23VZq7kcnFagNCyz8KSiyhmHijI8LGGWNRt7+DdTYEBy+4L+MeVfMKWSCbXmwatQ1Zvfr5FjLZfhaYKg1y7r7nzgzeqYSaQbdtEmEoxd37rEmiCjyLsCME8qmgPLwphnMl9XQqH9okcgDVOO0fMBEAAAABoWnaW6LmISEZ/kGdKTJUSAA1vn1OowlsJIO+EP3DkIatnX4sKSCCAYNkna/CUK71IwEge+BTCSBninMsC7XWSA==',
+            'wtL7LaJlus2njL/0zOwAebX135Ngf0tjJoXR8vLwlQjedusTKvmgzVifgni08KnnGVqOPJcBLnk5uucfa54ewByxr5efGk5Rkp+Q+IumRnxb7Vx1TBERgIE54kzzHd97pH5NjvmTJlxE1mzKNqdiD+hPvtt+5s7bUcHZ+cjBl5QAAAABwCYkapz6Kmg4PeXubZmTMmnbocHricAV4Raw5sEBIwOcOnyUQTk3efir7DMcxjG4/TNKIyUT+fEnyG5nTNIYTw==',
+            'nR+KOrVHFCiMpjMYmugNYvrTHK/+aTRUTyNyRvsC1C7mQ5flywJrA4yOK4rFVXLdgZGt1/kdBLGifBritO0WCyox/IZUGkx6gGU20kPxeE5GSa1bgQ9BTdqWm64VNcwarjTeFh7Z/Q+QcuogfPEL4jD/hQx/0gy0DZyGXbmKY0wAAAABl+/YqDBSlKQ73MUUI9LYTduVHBo0Zv6cYpgcaUakqrbVzV9GTEUCLUo1lepjfcLJHip+6MZEObfP3FWJSOYXBQ==',
+            'kNGccBA6cmuCQI/e7KFVFulhlXSJmtLkzYq1+C4YeovS62u8KHp4Jn9TA+G47BzbqM9+5qP5CNgIB0gt+EAxLRQUwF9mL5czQ6kF8ujJHIjPOFp1r6XS1ID7Bxdk5OdTl+VeMOE61vWBzqxqx7K2V/IEVsn1Vc/7y4Qf1SvWwm0AAAAB0w8NRqR2Br5s8B1oo5Kkpt5+VczjJH7TwVb8m19hoN3F3/nIGru636jm2V7hgoH2PKCOU9FC+yD0nwoecFOV6w==',
+            'wvhVY5GgGYtJ/mmbvvy7WSZrv0oAcOvodxPt020ZsO7YlZG8IFMcMrvOTrXzQ0+hFGBTkGzYn/hrD2vZxhf5JCyQL+oEIR8sTpuTSVFkW5hMmYGy9AgCgKgipYjRRRnR2rp7tCJyBJIpRwJtEB1+m8Ih/auB5sk65jtZoa5cVVgAAAAB63bRqnAzP22vps6SoOBBQSBuw0tVMf3F0bQWJrd+H32riMkBjTQqaOrF+T6s55nKk03WVJWRBMZofpVcaJGNHQ==',
+            '3UikcI7tBnQibK0ogV7U6BI2Rdj1YFAbDEZcRavM5PyQAg8y3BfwqC5wJW1CmASuuewNICcYipdRibRPsvsAixU5f5cTEqyfU9MGBC1QtmezYRBc6aS2l3t/77ef96/w1fRVMfYimKD7vldSGESXZ2+MNtMgKOoKV3w9sev+PuMAAAAB3olQZ8qXHieog613qzD63Dct1PZjF3BvtT5vEQzq1UqfYxt7Qp7btsHeRq90NlB+guK6RkQDwmkQjG42/8PRFw==',
+            '5n4MZoaL0GnVhuJ29fl57zKWsWwda9XQKTNOGBKhhP+eIy9twAPgvhOnbHzyDp1PlPlVPer9E96BJhI5+UMkxRg0MbISz9SkOPuAEPlRpIZD6AHYCZgqXDTPP6TTPFbKi7yiG3F0ee+RLGAlZgFrLaeqAuRGhDRVRJTa4+9yr8YAAAABwQlo9OV0aiaHUiJTHOowUTgeoRNfsZpluGaL8bRCPSfAEaRPjc44Q2229Pwz3Cqq6EG+MiGtD0MLP8YL9XIOCA==',
+            'pDLIMpLbSjs49Vgkjs4HyrmDoz9DZbaXCok/TgL0LZDU6/M3xdGfkfkgQnLay5DW0L4NblvQIl4Eykt3dhYYqwEQQYWR7mdrouhbMJ+jiijAUhYJQvRAoe+CnSn7yeLqrekRsyBS8caRkqpEFww67vYC84CDIHHVfyRaXOO6UlMAAAAB47rTH8/+oEYLQH61A0idIrXSlvBbSegPVgA+dvFrLDalqlnfmiSG4QNsNyCpUQdTnlE+mdutVS1X+ccElzlsFw=='
         ];
         this.witnessStrGroup = [
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykF+SfjaUmqpcHxlWq1UFTmP0TVki0h8J3ajdcfkcjxwAYrZX1Vm+B4pPx55lNVTGnBqyIHHXY8tsFKrxNmx4lQFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BycbrrTNH5vn9l9ceVRf5wSfBWZ9b18CA2s8KMtxFaxlAui0ay0fkEwiBRz0KCg9GAJiy6KMnH/HHkl4hMdWJy8wTlwunr10JS7rHOf3W7SyFr6tXRtitD5Tg9iQsZPDnRU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88g0GDyRoU49ZajCdG5UVTkBXkck+o2ot/51qk1I/dWKwb+0OlUHbQRAfAfrN52L6rTP72gWEr2TwCsNAu6ycozHVg0A5FrdXKCu9UhvzZdAhSmbE6nF3gs8bmOcpQj1g8KpAkHJx7QKvbRuCoJSjViOoc6vpLAWA1TmV68xjdEpg==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykXBSPgNfbmpKHVAcsloyS6eMJ61NbKMxqwTyA5rH//ixcvZq6KkhXJL+P6+JirBPix8OszaF5BSQp17PQoL9KFFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BxEMVUqjKQJhkpPQU8u3ZR3Hlq+6h9dtoZ5Qy8TQHOZSFPnGZwPg4P0z2X1GJTrGlPYhZA9PSHhnAC+P1usU29kvYdRw1DSgqzMsiX8yBPKBxalhCctmrapCTkUEB3KBRBU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88ZI98rOeeoCZoPs2e1wVpRFgGSG7YbbfUOm0Y3+r8W3C89M2N3LLlQBslD5B2IRki7pT4M+eb+EpAYHVkK+t4yJVfqRX2/MqkP75uvYRoOexEqbJ2qk02y0V7anuvvgeMfGV77dTcP7QhwI6dxXeLl+uzBV/+QZaIAx/MEDtglnA==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykcoSJXLr4ZU/dT7ieHqWE9qRVRBNZSwd+vEbyx9hfPXhy4VY6+5phWNdMCY09KN4Fs9lpvd7j4GXXv1Tr0UjoRFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7Byu2w1zLqQVE4kprWHQOXNEaSavF4aHYoGV+ePqM+MtqEuOXdsz3Qy5w4S4ogCfPPHsaSku0oCo+PMp3tSCjxeMTLD7NWmzqJmrmNjnaXoJIq+Z+RuA9dMFBUNzGrF/JwRU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88V+fEi+4uqMi/498qlXykdbdo3qSOo61UURKSeDbuJGBsOz9z9ycWnPA9nLP3IJ0IjWzfhSOPH/2hcBxutuPirIuLW5Bgr96sJlH+tyvked+jvFSewMLwB3yWpjzO9ffQPyQWXEBTXhOh/o5X2CiR/EAcuZbJxS0TvmSSZo1sf+Q==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3Tykj+r7OMdq+OFgLtS2lh2OLgNOECnkrPjDyHYweey8jjyQBHtyKZL8FTrksh4/sgK2dFlfoDQbHaXI/qJCkDhVbFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BwJ+kvszpCzyWDjub5ds2sasgRxZGIokKiNaToDZSqGTHEumdXeNZzuUIl0i7Zw4MD2nNUyAQweDMGVKet+t6tEGyAQrsuQEVgg5t8Gs48F8mE5Z9h5dfDI/tge70aagqhU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88GPKHuyx1U7KfA2elD9LLmpyOpwrhArfNrxoLwxZq73AWUS+gF9MeQz3gFnHq/tGsAuNNOaVFC2uAOkKdv7i9xJttZ+RcSsrVvgcZ1Ul2hT2mX0K9diCPirhvDPKlNcPMVzS8a9kS+otgMeO3n8Jw1a8wQT5xsvKWMZNjgQh1Pdw==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3Tykpw27h9TWpMcj16iGm0eEJItTu3Oh32kwEyDaGR57G7ynrohDzPSCKhwKu3PJUxPc4uxSEyMCJLFvRyA/F3/xpFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BwAawln+X2Gr0BbsUfOSeWa/q/w+sl6e7F2r96LA6ZFiCURuL9zTD69Yl3flYNeKAak4GFzRKrUmIckDM+Q29QAafoSNi+uyGnA2+KLWcbKSuBR22j5mOEdbpm12Y3k+VxU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88p9vyOjB+lvq54U0qz6MA3b62YOWahyar4KZhmVx7ifAwN9QF4w2ELsS2tR4XtIbbyhULPu52c1qmQP0qq6PxGFYMqlXNYFKpSGOLm4RULJ1UoMjxR5xgRHsRzfde70xsYfMFENJQQ+h40+sko1Z/VPAmpNNbUqqrMtVQsV03hYQ==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykAqHf5nJdBP+OiT0xsvX1NyyQsz8inwlxXFSrSQMNvWgDgo6oCsklLJU6jM6Wlb1i/Cl4T1lz3x5kj+jNrxhE0FeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BwCkECQ0LjTA1hX/iYWoTgw9HjdMKtchvZZDGom/mCrJAnQ1wamWssRq2Z/OfM1TRJh5P+Gi0CcUb7DOr1lPjNAjXwkxcA8zyoiLIsh6cwrvadFICSE6NOORxE8bXL7sERU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88sttj38x5l1MnveS+ldDP1HbHI19Z1ySqNj1ECHmWVTg1O0EEFfFhE26S5/Ll6z9n19Ye2dinf1+KYUuuBwGDtKGIHr4Dbi5+SnEDVDVcgXvUHbFPvI0qb3PSHDylbInYFOoU+MRGBPZU59fnOZyGETVxJr6vubHGMw1HoydUSTA==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykFLzuByrfuZOFI4pYTKkPbNopEfHUJW5gV+B64WArIYQVj0u3E4qpSuxqA05wfGtuxR8EPMj49BU5k4EmJM/MgFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7ByM+LzNcdu+KAwpnTx3ZDO3ftblQeuTEsuGxUwn9E1nOECQtzrSlcDF6nzaAOEOS+TKJS2FV2OQsNcfXPCf4vgUXV8DW2UP5YXblVAarEs4xEPqpoYiK4HQkA7s21YHBwBU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88qhvgPzT7sXIGq7WiysYdOMrxDmTIwZWr3MSQPpw25MwXsha+OApKYLWUhclLtb5nkZVKwH0CXMmkXWPnhex++GbfdkUPzFVdAKGr2eZGu4SJUdOLkDLXsJQRsfNFSXr4i6YWhPA9Rs+6uojJXOYXRVlq0OQLWsGj9490VdFjR8Q==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykGNYy5BfZisjzRxQxzlq5XMaxKf02FWZFzNLHbhSc6SwZJm4y19/vCwYdSN6pRfLK3axYZ8xxj37N6NpMp3zY0FeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BwV2XA8EdYYrNEWd88ULWrkjo0Qw5ZvkIP6pnRc/yQYIHiZQZSwuemUsLfVV2/dLc73GXQpweNDNLbtjcBcBFuUFg4uYPn8C6qNERh4vjPvHTC8oWqiuhCpM/Amh5dp7uxU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88iAftj+DKkXYGayiqqvBbVp1x1npTDd1Sf0O+wJgOdExxjig5JZ9/60wF/kKifOKzsgv7pw5OqgAA3UeK2mn7KHlNu+QX7Dqb1vKLevVoKaeBxGU44xGsIcvmWM7G3hlEIXt4yo9+UTwx2fsZThozD4frIqzihA/uDLvjL7T8SKQ==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykQ+Nzfc48aixSVPZKWYQQ9jvNn9FUAF4liN3q4aULhChETlF5jfooZQN+1Ws5cXOAnXTrAh1YFwGyLzSm0GOOdFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BzBCrSZxr2FfYBc2XNzW/Ow9LSrncuN7wZjyTvcHP66/HqzLUOl06TN7BDHRdac+UT/yukjtlgxIOUsBNY3gCcQKQ6OwiBN27If+mDlPOm0KxAqKENGAWu9nFzPUXI4lERU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88oy9ddPlztsGKH6L5TQz5iA0XnJk9LqCoaHs54f6v7kSEiG+RzbSr10gNZlfZ5QueA9+himDO24RD2Nf9HpDBTDbdSI0T+jsvBGJBA4rAx9mwaRwL7b6gYJNpj8WEc09YLkfbBV5OOJ2rjBsxlPgUg4Ww2W2317igEs1QbrfjYxg==',
-            'AAAADgAAAAAAAAAOKmOP/BKB29JlSTUqo5qzmEQcc7BT5q0rWGlvbg/3TykIGAeCncvgeomTe2+zQUVJdIOgxuUR1oxaOfPwl2itOwgmrRHWqPmc/arx8sFT/ZoDW2JSXW5souYtsfQmc2nDFeruvXqK6blNif7uXXZXpmSue6/Mhuh+9jQ4vqMbmwQO6dfW3k/L65oKiTLgERTGloBqpO0gyI9TJ1m0xmX7BxFLAdTSAvSt6bzIVeMmm8CSTUh5OC3GzF7UE4lG3z/EI286NxhJKJsE1pxQKeMOnV4I7WVVQIdbAl08N6wKOjUHWJRsG9LqaCjVZk1fDNke+fO6Bpt+SFfZtF0m3D3BSBU5OTXuzrBSNUKfrmxiRqLghInYb5djaLtElVyM5ZY6HbUaUdxACfxNF/3p2HkNpzPUMvlAm4644oq/vKsTs88l0sZ+M06QrUzjkZdLidiOgrrJD6qv9klUm7e4Sd98BCI2gZCWaYzfU5KR67/PBTxRHNn3a57+AdjT41sUjHjLF6Kf+B/E2BmtHskumXrzrmlrHmQ6d7dJWH/2cNP/q/gv2fofqKEtaTABzK+U0FCiEfeUACOeI0TbBcqT9s04LA=='
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHCa0hbRWykrWY5WRyNR573KGb5fAS+vc4bs7je018m0AuE7agyhw3ORMeSjNDmp0ZRQYG1jO4MeA8rRZMdlsC2CcdBV3tppwQWUExm1KHJ+04EUxxAYevtdxrYOlXhgyJFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzxHLngfV1TR9hbsTztEK8MfGehB069kuWat3BshbaUDPIPNgfdhhCjcR9l1zcmx4TjWDOCIqu5XgQXEANMEmN6kcrqX+NgxZJ1Ht3anjRC2HawlOSXHUwLvmLea8PtM9SwRVoIOfDUWe8oVFMleg+8trLYnXutixS3v8kd7jYNkM',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHLPMh0vGi0Zb5m0NyP0+xN6xYpKoT6XiLrGPhX9JUynMbbbIUj8QDaU97ODDpHn//ZF14oln7nUifJ/fel6Q8YA1UI5xTRj7dbj3Hrva6bNFV5Nw+0zcsTxWBI/YrOkJHFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzyYv5zh6QE8tj02ABd4/WpwSwZTHLID7vH7FuZ2x7F4iJ5p1GomesRSdIe2xRhNsbWtQFmZZZQQheCNtkhV6z+gWA3SP7dxNJTSp3827xL0aMl6xxhY8WgkvvZSYjmHMly/b/SxVsCtcXrSZI/k0vlrkqDRsP2SiW8VYEn1v7mJm',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHE8cPpWABcsIg5w8De4Lky/bA1SWe+lQl4FcUacpKpgcYRrCWlomchmVsFbMElt1IhS0uGahKSgguRpC0mUACLgNdOqEWtn8F1Tzq0eLVCGAaMboRE+fTDPqTau6j2Ut4FTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzw3tD0JPHRucA/KNGZUOfyv0oBsu4BE7k9aOX+yHjEzuLflK2CkmB/0O3r71sFlGzBU67+Lt6KGCVcqMtSdNhUsQWVxrGYdAg/1gbsk77o+FGSDqCu0/8J3ViOeI1g3pohPeQadnZT91N/Zm258Sh+MDw/xTEue+mWRTlH1y5Kob',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHFmkslnCYLe4iTv6u3Wu3yc9ZtQzJIykcDPlvdVhAYL0RHlQ2KYTNsOf9VKuLmLfEblvGPkUYoanCvRJZ10+40yM5uuzjxveZbXTKZbvoRMJywOPhmPu8r1zjp8k43m3WFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzwuq2+Lc2mhFPpOGaSteyD+OWyM0/2CKi+E1Y5voUh67H6y2vkhteYvQflufuZPwN2wNWG04oEAbfdEIeiY7XaEqdgFsu8gtBM5bXB/8ERBN82X+nJ/QgjsydKu53+FVCBb8hNZgkfsx2MWiY5xSjJYY+pYW+Mn03zt9ZGr/Kb4S',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHMEg4XGU+B/VseBp8GkgFjnSM37DwJF7A+i448DRzspsU1/AqoHE6SPa4SKrZ6meADAiP446MDb69PwCQfnWC0RnwTVvhMzzAhIzv6xSgejyk2hPOW8VyCck5eOWd1VzMFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzyKQm4781J2cC2gSMMAJisD0CNzAnSyk6W0ZtDovzedeJPqZp2kaZ/tATNryykHgeva4ncfzZ35KEUXoeJ2UqRkCSblyHWuwSwllY4vl5+OjkxfZEw6e1O4dIFjGphYw1yXfyC5T2Lm9tmD2c3CcYWrgWAw5VmBVIjxzbQagBXuJ',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHFpH2XqqKQN13ZlAqCJJ0MI5xIrMNGnxEQbwZpLDep2gjcxYaPHUHkfL+l6bhUWMvBU08lv7FxNNf9J6cRW7E7CS+Bp+VyDo/cXZXWjApI9lY4bfr2CuQdQ5AAlY1cJiOFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzwSHSS+aYGkrX86mk9Ygpfwid/dajVU6aKQhG9cIuqT9Gm0AZJaXuI1AdIu5OjsFPGfDku0wCvlODIEGg+D7plEq2vTbIydeW3znUd/8fhrRKTk8TbdXEAmpQp8oF25lABXzDZBlaJr3fey3QeenyPaqI3/iMmsT3ntVkM0qFplL',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHFUfkBKT5xYaYbc9M3ZlhYFkmsQAogBil/kNebU4G8nUoXED72e2Y3TJ7QEuH9w4eZzugpUcwFnNw8kTkzfo3Iybu6AhuKGtU9TqsDlIm+gSEZ4nScHd+kEg3/NH1HJu7FTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzwpBft1rPU4MPGHaqrSYP8S/b/LUGeeN1zuSFpdrZgpuAzS/YZFcROQwbnZ0+RbufbdNOxlco/dMdbZcuH2/vI4lu6/RHfTrUCEuRaiw/7pHKqxcy7IbiKwIjotLre/NvAHZenn3QCNPaL/dswkZmXwQjkUMPeAp8mVdwbZBzdqR',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHIhaZZxIPqMcXO6viyc76x+AwtDXOA2jSQrVvxFMIWk4akj0BEFJCzY03v1pHT+L1z5/+4PeTorgVY+4omJu7fyOsZXXfsUh8VDel9oahIVdcbm1T4ZeISyVX5ogCPHtDFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzymZfU4i9STvaeZPqaQAFZDyDq4rQeDjRSs5kfH/c9VCGgWpPzF/YOpwOY4UL6bTiNH6prv65BR+z7rIcX4qlYUs8HNmLJU2579oxmi/0U0aweuuSUPVvunMXtbsWghHYwyE7FAl4lzN61xAuJQ9TFiwk+fBi8+m8KpJ5aDcASwP',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHHoyvNe/1Fs8Zdib7yGmMDOsMl3PNHLgux04iEuGqFooA8ZuRQTO+d8idJK4PU4iEK3yDZ8tkIvn3xy9e5E1woxFf3Q4Hnf4QrzrKwqxU/sKQE5BDR477ZZ5HXnVw5GCEFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzx68PIygpUsSXctOlYYUlXJoYpijc6xcLrX+okjWqliPBMaSDNiWPFovb4nqqqcjECU87PBHKRbQUVtwHfFayewJzK0fXcbLnOLkI0000iT0/aQF1886Yu0tn38MDGj9mA3XGuW8GchscFXwJD0A9rsGfndUtnQQGn8Qvjco13KF',
+            'AAAADAAAAAAAAAAMLgMlO2dry2VsDgZ+iAQGPaw9ayDePTxwolgK6PxgKqcV6u69eorpuU2J/u5ddlemZK57r8yG6H72NDi+oxubBA7p19beT8vrmgqJMuARFMaWgGqk7SDIj1MnWbTGZfsHBqIMcBxedif92VYgkKqwuF6DgAsEGqRl75TxjsYBMV4l+nwCfEcJuY4b5CbKQKqbKpgP+yi1k/0VzPHJ5sJSOC5yHXM+65k+iz+Di9PJlVzAOd7RmcYOvDWNLvy4sjHtFTk5Ne7OsFI1Qp+ubGJGouCEidhvl2Nou0SVXIzlljodtRpR3EAJ/E0X/enYeQ2nM9Qy+UCbjrjiir+8qxOzzy5uXWny1M3PUGeKsyDyyFQT/6t+m68iSajTuEOYIImfI033SlAMWC7dMDIOLCbPpo3fcrFPHl8MDKsX0hC7z80mI/vhdM7HNXowYbsvdGGXm8DaPIajX/DzvDFW3Phr9xlluezUgueBRt1186FMHYN702LPkZew4HSziaQoCOVO'
         ];
     }
 
@@ -29,15 +29,18 @@ class InitLedgerWorkload extends WorkloadModuleBase {
         this.spkStr = roundArguments.spkStr;
         this.rootStr = roundArguments.rootStr;
         this.gvkStr = roundArguments.gvkStr;
+        this.lpkStr = roundArguments.lpkStr;
+        // InitLedger needs the s3cross.admin attribute
+        this.invokerIdentity = roundArguments.invokerIdentity || 'Admin';
     }
 
     async submitTransaction() {
-        const args = [this.ipkStr, this.spkStr, this.rootStr, this.gvkStr];
+        const args = [this.ipkStr, this.spkStr, this.rootStr, this.gvkStr, this.lpkStr];
 
         const request = {
             contractId: 's3crosszk',
             contractFunction: 'InitLedger',
-            invokerIdentity: 'User1',
+            invokerIdentity: this.invokerIdentity,
             contractArguments: args,
             readOnly: false,
             channel: 'mychannel',
@@ -18,22 +18,23 @@ const { WorkloadModuleBase } = require('@hyperledger/caliper-core');
 
 /**
  * Caliper workload module for calling QueryPseudonymByPBK function.
+ * Obsolete: the pseudonyms below are those of the proofs of createPseudonym.js, which are no longer stored.
  */
 class QueryPseudonymByPBKWorkload extends WorkloadModuleBase {
 
     constructor() {
         super();
         this.pbkStrGroup = [
-            'LydWx4R4SR7Hf5yMostiAhg9KCj0HAUiTJAfLWu06II=',
-            '2dsU69aPLwBneEhPD2Qh9pTGOiVGfdkz/eDgA2fG+RQ=',
-            '48WjILV3yjw+KqC0S0oaezzPJ4AoLuFwLkP3zHaX45I=',
-            '0eqt33pKZTCDB0OATDWnPTA4nO0iXSKUO2eNd3WmSxw=',
-            'APU25DMDySEmtSrRXBg4qQGK12Dld5dYrw/T3C9uRAk=',
-            '0IxPWa/OsG8UJ9Ci4T95mERTzXzOn9lqxLKWqcE1dAI=',
-            'Bb74JzzXxzUs5NhVYUuJMvmSQziANp96MXCltM4tJJA=',
-            '5RYBF3Bjuy3N0HhwCl3GvXNL99tV9S0sZXouLGVQJh4=',
-            'xAngjTUBSzlIDJbtSLryP1E+p3XRMQR7M+l06VDLrJ4=',
-            'NToKrDc8XQJbh0BVZe0IXp0O4ylQnNYEmyhJGDc6byM='
+            '2AJbdkwWrTzgMbgz1gYGRRmdmkMzSh4TOTccyqC2Ey4=',
+            'YDykl973J59InftZonhdZP9/HukwOHtPaQPEjxSybZs=',
+            'LgJAmbSQRi4ISkqoGS4thUjdlgSzFWxlhpyJlpawRhg=',
+            '07hP11kSvcKpoRhFPsZbbsS3mIurVP3nsM2EKTZUHhE=',
+            '0YJ1fpAAP72+DYyO448IDIBn6tmqSLj2SDpxoCrw15Q=',
+            '7MRuRZye9F/TxMX+ljxNBS9jUeGml/7ykQd1PBoWcyM=',
+            'Izf6zeRE8nBzFjBHpaA7Zx4O94dLQHsy3Zjt2ftAXCg=',
+            'f7ubmCjuYxW4opP34P6fz/XiT0davzeNzUJSEAE9kpo=',
+            'o3BN5F4vx/f5ImTLZ4N8K4SIUw+uJJ3Id74zQZGb8YA=',
+            'OFLC5snxzBX9k7Uo+w+YKpuqQMom5BuOuQlHfAJ8+iU='
         ];
     }
 
@@ -1,7 +1,9 @@
 package chaincode
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,17 +12,40 @@ import (
 	"github.com/hyperledger/fabric-contract-api-go/v2/contractapi"
 	"math/big"
 	"strconv"
-	"time"
 )
 
 type SmartContract struct {
 	contractapi.Contract
 }
 
+const (
+	// DefaultBits each member gets 2^bits - 1 pseudonyms per nonce epoch
+	DefaultBits = 4
+	// EpochSeconds minimum lifetime of a nonce epoch (same as the pseudonym expiry)
+	EpochSeconds = 7200
+	// EpochAdminAttribute certificate attribute ("true") of the AdvanceEpoch callers
+	EpochAdminAttribute = "s3cross.epochAdmin"
+
+	// legacyNonceStr the static nonce used before nonce epochs were introduced,
+	// epoch 0 keeps it so that the proofs generated for it stay valid
+	legacyNonceStr = "17077557196202813204801775360160812872901728681867794927808072673056060376603"
+)
+
+// NonceEpoch the nonce of one epoch, stored under NONCE_<epoch>
+type NonceEpoch struct {
+	Epoch     uint64 `json:"epoch"`
+	Nonce     string `json:"nonce"` // decimal string
+	Bits      int    `json:"bits"`
+	Seed      string `json:"seed,omitempty"` // id of the AdvanceEpoch transaction, "" for epoch 0
+	TimeStamp int64  `json:"timestamp"`
+}
+
 type Pseudonym struct {
 	PublicKey string `json:"publickey"`
 	TimeStamp int64  `json:"timestamp"`
 	Used      bool   `json:"used"`
+	Epoch     uint64 `json:"epoch"`
+	Scope     string `json:"scope"`
 
 	// ElGamal Encryption
 	C1 string `json:"c1"`
@@ -60,7 +85,18 @@ func (s *SmartContract) InitLedger(
 	if err != nil {
 		return fmt.Errorf("failed to store gpStr. %v", err)
 	}
-	return nil
+
+	// epoch 0
+	ts, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	return putNonceEpoch(ctx, &NonceEpoch{
+		Epoch:     0,
+		Nonce:     legacyNonceStr,
+		Bits:      DefaultBits,
+		TimeStamp: ts,
+	})
 }
 
 // UpdatePP update the PP
@@ -103,15 +139,92 @@ func (s *SmartContract) UpdateGP(
 	return nil
 }
 
+// AdvanceEpoch start a new nonce epoch, only for the callers with the EpochAdminAttribute
+// The nonce is chained from the nonce of the current epoch, the new epoch number and the transaction id,
+// so every endorser derives the same value and the caller can't pick it with the transaction timestamp.
+// An epoch lasts at least EpochSeconds.
+func (s *SmartContract) AdvanceEpoch(
+	ctx contractapi.TransactionContextInterface,
+) (*NonceEpoch, error) {
+	clientID := ctx.GetClientIdentity()
+	if clientID == nil {
+		return nil, fmt.Errorf("failed to get client identity")
+	}
+	if err := clientID.AssertAttributeValue(EpochAdminAttribute, "true"); err != nil {
+		return nil, fmt.Errorf("AdvanceEpoch needs the %s attribute. %v", EpochAdminAttribute, err)
+	}
+
+	cur, err := getCurrentNonceEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := txTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ts-cur.TimeStamp < EpochSeconds {
+		return nil, fmt.Errorf("epoch %d is still active", cur.Epoch)
+	}
+
+	curNonce, ok := new(big.Int).SetString(cur.Nonce, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid nonce of epoch %d", cur.Epoch)
+	}
+	seed := ctx.GetStub().GetTxID()
+	var ind [8]byte
+	h := sha256.New()
+	h.Write([]byte("S3Cross-GS-Nonce"))
+	h.Write(curNonce.Bytes())
+	binary.BigEndian.PutUint64(ind[:], cur.Epoch+1)
+	h.Write(ind[:])
+	h.Write([]byte(seed))
+	nonce := new(big.Int).SetBytes(h.Sum(nil))
+	nonce.Mod(nonce, bn254.ID.ScalarField())
+
+	ne := &NonceEpoch{
+		Epoch:     cur.Epoch + 1,
+		Nonce:     nonce.String(),
+		Bits:      cur.Bits,
+		Seed:      seed,
+		TimeStamp: ts,
+	}
+	err = putNonceEpoch(ctx, ne)
+	if err != nil {
+		return nil, err
+	}
+	return ne, nil
+}
+
+// GetCurrentNonce query the nonce and bits of the active epoch
+func (s *SmartContract) GetCurrentNonce(
+	ctx contractapi.TransactionContextInterface,
+) (*NonceEpoch, error) {
+	return getCurrentNonceEpoch(ctx)
+}
+
+// CreatePseudonym verify the s3cross proof against the nonce of the current epoch (or the previous one) and store the pseudonym
 func (s *SmartContract) CreatePseudonym(
 	ctx contractapi.TransactionContextInterface,
 	s3cProofStr string,
 ) error {
-	// use static nonce for test
-	nStr := "17077557196202813204801775360160812872901728681867794927808072673056060376603"
-	nonce, _ := new(big.Int).SetString(nStr, 10)
-	bits := 4
+	return createPseudonym(ctx, "", s3cProofStr)
+}
 
+// CreateScopedPseudonym same as CreatePseudonym for a pseudonym bound to the scope (service ID)
+func (s *SmartContract) CreateScopedPseudonym(
+	ctx contractapi.TransactionContextInterface,
+	scope, s3cProofStr string,
+) error {
+	if scope == "" {
+		return errors.New("empty scope, use CreatePseudonym instead")
+	}
+	return createPseudonym(ctx, scope, s3cProofStr)
+}
+
+func createPseudonym(
+	ctx contractapi.TransactionContextInterface,
+	scope, s3cProofStr string,
+) error {
 	// convert proof string to object
 	proof, err := base64StringToS3CrossProof(&s3cProofStr)
 	if err != nil {
@@ -134,24 +247,29 @@ func (s *SmartContract) CreatePseudonym(
 	}
 
 	// get the group signature parameters
-	gpStrJson, err := ctx.GetStub().GetState("GP")
+	groupParams, err := getGroupParams(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get gp string from world state. %v", err)
+		return err
 	}
-	var gpStr string
-	err = json.Unmarshal(gpStrJson, &gpStr)
+
+	// verify s3cross proof
+	// // current epoch first, then the previous one
+	ne, err := getCurrentNonceEpoch(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to convert gpStrJson to gpStr. %v", err)
+		return err
 	}
-	groupParams, err := base64StringToGroupParams(&gpStr)
-	if err != nil {
-		return fmt.Errorf("failed to convert gpStr string to group parameters. %v", err)
+	err = verifyWithNonceEpoch(proof, pp, groupParams, ne, scope)
+	if err != nil && ne.Epoch > 0 {
+		prev, pErr := getNonceEpoch(ctx, ne.Epoch-1)
+		if pErr != nil {
+			return pErr
+		}
+		if verifyWithNonceEpoch(proof, pp, groupParams, prev, scope) == nil {
+			ne, err = prev, nil
+		}
 	}
-
-	// verify s3cross proof
-	err = VerifyPseudonym(proof, pp, groupParams, nonce, bits)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("failed to verify s3cross proof. %v", err)
 	}
 
 	// // store the pseudonym
@@ -165,17 +283,31 @@ func (s *SmartContract) CreatePseudonym(
 	}
 	indC1 := c1.Bytes()
 	pusB64Key := base64.StdEncoding.EncodeToString(indC1[:])
+	// a proof is stored once, its resubmission would reset Used and the TimeStamp of the pseudonym
+	psuJson, err := ctx.GetStub().GetState("PSU_" + pusB64Key)
+	if err != nil {
+		return fmt.Errorf("failed to query state: %v", err)
+	}
+	if psuJson != nil {
+		return fmt.Errorf("pseudonym already exists: %s", pusB64Key)
+	}
 	indC2 := c2.Bytes()
 	b64C2Key := base64.StdEncoding.EncodeToString(indC2[:])
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
 	psu := Pseudonym{
 		PublicKey: pusB64Key,
-		TimeStamp: time.Now().Unix(),
+		TimeStamp: now,
 		Used:      false,
+		Epoch:     ne.Epoch,
+		Scope:     scope,
 		C1:        pusB64Key,
 		C2:        b64C2Key,
 	}
 
-	psuJson, err := json.Marshal(psu)
+	psuJson, err = json.Marshal(psu)
 	if err != nil {
 		return fmt.Errorf("failed to marshal psu. %v", err)
 	}
@@ -186,6 +318,60 @@ func (s *SmartContract) CreatePseudonym(
 	return nil
 }
 
+// UsePseudonym exercise a registered pseudonym
+// pbk: the pseudonym public key string
+// action: the action signed by the pseudonym
+// sigStr: base64 string of the pseudonym signature on action
+func (s *SmartContract) UsePseudonym(
+	ctx contractapi.TransactionContextInterface,
+	pbk, action, sigStr string,
+) error {
+	valid, err := s.IsPseudonymValid(ctx, pbk)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("pseudonym is used or expired: %s", pbk)
+	}
+
+	pkBytes, err := base64.StdEncoding.DecodeString(pbk)
+	if err != nil {
+		return fmt.Errorf("failed to decode pseudonym public key. %v", err)
+	}
+	pk := new(bn254.G1Affine)
+	_, err = pk.SetBytes(pkBytes)
+	if err != nil {
+		return fmt.Errorf("failed to convert pseudonym public key. %v", err)
+	}
+	sig, err := base64StringToPsuSignature(&sigStr)
+	if err != nil {
+		return fmt.Errorf("failed to convert signature string to object. %v", err)
+	}
+	groupParams, err := getGroupParams(ctx)
+	if err != nil {
+		return err
+	}
+	err = groupParams.VerifyAsPseudonym(pk, []byte(action), sig)
+	if err != nil {
+		return fmt.Errorf("failed to verify pseudonym signature. %v", err)
+	}
+
+	psu, err := s.QueryPseudonymByPBK(ctx, pbk)
+	if err != nil {
+		return err
+	}
+	psu.Used = true
+	psuJson, err := json.Marshal(psu)
+	if err != nil {
+		return fmt.Errorf("failed to marshal psu. %v", err)
+	}
+	err = ctx.GetStub().PutState("PSU_"+pbk, psuJson)
+	if err != nil {
+		return fmt.Errorf("failed to store pseudonym. %v", err)
+	}
+	return nil
+}
+
 // GetAllPseudonymsPaged query given number of Pseudonyms
 // pageSizeStr: pseudonym number
 // bookmark: First time set as "", next use the "Bookmark" context from the last query
@@ -273,7 +459,10 @@ func (s *SmartContract) IsPseudonymValid(
 	if err := json.Unmarshal(data, &psu); err != nil {
 		return false, fmt.Errorf("failed to parse psu data: %v", err)
 	}
-	now := time.Now().Unix()
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return false, err
+	}
 	const ExpirySeconds = 7200
 	if psu.Used == false && now-psu.TimeStamp < ExpirySeconds {
 		return true, nil // 有效
@@ -281,6 +470,79 @@ func (s *SmartContract) IsPseudonymValid(
 	return false, nil // 已失效
 }
 
+// ===== Nonce Epochs =====
+
+func verifyWithNonceEpoch(proof *S3CProof, pp *PedersenParams, gp *Params, ne *NonceEpoch, scope string) error {
+	nonce, ok := new(big.Int).SetString(ne.Nonce, 10)
+	if !ok {
+		return fmt.Errorf("invalid nonce of epoch %d", ne.Epoch)
+	}
+	return VerifyPseudonym(proof, pp, gp, nonce, scope, ne.Bits)
+}
+
+func nonceEpochKey(epoch uint64) string {
+	return "NONCE_" + strconv.FormatUint(epoch, 10)
+}
+
+func getNonceEpoch(ctx contractapi.TransactionContextInterface, epoch uint64) (*NonceEpoch, error) {
+	data, err := ctx.GetStub().GetState(nonceEpochKey(epoch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of epoch %d from world state. %v", epoch, err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("no nonce found for epoch %d", epoch)
+	}
+	var ne NonceEpoch
+	if err := json.Unmarshal(data, &ne); err != nil {
+		return nil, fmt.Errorf("failed to parse nonce epoch data: %v", err)
+	}
+	return &ne, nil
+}
+
+func getCurrentNonceEpoch(ctx contractapi.TransactionContextInterface) (*NonceEpoch, error) {
+	data, err := ctx.GetStub().GetState("EPOCH")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epoch from world state. %v", err)
+	}
+	if data == nil {
+		return nil, errors.New("no epoch found, the ledger is not initialized")
+	}
+	var epoch uint64
+	if err := json.Unmarshal(data, &epoch); err != nil {
+		return nil, fmt.Errorf("failed to parse epoch: %v", err)
+	}
+	return getNonceEpoch(ctx, epoch)
+}
+
+func putNonceEpoch(ctx contractapi.TransactionContextInterface, ne *NonceEpoch) error {
+	neJson, err := json.Marshal(ne)
+	if err != nil {
+		return fmt.Errorf("failed to marshal nonce epoch. %v", err)
+	}
+	err = ctx.GetStub().PutState(nonceEpochKey(ne.Epoch), neJson)
+	if err != nil {
+		return fmt.Errorf("failed to store nonce epoch. %v", err)
+	}
+	epochJson, err := json.Marshal(ne.Epoch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal epoch. %v", err)
+	}
+	err = ctx.GetStub().PutState("EPOCH", epochJson)
+	if err != nil {
+		return fmt.Errorf("failed to store epoch. %v", err)
+	}
+	return nil
+}
+
+// txTimestamp the transaction timestamp (seconds), identical on every endorser
+func txTimestamp(ctx contractapi.TransactionContextInterface) (int64, error) {
+	ts, err := ctx.GetStub().GetTxTimestamp()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get transaction timestamp. %v", err)
+	}
+	return ts.GetSeconds(), nil
+}
+
 // ===== Tool Structs =====
 
 type StaticParams struct {
@@ -304,10 +566,12 @@ type StaticGP struct {
 	W  []byte `json:"w"`
 	H_ []byte `json:"h"`
 	H0 []byte `json:"h0"`
+	H1 []byte `json:"h1"`
 }
 
 type S3CProofJson struct {
 	BorromeanProofJson
+	Quota BorromeanProofJson `json:"quota"`
 	GroupSignatureJson
 	PsuProofJson
 }
@@ -333,17 +597,45 @@ type GroupSignatureJson struct {
 	SR2  []byte `json:"sR2"`
 	SR3  []byte `json:"sR3"`
 	SS   string `json:"sS"` // not loose of sign
+	Cq   []byte `json:"Cq"`
+	SQ   []byte `json:"sQ"`
+	SRQ  []byte `json:"sRQ"`
 }
 
 type PsuProofJson struct {
-	CP  []byte `json:"cp"`
-	SYP []byte `json:"sYP"`
-	SVP []byte `json:"sVP"`
-	SRP []byte `json:"sRP"`
-	SPP []byte `json:"sPP"`
+	CP   []byte `json:"cp"`
+	SYP  []byte `json:"sYP"`
+	SVP  []byte `json:"sVP"`
+	SRP  []byte `json:"sRP"`
+	SPP  []byte `json:"sPP"`
+	SQP  []byte `json:"sQP"`
+	SSP  []byte `json:"sSP"`
+	SRQP []byte `json:"sRQP"`
+}
+
+type PsuSignatureJson struct {
+	C []byte `json:"c"`
+	S []byte `json:"s"`
 }
 
 // ===== Tool Functions =====
+func getGroupParams(ctx contractapi.TransactionContextInterface) (*Params, error) {
+	gpStrJson, err := ctx.GetStub().GetState("GP")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gp string from world state. %v", err)
+	}
+	var gpStr string
+	err = json.Unmarshal(gpStrJson, &gpStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert gpStrJson to gpStr. %v", err)
+	}
+	groupParams, err := base64StringToGroupParams(&gpStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert gpStr string to group parameters. %v", err)
+	}
+	return groupParams, nil
+}
+
 func base64StringToPedersenParams(ppStr *string) (*PedersenParams, error) {
 	ppJson, _ := base64.StdEncoding.DecodeString(*ppStr)
 	var tpp StaticPP
@@ -357,7 +649,10 @@ func base64StringToPedersenParams(ppStr *string) (*PedersenParams, error) {
 		return nil, err
 	}
 	pp.H = new(bn254.G1Affine)
-	_, _ = pp.G.SetBytes(tpp.G)
+	_, err = pp.H.SetBytes(tpp.H)
+	if err != nil {
+		return nil, err
+	}
 	pp.Mod = new(big.Int).SetBytes(tpp.Mod)
 
 	return &pp, nil
@@ -376,6 +671,7 @@ func base64StringToGroupParams(gpStr *string) (*Params, error) {
 	gp.w = new(bn254.G2Affine)
 	gp.h = new(bn254.G1Affine)
 	gp.h0 = new(bn254.G1Affine)
+	gp.h1 = new(bn254.G1Affine)
 	_, err := gp.g1.SetBytes(tgp.G1)
 	if err != nil {
 		return nil, err
@@ -385,6 +681,10 @@ func base64StringToGroupParams(gpStr *string) (*Params, error) {
 	_, _ = gp.w.SetBytes(tgp.W)
 	_, _ = gp.h.SetBytes(tgp.H_)
 	_, _ = gp.h0.SetBytes(tgp.H0)
+	_, err = gp.h1.SetBytes(tgp.H1)
+	if err != nil {
+		return nil, err
+	}
 
 	return &gp, nil
 }
@@ -397,28 +697,16 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	}
 	var s3p S3CProof
 	// BorromeanProof
-	var bp BorromeanProof
-	bp.C = new(bn254.G1Affine)
-	_, err := bp.C.SetBytes(s3pJ.C)
+	bp, err := jsonToBorromeanProof(&s3pJ.BorromeanProofJson)
 	if err != nil {
 		return nil, err
 	}
-
-	bp.e0 = new(big.Int).SetBytes(s3pJ.E0)
-
-	C_ := make([]*bn254.G1Affine, len(s3pJ.CInt))
-	for i, v := range s3pJ.C_ {
-		C_[i] = new(bn254.G1Affine)
-		_, _ = C_[i].SetBytes(v)
-	}
-	bp.C_ = C_
-
-	s := make([]*big.Int, len(s3pJ.S))
-	for i, v := range s3pJ.S {
-		s[i] = new(big.Int).SetBytes(v)
+	s3p.BorromeanProof = bp
+	qp, err := jsonToBorromeanProof(&s3pJ.Quota)
+	if err != nil {
+		return nil, err
 	}
-	bp.s = s
-	s3p.BorromeanProof = &bp
+	s3p.Quota = qp
 
 	// GroupSignature
 	var gs GroupSignature
@@ -428,12 +716,17 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	gs.A1 = new(bn254.G1Affine)
 	gs.A_ = new(bn254.G1Affine)
 	gs.d = new(bn254.G1Affine)
+	gs.Cq = new(bn254.G1Affine)
 	_, _ = gs.M.SetBytes(s3pJ.M)
 	_, _ = gs.C1.SetBytes(s3pJ.C1)
 	_, _ = gs.C2.SetBytes(s3pJ.C2)
 	_, _ = gs.A1.SetBytes(s3pJ.A1)
 	_, _ = gs.A_.SetBytes(s3pJ.A_)
 	_, _ = gs.d.SetBytes(s3pJ.D)
+	_, err = gs.Cq.SetBytes(s3pJ.Cq)
+	if err != nil {
+		return nil, err
+	}
 	gs.c = new(big.Int).SetBytes(s3pJ.CInt)
 	gs.sX = new(big.Int).SetBytes(s3pJ.SX)
 	gs.sY = new(big.Int).SetBytes(s3pJ.SY)
@@ -441,6 +734,8 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	gs.sR2 = new(big.Int).SetBytes(s3pJ.SR2)
 	gs.sR3 = new(big.Int).SetBytes(s3pJ.SR3)
 	gs.sS, _ = new(big.Int).SetString(s3pJ.SS, 10)
+	gs.sQ = new(big.Int).SetBytes(s3pJ.SQ)
+	gs.sRQ = new(big.Int).SetBytes(s3pJ.SRQ)
 	s3p.GroupSignature = &gs
 
 	// PsuProof
@@ -450,7 +745,51 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	psuP.sVP = new(big.Int).SetBytes(s3pJ.SVP)
 	psuP.sRP = new(big.Int).SetBytes(s3pJ.SRP)
 	psuP.sPP = new(big.Int).SetBytes(s3pJ.SPP)
+	psuP.sQP = new(big.Int).SetBytes(s3pJ.SQP)
+	psuP.sSP = new(big.Int).SetBytes(s3pJ.SSP)
+	psuP.sRQP = new(big.Int).SetBytes(s3pJ.SRQP)
 	s3p.PsuProof = &psuP
 
 	return &s3p, nil
 }
+
+func jsonToBorromeanProof(bpJ *BorromeanProofJson) (*BorromeanProof, error) {
+	var bp BorromeanProof
+	bp.C = new(bn254.G1Affine)
+	_, err := bp.C.SetBytes(bpJ.C)
+	if err != nil {
+		return nil, err
+	}
+
+	bp.e0 = new(big.Int).SetBytes(bpJ.E0)
+
+	C_ := make([]*bn254.G1Affine, len(bpJ.C_))
+	for i, v := range bpJ.C_ {
+		C_[i] = new(bn254.G1Affine)
+		_, _ = C_[i].SetBytes(v)
+	}
+	bp.C_ = C_
+
+	s := make([]*big.Int, len(bpJ.S))
+	for i, v := range bpJ.S {
+		s[i] = new(big.Int).SetBytes(v)
+	}
+	bp.s = s
+
+	return &bp, nil
+}
+
+func base64StringToPsuSignature(sigStr *string) (*PsuSignature, error) {
+	sigJson, err := base64.StdEncoding.DecodeString(*sigStr)
+	if err != nil {
+		return nil, err
+	}
+	var sigJ PsuSignatureJson
+	if err := json.Unmarshal(sigJson, &sigJ); err != nil {
+		return nil, errors.New("PsuSignature json.Unmarshal failed: " + err.Error())
+	}
+	return &PsuSignature{
+		c: new(big.Int).SetBytes(sigJ.C),
+		s: new(big.Int).SetBytes(sigJ.S),
+	}, nil
+}
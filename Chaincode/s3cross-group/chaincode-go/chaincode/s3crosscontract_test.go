@@ -0,0 +1,258 @@
+package chaincode
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"s3cross-ring/chaincode-go/chaincode/mocks"
+)
+
+// testLedger a world state kept in memory behind the counterfeiter stub
+type testLedger struct {
+	state map[string][]byte
+	stub  *mocks.FakeChaincodeStubInterface
+	ctx   *mocks.FakeTransactionContextInterface
+}
+
+func newTestLedger() *testLedger {
+	l := &testLedger{
+		state: make(map[string][]byte),
+		stub:  &mocks.FakeChaincodeStubInterface{},
+		ctx:   &mocks.FakeTransactionContextInterface{},
+	}
+	l.stub.GetStateCalls(func(key string) ([]byte, error) {
+		return l.state[key], nil
+	})
+	l.stub.PutStateCalls(func(key string, value []byte) error {
+		l.state[key] = value
+		return nil
+	})
+	l.ctx.GetStubReturns(l.stub)
+	l.ctx.GetClientIdentityReturns(&testIdentity{})
+	l.at(1700000000, "tx0")
+	return l
+}
+
+// at the timestamp and id of the next transaction
+func (l *testLedger) at(seconds int64, txID string) {
+	l.stub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: seconds}, nil)
+	l.stub.GetTxIDReturns(txID)
+}
+
+// as the client of the next transaction, with the certificate attributes
+func (l *testLedger) as(attrs map[string]string) {
+	l.ctx.GetClientIdentityReturns(&testIdentity{attrs: attrs})
+}
+
+// testIdentity a client identity with its certificate attributes
+type testIdentity struct {
+	attrs map[string]string
+}
+
+func (id *testIdentity) GetID() (string, error)    { return "client", nil }
+func (id *testIdentity) GetMSPID() (string, error) { return "Org1MSP", nil }
+
+func (id *testIdentity) GetAttributeValue(attrName string) (string, bool, error) {
+	v, ok := id.attrs[attrName]
+	return v, ok, nil
+}
+
+func (id *testIdentity) AssertAttributeValue(attrName, attrValue string) error {
+	if v, ok := id.attrs[attrName]; !ok || v != attrValue {
+		return fmt.Errorf("attribute %s is not %s", attrName, attrValue)
+	}
+	return nil
+}
+
+func (id *testIdentity) GetX509Certificate() (*x509.Certificate, error) { return nil, nil }
+
+var epochAdmin = map[string]string{EpochAdminAttribute: "true"}
+
+func TestAdvanceEpoch(t *testing.T) {
+	l := newTestLedger()
+	psuManager := SmartContract{}
+	require.NoError(t, psuManager.InitLedger(l.ctx, "", ""))
+
+	// not an epoch admin
+	l.at(1700000000+EpochSeconds, "tx1")
+	_, err := psuManager.AdvanceEpoch(l.ctx)
+	require.Error(t, err)
+
+	// epoch 0 is still active
+	l.as(epochAdmin)
+	l.at(1700000000+EpochSeconds-1, "tx1")
+	_, err = psuManager.AdvanceEpoch(l.ctx)
+	require.Error(t, err)
+
+	l.at(1700000000+EpochSeconds, "tx1")
+	ne, err := psuManager.AdvanceEpoch(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), ne.Epoch)
+	require.Equal(t, "tx1", ne.Seed)
+	require.NotEqual(t, legacyNonceStr, ne.Nonce)
+	cur, err := psuManager.GetCurrentNonce(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, ne, cur)
+
+	// the nonce follows the transaction id, not the timestamp chosen by the caller
+	next := func(ts int64, txID string) *NonceEpoch {
+		l := newTestLedger()
+		require.NoError(t, psuManager.InitLedger(l.ctx, "", ""))
+		l.as(epochAdmin)
+		l.at(ts, txID)
+		ne, err := psuManager.AdvanceEpoch(l.ctx)
+		require.NoError(t, err)
+		return ne
+	}
+	require.Equal(t, next(1700000000+EpochSeconds, "tx1").Nonce, next(1700000000+2*EpochSeconds, "tx1").Nonce)
+	require.NotEqual(t, next(1700000000+EpochSeconds, "tx1").Nonce, next(1700000000+EpochSeconds, "tx2").Nonce)
+}
+
+// testGroup the parameters of a group with one member, as base64 strings for InitLedger
+type testGroup struct {
+	pp     *PedersenParams
+	bbsSE  *BbsSE
+	member *S3Cross
+	ppStr  string
+	gpStr  string
+}
+
+func newTestGroup(t *testing.T) *testGroup {
+	mod := bn254.ID.ScalarField()
+	gamma, err := rand.Int(rand.Reader, mod)
+	require.NoError(t, err)
+	sk, err := rand.Int(rand.Reader, mod)
+	require.NoError(t, err)
+	bbsSE, err := InitBbsSE(gamma, sk)
+	require.NoError(t, err)
+	pp := GenPedersenParams()
+
+	y, err := rand.Int(rand.Reader, mod)
+	require.NoError(t, err)
+	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
+	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(1<<DefaultBits), DefaultBits)
+	require.NoError(t, err)
+	user.y = y
+
+	indG, indH := pp.G.Bytes(), pp.H.Bytes()
+	ppJson, err := json.Marshal(StaticPP{G: indG[:], H: indH[:], Mod: pp.Mod.Bytes()})
+	require.NoError(t, err)
+	gp := bbsSE.Params
+	indg1, indg2, indpk, indw := gp.g1.Bytes(), gp.g2.Bytes(), gp.pk.Bytes(), gp.w.Bytes()
+	indh, indh0, indh1 := gp.h.Bytes(), gp.h0.Bytes(), gp.h1.Bytes()
+	gpJson, err := json.Marshal(StaticGP{
+		G1: indg1[:], G2: indg2[:], PK: indpk[:], W: indw[:],
+		H_: indh[:], H0: indh0[:], H1: indh1[:],
+	})
+	require.NoError(t, err)
+	return &testGroup{
+		pp:     pp,
+		bbsSE:  bbsSE,
+		member: &S3Cross{UserKey: user, PedersenParams: pp},
+		ppStr:  base64.StdEncoding.EncodeToString(ppJson),
+		gpStr:  base64.StdEncoding.EncodeToString(gpJson),
+	}
+}
+
+// pseudonym the pseudonym v of the member for the nonce of the epoch and its CreatePseudonym proof string
+func (g *testGroup) pseudonym(t *testing.T, ne *NonceEpoch, scope string, v int64) (*KeyPair, string) {
+	nonce, ok := new(big.Int).SetString(ne.Nonce, 10)
+	require.True(t, ok)
+	M, err := getRandomG1Affine()
+	require.NoError(t, err)
+	kp, proof, err := g.member.GenPseudonym(M, nonce, scope, big.NewInt(v), ne.Bits)
+	require.NoError(t, err)
+	return kp, s3crossProofToBase64String(t, proof)
+}
+
+func borromeanProofToJson(bp *BorromeanProof) BorromeanProofJson {
+	C_ := make([][]byte, len(bp.C_))
+	for i, v := range bp.C_ {
+		ind := v.Bytes()
+		C_[i] = ind[:]
+	}
+	S := make([][]byte, len(bp.s))
+	for i, v := range bp.s {
+		S[i] = v.Bytes()
+	}
+	indC := bp.C.Bytes()
+	return BorromeanProofJson{C: indC[:], E0: bp.e0.Bytes(), C_: C_, S: S}
+}
+
+func s3crossProofToBase64String(t *testing.T, s3p *S3CProof) string {
+	indM, indC1, indC2 := s3p.M.Bytes(), s3p.C1.Bytes(), s3p.C2.Bytes()
+	indA1, indA_, indD, indCq := s3p.A1.Bytes(), s3p.A_.Bytes(), s3p.d.Bytes(), s3p.Cq.Bytes()
+	s3pJson, err := json.Marshal(S3CProofJson{
+		BorromeanProofJson: borromeanProofToJson(s3p.BorromeanProof),
+		Quota:              borromeanProofToJson(s3p.Quota),
+		GroupSignatureJson: GroupSignatureJson{
+			M: indM[:], C1: indC1[:], C2: indC2[:], A1: indA1[:], A_: indA_[:], D: indD[:],
+			CInt: s3p.c.Bytes(),
+			SX:   s3p.sX.Bytes(), SY: s3p.sY.Bytes(),
+			SR: s3p.sR.Bytes(), SR2: s3p.sR2.Bytes(), SR3: s3p.sR3.Bytes(),
+			SS: s3p.sS.String(),
+			Cq: indCq[:], SQ: s3p.sQ.Bytes(), SRQ: s3p.sRQ.Bytes(),
+		},
+		PsuProofJson: PsuProofJson{
+			CP: s3p.cp.Bytes(), SYP: s3p.sYP.Bytes(), SVP: s3p.sVP.Bytes(), SRP: s3p.sRP.Bytes(),
+			SPP: s3p.sPP.Bytes(), SQP: s3p.sQP.Bytes(), SSP: s3p.sSP.Bytes(), SRQP: s3p.sRQP.Bytes(),
+		},
+	})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(s3pJson)
+}
+
+func psuSignatureToBase64String(t *testing.T, sig *PsuSignature) string {
+	sigJson, err := json.Marshal(PsuSignatureJson{C: sig.c.Bytes(), S: sig.s.Bytes()})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sigJson)
+}
+
+func TestCreatePseudonymOnce(t *testing.T) {
+	l := newTestLedger()
+	g := newTestGroup(t)
+	psuManager := SmartContract{}
+	require.NoError(t, psuManager.InitLedger(l.ctx, g.ppStr, g.gpStr))
+	ne, err := psuManager.GetCurrentNonce(l.ctx)
+	require.NoError(t, err)
+
+	kp, proofStr := g.pseudonym(t, ne, "", 3)
+	require.NoError(t, psuManager.CreatePseudonym(l.ctx, proofStr))
+	indPK := kp.pk.Bytes()
+	pbk := base64.StdEncoding.EncodeToString(indPK[:])
+	psu, err := psuManager.QueryPseudonymByPBK(l.ctx, pbk)
+	require.NoError(t, err)
+	require.False(t, psu.Used)
+	// the pseudonym expires 7200s after the timestamp of its transaction
+	require.Equal(t, int64(1700000000), psu.TimeStamp)
+	l.at(1700007199, "tx1")
+	valid, err := psuManager.IsPseudonymValid(l.ctx, pbk)
+	require.NoError(t, err)
+	require.True(t, valid)
+	l.at(1700007200, "tx2")
+	valid, err = psuManager.IsPseudonymValid(l.ctx, pbk)
+	require.NoError(t, err)
+	require.False(t, valid)
+	l.at(1700000000, "tx3")
+
+	sig, err := g.member.SignAsPseudonym(kp, []byte("login"))
+	require.NoError(t, err)
+	sigStr := psuSignatureToBase64String(t, sig)
+	require.NoError(t, psuManager.UsePseudonym(l.ctx, pbk, "login", sigStr))
+	require.Error(t, psuManager.UsePseudonym(l.ctx, pbk, "login", sigStr))
+
+	// the resubmitted proof does not reset the used pseudonym
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, proofStr))
+	psu, err = psuManager.QueryPseudonymByPBK(l.ctx, pbk)
+	require.NoError(t, err)
+	require.True(t, psu.Used)
+}
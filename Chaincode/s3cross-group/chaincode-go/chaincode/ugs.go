@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -23,27 +24,30 @@ type Params struct {
 	w  *bn254.G2Affine
 
 	h, h0 *bn254.G1Affine
+	h1    *bn254.G1Affine // for the pseudonym quota
 }
 
 type UserKey struct {
 	x, y *big.Int
+	q    *big.Int // pseudonym quota certified by the issuer
 	A    *bn254.G1Affine
 
 	*Params
 }
 
 type RevokedKey struct {
-	xi     *big.Int
-	Ai, hi *bn254.G1Affine
-	Ai_    *bn254.G2Affine
+	xi          *big.Int
+	Ai, hi, h1i *bn254.G1Affine
+	Ai_         *bn254.G2Affine
 }
 
 type GroupSignature struct {
 	M         *bn254.G1Affine // Message (can be omitted)
 	C1, C2    *bn254.G1Affine // ElGamal ciphertext
 	A1, A_, d *bn254.G1Affine // SoK
+	Cq        *bn254.G1Affine // commitment of the quota q·h1 + rq·h0
 
-	c, sX, sY, sR, sR2, sR3, sS *big.Int
+	c, sX, sY, sR, sR2, sR3, sS, sQ, sRQ *big.Int
 }
 
 func InitBbsSE(gamma, sk *big.Int) (*BbsSE, error) {
@@ -55,6 +59,7 @@ func InitBbsSE(gamma, sk *big.Int) (*BbsSE, error) {
 		return nil, errors.New("getRandomG1Affine failed: " + err.Error())
 	}
 	h0, _ := getRandomG1Affine()
+	h1, _ := getRandomG1Affine()
 	pk := new(bn254.G1Affine).ScalarMultiplication(h, sk)
 
 	bbsSE := &BbsSE{
@@ -73,26 +78,41 @@ func InitBbsSE(gamma, sk *big.Int) (*BbsSE, error) {
 			w:  w,
 			h:  h,
 			h0: h0,
+			h1: h1,
 		},
 	}
 
 	return bbsSE, nil
 }
 
-func (bbsSE *BbsSE) UserKeyGen(Y0, Y *bn254.G1Affine) (*UserKey, error) {
+// UserKeyGen issue the user key
+// Y0: h0^{-y} from the user
+// quota: the number of pseudonyms per nonce the user is allowed (v < quota), signed as an extra message
+// bits: the range proof size of GenPseudonym, q-v-1 < 2^bits so the quota is in [1, 2^bits]
+func (bbsSE *BbsSE) UserKeyGen(Y0, Y *bn254.G1Affine, quota *big.Int, bits int) (*UserKey, error) {
+	if bits <= 0 {
+		return nil, errors.New("UserKeyGen: bits should be positive")
+	}
+	if quota.Sign() <= 0 || quota.Cmp(new(big.Int).Lsh(big.NewInt(1), uint(bits))) > 0 {
+		return nil, fmt.Errorf("UserKeyGen: the quota should be in [1, 2^%d]", bits)
+	}
 	mod := bn254.ID.ScalarField()
 	x, err := rand.Int(rand.Reader, mod)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 
-	A := new(bn254.G1Affine).ScalarMultiplication(new(bn254.G1Affine).Add(bbsSE.g1, Y0), new(big.Int).ModInverse(new(big.Int).Add(bbsSE.gamma, x), mod))
+	// A = (g1·Y0·h1^{-q})^{1/(gamma+x)}
+	base := new(bn254.G1Affine).Add(bbsSE.g1, Y0)
+	base.Sub(base, new(bn254.G1Affine).ScalarMultiplication(bbsSE.h1, quota))
+	A := new(bn254.G1Affine).ScalarMultiplication(base, new(big.Int).ModInverse(new(big.Int).Add(bbsSE.gamma, x), mod))
 
 	// store Y for tracing
 	_ = Y
 	// lack y
 	user := &UserKey{
 		x: x,
+		q: new(big.Int).Set(quota),
 		A: A,
 		Params: &Params{
 			g1: bbsSE.g1,
@@ -101,6 +121,7 @@ func (bbsSE *BbsSE) UserKeyGen(Y0, Y *bn254.G1Affine) (*UserKey, error) {
 			w:  bbsSE.w,
 			h:  bbsSE.h,
 			h0: bbsSE.h0,
+			h1: bbsSE.h1,
 		},
 	}
 	return user, nil
@@ -110,12 +131,14 @@ func (bbsSE *BbsSE) RevokeGen(xi *big.Int) *RevokedKey {
 	mod := bn254.ID.ScalarField()
 	Ai := new(bn254.G1Affine).ScalarMultiplication(bbsSE.g1, new(big.Int).ModInverse(new(big.Int).Add(bbsSE.gamma, xi), mod))
 	hi := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).ModInverse(new(big.Int).Add(bbsSE.gamma, xi), mod))
+	h1i := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h1, new(big.Int).ModInverse(new(big.Int).Add(bbsSE.gamma, xi), mod))
 	Ai_ := new(bn254.G2Affine).ScalarMultiplication(bbsSE.g2, new(big.Int).ModInverse(new(big.Int).Add(bbsSE.gamma, xi), mod))
 
 	rk := &RevokedKey{
 		xi:  xi,
 		Ai:  Ai,
 		hi:  hi,
+		h1i: h1i,
 		Ai_: Ai_,
 	}
 
@@ -133,6 +156,7 @@ func (para *Params) UpdateParams(rk *RevokedKey) {
 	para.g1 = rk.Ai
 	para.g2 = rk.Ai_
 	para.h0 = rk.hi
+	para.h1 = rk.h1i
 	para.w = new(bn254.G2Affine).Add(para.g2, new(bn254.G2Affine).ScalarMultiplication(rk.Ai_, new(big.Int).Neg(rk.xi)))
 }
 
@@ -146,6 +170,7 @@ func (usk *UserKey) RevokeExe(rk *RevokedKey) error {
 		return errors.New("RevokedKey is invalid")
 	}
 	nA := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(rk.Ai, ind), new(bn254.G1Affine).ScalarMultiplication(rk.hi, new(big.Int).Mul(new(big.Int).Neg(usk.y), ind)))
+	nA.Sub(nA, new(bn254.G1Affine).ScalarMultiplication(rk.h1i, new(big.Int).Mul(usk.q, ind)))
 	nA.Sub(nA, new(bn254.G1Affine).ScalarMultiplication(usk.A, ind))
 	usk.A = nA
 
@@ -155,6 +180,7 @@ func (usk *UserKey) RevokeExe(rk *RevokedKey) error {
 func (usk *UserKey) UserKeyVerify() error {
 	p0Right := new(bn254.G2Affine).Add(usk.w, new(bn254.G2Affine).ScalarMultiplication(usk.g2, usk.x))
 	p1Left := new(bn254.G1Affine).Add(usk.g1, new(bn254.G1Affine).ScalarMultiplication(usk.h0, new(big.Int).Neg(usk.y)))
+	p1Left.Sub(p1Left, new(bn254.G1Affine).ScalarMultiplication(usk.h1, usk.q))
 	res0, err := bn254.Pair([]bn254.G1Affine{*usk.A}, []bn254.G2Affine{*p0Right})
 	if err != nil {
 		return err
@@ -173,6 +199,15 @@ func (usk *UserKey) UserKeyVerify() error {
 // M: the message to be signed
 // p: can be a random scalar or the pseudonym secret key
 func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, error) {
+	rq, err := rand.Int(rand.Reader, bn254.ID.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	return usk.groupSign(M, p, rq)
+}
+
+// groupSign rq: the randomness of the quota commitment Cq
+func (usk *UserKey) groupSign(M *bn254.G1Affine, p, rq *big.Int) (*GroupSignature, error) {
 	mod := bn254.ID.ScalarField()
 	r1, _ := rand.Int(rand.Reader, mod)
 	r2, _ := rand.Int(rand.Reader, mod)
@@ -185,9 +220,14 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 	C1 := new(bn254.G1Affine).ScalarMultiplication(usk.h, p)
 	C2 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(usk.h, new(big.Int).Neg(usk.y)), new(bn254.G1Affine).ScalarMultiplication(usk.pk, p))
 
+	// Quota commitment
+	Cq := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(usk.h1, usk.q), new(bn254.G1Affine).ScalarMultiplication(usk.h0, rq))
+
 	// Group Sig
 	A1 := new(bn254.G1Affine).ScalarMultiplication(usk.A, r1)
-	ind := new(bn254.G1Affine).ScalarMultiplication(new(bn254.G1Affine).Add(usk.g1, new(bn254.G1Affine).ScalarMultiplication(usk.h0, new(big.Int).Neg(usk.y))), r1)
+	ind := new(bn254.G1Affine).Add(usk.g1, new(bn254.G1Affine).ScalarMultiplication(usk.h0, new(big.Int).Neg(usk.y)))
+	ind.Sub(ind, new(bn254.G1Affine).ScalarMultiplication(usk.h1, usk.q))
+	ind.ScalarMultiplication(ind, r1)
 	A_ := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(A1, new(big.Int).Neg(usk.x)), ind)
 	d := new(bn254.G1Affine).Add(ind, new(bn254.G1Affine).ScalarMultiplication(usk.h0, new(big.Int).Neg(r2)))
 
@@ -198,13 +238,17 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 	nR2, _ := rand.Int(rand.Reader, mod)
 	nR3, _ := rand.Int(rand.Reader, mod)
 	nS, _ := rand.Int(rand.Reader, mod)
+	nQ, _ := rand.Int(rand.Reader, mod)
+	nRQ, _ := rand.Int(rand.Reader, mod)
 
 	// Equation
 	E1 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(A1, new(big.Int).Neg(nX)), new(bn254.G1Affine).ScalarMultiplication(usk.h0, nR2))
 	E2 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(d, nR3), new(bn254.G1Affine).ScalarMultiplication(usk.h0, nY))
 	E2.Add(E2, new(bn254.G1Affine).ScalarMultiplication(usk.h0, new(big.Int).Neg(nS)))
+	E2.Add(E2, new(bn254.G1Affine).ScalarMultiplication(usk.h1, nQ))
 	E3 := new(bn254.G1Affine).ScalarMultiplication(usk.h, nR)
 	E4 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(usk.h, new(big.Int).Neg(nY)), new(bn254.G1Affine).ScalarMultiplication(usk.pk, nR))
+	E5 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(usk.h1, nQ), new(bn254.G1Affine).ScalarMultiplication(usk.h0, nRQ))
 
 	//fmt.Println("E1: ", E1.String())
 	//fmt.Println("E2: ", E2.String())
@@ -221,6 +265,7 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 	h.Write(usk.w.Marshal())
 	h.Write(usk.h.Marshal())
 	h.Write(usk.h0.Marshal())
+	h.Write(usk.h1.Marshal())
 	// ElGamal
 	h.Write(C1.Marshal())
 	h.Write(C2.Marshal())
@@ -228,11 +273,13 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 	h.Write(A1.Marshal())
 	h.Write(A_.Marshal())
 	h.Write(d.Marshal())
+	h.Write(Cq.Marshal())
 	// SoK
 	h.Write(E1.Marshal())
 	h.Write(E2.Marshal())
 	h.Write(E3.Marshal())
 	h.Write(E4.Marshal())
+	h.Write(E5.Marshal())
 	c := new(big.Int).SetBytes(h.Sum(nil))
 
 	sX := new(big.Int).Add(nX, new(big.Int).Mul(c, usk.x))
@@ -241,6 +288,8 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 	sR2 := new(big.Int).Add(nR2, new(big.Int).Mul(c, r2))
 	sR3 := new(big.Int).Add(nR3, new(big.Int).Mul(c, r3))
 	sS := new(big.Int).Add(nS, new(big.Int).Mul(c, s))
+	sQ := new(big.Int).Add(nQ, new(big.Int).Mul(c, usk.q))
+	sRQ := new(big.Int).Add(nRQ, new(big.Int).Mul(c, rq))
 
 	return &GroupSignature{
 		M:   M,
@@ -249,6 +298,7 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 		A1:  A1,
 		A_:  A_,
 		d:   d,
+		Cq:  Cq,
 		c:   c,
 		sX:  sX,
 		sY:  sY,
@@ -256,6 +306,8 @@ func (usk *UserKey) GroupSign(M *bn254.G1Affine, p *big.Int) (*GroupSignature, e
 		sR2: sR2,
 		sR3: sR3,
 		sS:  sS,
+		sQ:  sQ,
+		sRQ: sRQ,
 	}, nil
 }
 
@@ -288,6 +340,7 @@ func GroupVerify(gs *GroupSignature, para *Params) error {
 
 	E2_ := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(gs.d, gs.sR3), new(bn254.G1Affine).ScalarMultiplication(para.h0, gs.sY))
 	E2_.Add(E2_, new(bn254.G1Affine).ScalarMultiplication(para.h0, new(big.Int).Neg(gs.sS)))
+	E2_.Add(E2_, new(bn254.G1Affine).ScalarMultiplication(para.h1, gs.sQ))
 	E2_.Sub(E2_, new(bn254.G1Affine).ScalarMultiplication(para.g1, gs.c))
 
 	E3_ := new(bn254.G1Affine).ScalarMultiplication(para.h, gs.sR)
@@ -296,6 +349,9 @@ func GroupVerify(gs *GroupSignature, para *Params) error {
 	E4_ := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(para.h, new(big.Int).Neg(gs.sY)), new(bn254.G1Affine).ScalarMultiplication(para.pk, gs.sR))
 	E4_.Sub(E4_, new(bn254.G1Affine).ScalarMultiplication(gs.C2, gs.c))
 
+	E5_ := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(para.h1, gs.sQ), new(bn254.G1Affine).ScalarMultiplication(para.h0, gs.sRQ))
+	E5_.Sub(E5_, new(bn254.G1Affine).ScalarMultiplication(gs.Cq, gs.c))
+
 	//fmt.Println("E1_: ", E1_.String())
 	//fmt.Println("E2_: ", E2_.String())
 	//fmt.Println("E3_: ", E3_.String())
@@ -311,6 +367,7 @@ func GroupVerify(gs *GroupSignature, para *Params) error {
 	h.Write(para.w.Marshal())
 	h.Write(para.h.Marshal())
 	h.Write(para.h0.Marshal())
+	h.Write(para.h1.Marshal())
 	// ElGamal
 	h.Write(gs.C1.Marshal())
 	h.Write(gs.C2.Marshal())
@@ -318,11 +375,13 @@ func GroupVerify(gs *GroupSignature, para *Params) error {
 	h.Write(gs.A1.Marshal())
 	h.Write(gs.A_.Marshal())
 	h.Write(gs.d.Marshal())
+	h.Write(gs.Cq.Marshal())
 	// SoK
 	h.Write(E1_.Marshal())
 	h.Write(E2_.Marshal())
 	h.Write(E3_.Marshal())
 	h.Write(E4_.Marshal())
+	h.Write(E5_.Marshal())
 	c := new(big.Int).SetBytes(h.Sum(nil))
 
 	if c.Cmp(gs.c) != 0 {
@@ -3,33 +3,89 @@ package chaincode
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark/backend/groth16"
+	groth16_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/plonk"
+	plonk_bn254 "github.com/consensys/gnark/backend/plonk/bn254"
 	"github.com/consensys/gnark/backend/witness"
-	"github.com/consensys/gnark/std/rangecheck"
 	"github.com/hyperledger/fabric-chaincode-go/v2/shim"
 	"github.com/hyperledger/fabric-contract-api-go/v2/contractapi"
+	"math"
 	"strconv"
-	"time"
+	"strings"
 
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
-	twistededwards2 "github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark/frontend"
-	twistededwards1 "github.com/consensys/gnark/std/algebra/native/twistededwards"
-	"github.com/consensys/gnark/std/hash/mimc"
+	s3cross "zkMemMerkle/S3CrossMiMC"
 )
 
 type SmartContract struct {
 	contractapi.Contract
 }
 
+// proof systems of the circuit
+const (
+	BackendGroth16 = "groth16"
+	BackendPlonk   = "plonk"
+)
+
+// hash functions of the circuit (tree, schnorr challenge and pseudonym)
+const (
+	HashMiMC      = "mimc"
+	HashPoseidon2 = "poseidon2"
+)
+
+// AdminAttribute the certificate attribute ("true") of the callers that change the verification keys
+// and the revocation parameters of the ledger
+const AdminAttribute = "s3cross.admin"
+
+// nonce epochs
+const (
+	EpochAdminAttribute = "s3cross.epochAdmin" // certificate attribute ("true") of the AdvanceEpoch callers
+	AcceptedEpochs      = 2                    // the current epoch and the previous one, proofs made before an AdvanceEpoch still land
+	EpochSeconds        = 7200                 // minimum lifetime of a nonce epoch (same as the pseudonym expiry)
+)
+
+// revocation root window, the defaults and the bounds of the RootPolicy
+const (
+	DefaultRootWindow      = 4     // the current ROOT and the 3 roots before it
+	DefaultRootGracePeriod = 600   // seconds a replaced root is still accepted
+	MaxRootWindow          = 16    // a larger window keeps the proofs of long revoked users valid
+	MaxRootGracePeriod     = 86400 // one day
+)
+
+// MaxClockSkew the max distance (seconds) of the CurrentTime of an Expiry proof to the transaction timestamp,
+// the prover sets it before proving, some time before the transaction is endorsed
+const MaxClockSkew = 300
+
+// VerifyingKeyRecord the circuit verification key stored with its proof system and the Config of its circuit,
+// the circuit of each version is s3cross.NewCircuit(Config) (PMS/zkSNARKs) and its public witness is read
+// with s3cross.PublicSchemaOf(Config): Pseudonyms = 0 is the S3CrossCircuit, k > 0 the S3CrossMultiCircuit,
+// IssuerDepth > 0 the S3CrossHiddenIssuerCircuit checked against ISSUER_ROOT instead of IPK,
+// Expiry a circuit with the credential validity window, its CurrentTime is checked against the transaction timestamp
+type VerifyingKeyRecord struct {
+	Version string         `json:"version,omitempty"` // "" is the default GVK
+	Backend string         `json:"backend"`
+	Config  s3cross.Config `json:"config"`
+	VK      string         `json:"vk"`
+}
+
+// VersionedProof the proof string of a versioned circuit, a bare base64 proof uses the default GVK
+type VersionedProof struct {
+	Version string `json:"version"`
+	Proof   string `json:"proof"`
+}
+
 type Pseudonym struct {
 	PublicKey string `json:"publickey"`
 	TimeStamp int64  `json:"timestamp"`
 	Used      bool   `json:"used"`
+	Root      string `json:"root,omitempty"` // revocation root of the proof, base64
 
 	// ElGamal Encryption
 	C1 string `json:"c1"`
@@ -43,15 +99,81 @@ type PagedPseudonymResult struct {
 	More     bool         `json:"more"`
 }
 
+// BatchResult the outcome of CreatePseudonymsBatch
+// Stored: base64 public keys of the stored pseudonyms, Rejected: the invalid entries
+type BatchResult struct {
+	Stored   []string         `json:"stored"`
+	Rejected []BatchRejection `json:"rejected"`
+}
+
+// BatchRejection index of the entry in the batch and why it was rejected
+type BatchRejection struct {
+	Index int    `json:"index"`
+	Error string `json:"error"`
+}
+
+// EpochRecord a nonce epoch, opened by AdvanceEpoch
+// the pseudonyms of a user are unlinkable across epochs and at most 2^IndexBits - 1 per epoch
+type EpochRecord struct {
+	Epoch       uint64            `json:"epoch"`
+	Nonce       string            `json:"nonce"`       // nonce point (compressed, base64), the nonce of s3cross.NewPseudonym
+	X           string            `json:"x"`           // nonce point coordinates, decimal
+	Y           string            `json:"y"`           //
+	Seed        string            `json:"seed"`        // id of the AdvanceEpoch transaction, see s3cross.EpochNonce
+	NonceHashes map[string]string `json:"nonceHashes"` // public Nonce of the proofs for each hash (base64)
+	TimeStamp   int64             `json:"timestamp"`
+}
+
+// RootRecord a revocation root and the transaction timestamp it became the ROOT at
+type RootRecord struct {
+	Root        string `json:"root"`        // base64
+	Epoch       uint64 `json:"epoch"`       // tree head epoch of UpdateRoot, 0 for the root of InitLedger
+	ActivatedAt int64  `json:"activatedAt"` // transaction timestamp of the activation
+}
+
+// RootPolicy the revocation roots of the accepted proofs: the last Window roots,
+// a replaced root until GracePeriod seconds after the root that replaced it is activated,
+// so the proofs in flight during an UpdateRoot still land
+type RootPolicy struct {
+	Window      int   `json:"window"`
+	GracePeriod int64 `json:"gracePeriod"`
+}
+
+// TraceRecord a pseudonym opened by the supervisor, the decryption proof is verified against SPK
+type TraceRecord struct {
+	PublicKey string `json:"publickey"` // pseudonym public key, base64
+	UserPK    string `json:"upk"`       // decrypted user public key, base64
+	Proof     string `json:"proof"`     // s3cross.DecryptionProof json
+	TimeStamp int64  `json:"timestamp"`
+}
+
 // InitLedger Init some public parameters
 // IPK: Issuer public key (for Schnorr signature)
 // SPK: Supervisor public key (for ElGamal encryption)
-// Root: Merkle Root
-// GVK: Circuit verification key
+// Root: Merkle Root, the initial revocation root before the signed tree heads of UpdateRoot
+// GVK: Circuit verification key (groth16, s3cross.DefaultConfig, use UpdateVerifyingKey for plonk or another config,
+// RegisterCircuitVersion for new versions)
+// LPK: Revocation log public key of the supervisor (for the Schnorr signatures of the tree heads of UpdateRoot),
+// a single key apart from SPK, which can be a threshold key whose shares do not sign
+// only for the callers with the AdminAttribute, and once: the keys and the ROOT are then changed by
+// their own transactions (UpdateVerifyingKey, UpdateRoot)
 func (s *SmartContract) InitLedger(
 	ctx contractapi.TransactionContextInterface,
-	ipkStr, spkStr, rootStr, gvkStr string,
+	ipkStr, spkStr, rootStr, gvkStr, lpkStr string,
 ) error {
+	if err := assertAttribute(ctx, AdminAttribute, "InitLedger"); err != nil {
+		return err
+	}
+	for _, key := range []string{"LPK", verifyingKeyKey("")} {
+		value, err := ctx.GetStub().GetState(key)
+		if err != nil {
+			return fmt.Errorf("failed to query state: %v", err)
+		}
+		if value != nil {
+			return fmt.Errorf("ledger already initialized")
+		}
+	}
+
 	// ipkStr
 	ipkStrJson, err := json.Marshal(ipkStr)
 	if err != nil {
@@ -72,175 +194,427 @@ func (s *SmartContract) InitLedger(
 		return fmt.Errorf("failed to store spkStr. %v", err)
 	}
 
-	// rootStr
-	rootStrJson, err := json.Marshal(rootStr)
+	// lpkStr
+	if _, err = base64StringToPoint(lpkStr); err != nil {
+		return fmt.Errorf("failed to convert lpk string to point. %v", err)
+	}
+	lpkStrJson, err := json.Marshal(lpkStr)
 	if err != nil {
-		return fmt.Errorf("failed to marshal rootStr. %v", err)
+		return fmt.Errorf("failed to marshal lpkStr. %v", err)
 	}
-	err = ctx.GetStub().PutState("ROOT", rootStrJson)
+	err = ctx.GetStub().PutState("LPK", lpkStrJson)
 	if err != nil {
-		return fmt.Errorf("failed to store rootStr. %v", err)
+		return fmt.Errorf("failed to store lpkStr. %v", err)
+	}
+
+	// rootStr
+	if err = activateRoot(ctx, rootStr, 0); err != nil {
+		return err
 	}
 
-	gvkStringJson, err := json.Marshal(gvkStr)
+	return putVerifyingKey(ctx, &VerifyingKeyRecord{Backend: BackendGroth16, Config: s3cross.DefaultConfig, VK: gvkStr})
+}
+
+// UpdateIssuerRoot set the root of the issuer set (s3cross.IssuerSet), the proofs of the hidden issuer
+// circuit versions show their issuer is one of the set instead of revealing IPK,
+// only for the callers with the AdminAttribute
+func (s *SmartContract) UpdateIssuerRoot(
+	ctx contractapi.TransactionContextInterface,
+	issuerRootStr string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "UpdateIssuerRoot"); err != nil {
+		return err
+	}
+	root, err := base64.StdEncoding.DecodeString(issuerRootStr)
+	if err != nil || len(root) != 32 {
+		return fmt.Errorf("invalid issuer root: %s", issuerRootStr)
+	}
+	issuerRootJson, err := json.Marshal(issuerRootStr)
 	if err != nil {
-		return fmt.Errorf("failed to marshal gvkStr. %v", err)
+		return fmt.Errorf("failed to marshal issuerRootStr. %v", err)
 	}
-	err = ctx.GetStub().PutState("GVK", gvkStringJson)
+	err = ctx.GetStub().PutState("ISSUER_ROOT", issuerRootJson)
 	if err != nil {
-		return fmt.Errorf("failed to store gvkStr. %v", err)
+		return fmt.Errorf("failed to store issuerRootStr. %v", err)
 	}
 	return nil
 }
 
-// UpdateGVK update the gvk
-func (s *SmartContract) UpdateGVK(
+// UpdateRoot publish the revocation root of a tree head signed by the supervisor (SignTreeHead, verified against LPK),
+// sthJson: s3cross.SignedTreeHead json of the next epoch, proofJson: consistency proof (json array of base64 hashes,
+// "" or "[]" for none) of the log of the current head to the log of the new one (ConsistencyProof),
+// the revocation log only grows, so a revoked user is not dropped from ROOT without a reinstatement entry
+func (s *SmartContract) UpdateRoot(
 	ctx contractapi.TransactionContextInterface,
-	gvkString string,
+	sthJson, proofJson string,
 ) error {
-	_, err := base64StringToVerifyingKey(&gvkString)
+	var sth s3cross.SignedTreeHead
+	if err := json.Unmarshal([]byte(sthJson), &sth); err != nil {
+		return fmt.Errorf("failed to parse tree head. %v", err)
+	}
+	proof := [][]byte{}
+	if proofJson != "" {
+		if err := json.Unmarshal([]byte(proofJson), &proof); err != nil {
+			return fmt.Errorf("failed to parse consistency proof. %v", err)
+		}
+	}
+	lpk, err := getLogKey(ctx)
+	if err != nil {
+		return err
+	}
+	if err = s3cross.VerifyTreeHead(lpk, &sth); err != nil {
+		return err
+	}
+
+	// the first head extends the empty log of epoch 0
+	current := &s3cross.SignedTreeHead{TreeHead: s3cross.TreeHead{LogRoot: s3cross.LogRoot(nil)}}
+	head, err := getTreeHead(ctx, "TREE_HEAD")
 	if err != nil {
-		return fmt.Errorf("failed to convert gvk string to verifying key: %w", err)
+		return err
+	}
+	if head != nil {
+		current = head
+	}
+	if sth.Epoch != current.Epoch+1 {
+		return fmt.Errorf("tree head epoch %d, the next epoch is %d", sth.Epoch, current.Epoch+1)
+	}
+	if err = s3cross.VerifyConsistency(current.Size, sth.Size, current.LogRoot, sth.LogRoot, proof); err != nil {
+		return fmt.Errorf("the revocation log of epoch %d does not extend the log of epoch %d. %v", sth.Epoch, current.Epoch, err)
 	}
-	gvkStringJson, err := json.Marshal(gvkString)
+
+	sthBytes, err := json.Marshal(sth)
 	if err != nil {
-		return fmt.Errorf("failed to marshal gvkString. %v", err)
+		return fmt.Errorf("failed to marshal tree head. %v", err)
 	}
-	err = ctx.GetStub().PutState("GVK", gvkStringJson)
+	err = ctx.GetStub().PutState(treeHeadKey(sth.Epoch), sthBytes)
 	if err != nil {
-		return fmt.Errorf("failed to store gvkStringJson. %v", err)
+		return fmt.Errorf("failed to store tree head. %v", err)
 	}
-	return nil
+	err = ctx.GetStub().PutState("TREE_HEAD", sthBytes)
+	if err != nil {
+		return fmt.Errorf("failed to store tree head. %v", err)
+	}
+	return activateRoot(ctx, base64.StdEncoding.EncodeToString(sth.Root), sth.Epoch)
 }
 
-func (s *SmartContract) CreatePseudonym(
+// GetTreeHead the signed tree head of the current ROOT, an error before the first UpdateRoot
+func (s *SmartContract) GetTreeHead(
 	ctx contractapi.TransactionContextInterface,
-	proofStr, pubWitStr string,
-) error {
-	// convert string to object
-	proof, err := base64StringToProof(&proofStr)
+) (*s3cross.SignedTreeHead, error) {
+	head, err := getTreeHead(ctx, "TREE_HEAD")
 	if err != nil {
-		return fmt.Errorf("failed to convert proof string to verifyingKey. %v", err)
+		return nil, err
 	}
+	if head == nil {
+		return nil, fmt.Errorf("no tree head, see UpdateRoot")
+	}
+	return head, nil
+}
 
-	publicWitness, err := base64StringToWitness(&pubWitStr)
+// QueryTreeHead the signed tree head of an epoch, for the auditors of the revocation log
+func (s *SmartContract) QueryTreeHead(
+	ctx contractapi.TransactionContextInterface,
+	epoch uint64,
+) (*s3cross.SignedTreeHead, error) {
+	head, err := getTreeHead(ctx, treeHeadKey(epoch))
 	if err != nil {
-		return fmt.Errorf("failed to convert publicWitness string to witness. %v", err)
+		return nil, err
+	}
+	if head == nil {
+		return nil, fmt.Errorf("unknown tree head epoch: %d", epoch)
 	}
+	return head, nil
+}
 
-	// verify circuit proof
-	gvkStringJson, err := ctx.GetStub().GetState("GVK")
+// SetRootPolicy set the number of the accepted revocation roots (1 <= window <= MaxRootWindow, the current ROOT
+// is one of them) and the grace period (0 <= seconds <= MaxRootGracePeriod) of a replaced root, 0 accepts only
+// the current ROOT; only for the callers with the AdminAttribute
+func (s *SmartContract) SetRootPolicy(
+	ctx contractapi.TransactionContextInterface,
+	window int,
+	gracePeriod int64,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "SetRootPolicy"); err != nil {
+		return err
+	}
+	if window < 1 || window > MaxRootWindow || gracePeriod < 0 || gracePeriod > MaxRootGracePeriod {
+		return fmt.Errorf("invalid root policy: window %d, grace period %d", window, gracePeriod)
+	}
+	policyJson, err := json.Marshal(RootPolicy{Window: window, GracePeriod: gracePeriod})
 	if err != nil {
-		return fmt.Errorf("failed to get gvk string from world state. %v", err)
+		return fmt.Errorf("failed to marshal root policy. %v", err)
 	}
-	var gvkString string
-	err = json.Unmarshal(gvkStringJson, &gvkString)
+	err = ctx.GetStub().PutState("ROOT_POLICY", policyJson)
+	if err != nil {
+		return fmt.Errorf("failed to store root policy. %v", err)
+	}
+	return nil
+}
+
+// GetRootPolicy the root policy, DefaultRootWindow and DefaultRootGracePeriod before SetRootPolicy
+func (s *SmartContract) GetRootPolicy(
+	ctx contractapi.TransactionContextInterface,
+) (*RootPolicy, error) {
+	return getRootPolicy(ctx)
+}
+
+// GetAcceptedRoots the revocation roots a proof can use now, the current ROOT first
+func (s *SmartContract) GetAcceptedRoots(
+	ctx contractapi.TransactionContextInterface,
+) ([]RootRecord, error) {
+	roots, err := getRoots(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to convert gvkStringJson to gvk string. %v", err)
+		return nil, err
 	}
-	gvk, err := base64StringToVerifyingKey(&gvkString)
+	policy, err := getRootPolicy(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to convert gvk string to verifying key. %v", err)
+		return nil, err
 	}
-	err = groth16.Verify(proof, *gvk, publicWitness)
+	now, err := txTimestamp(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to verify circuit proof. %v", err)
+		return nil, err
+	}
+	accepted := []RootRecord{}
+	for i, r := range roots {
+		if rootAccepted(roots, i, policy, now) == nil {
+			accepted = append(accepted, r)
+		}
+	}
+	return accepted, nil
+}
+
+// UpdateGVK update the gvk (groth16, s3cross.DefaultConfig), only for the callers with the AdminAttribute
+func (s *SmartContract) UpdateGVK(
+	ctx contractapi.TransactionContextInterface,
+	gvkString string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "UpdateGVK"); err != nil {
+		return err
 	}
+	return updateVerifyingKey(ctx, &VerifyingKeyRecord{Backend: BackendGroth16, Config: s3cross.DefaultConfig, VK: gvkString})
+}
 
-	// omit the check of nonce
-	values := publicWitness.Vector().(fr.Vector)
-	// // check the consistency of isk, spk and root
-	root := values[0].Bytes()
-	//fmt.Println("root from publicWitness", root)
-	ipk := twistededwards.PointAffine{
-		X: values[3],
-		Y: values[4],
+// UpdateVerifyingKey switch the default circuit verification key, its backend and circuit config,
+// only for the callers with the AdminAttribute
+// backend: "groth16" or "plonk"
+// configJson: s3cross.Config json of the circuit, the ROOT should be built with its hash and depth
+func (s *SmartContract) UpdateVerifyingKey(
+	ctx contractapi.TransactionContextInterface,
+	backend, configJson, vkString string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "UpdateVerifyingKey"); err != nil {
+		return err
 	}
-	spk := twistededwards.PointAffine{
-		X: values[8],
-		Y: values[9],
+	cfg, err := parseConfig(configJson)
+	if err != nil {
+		return err
 	}
+	return updateVerifyingKey(ctx, &VerifyingKeyRecord{Backend: backend, Config: *cfg, VK: vkString})
+}
 
-	indIpk := ipk.Bytes()
-	ipkStr := base64.StdEncoding.EncodeToString(indIpk[:])
-	ipkStringJson, err := ctx.GetStub().GetState("IPK")
+// RegisterCircuitVersion add the verification key of a new circuit version, only for the callers with the AdminAttribute,
+// a registered version is never replaced, so proofs tagged with an older version keep verifying with their own key
+// configJson: s3cross.Config json of the circuit of the version
+func (s *SmartContract) RegisterCircuitVersion(
+	ctx contractapi.TransactionContextInterface,
+	version, backend, configJson, vkString string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "RegisterCircuitVersion"); err != nil {
+		return err
+	}
+	cfg, err := parseConfig(configJson)
 	if err != nil {
-		return fmt.Errorf("failed to get ipk string from world state. %v", err)
+		return err
 	}
-	var ipkString string
-	err = json.Unmarshal(ipkStringJson, &ipkString)
+	return registerCircuitVersion(ctx, &VerifyingKeyRecord{Version: version, Backend: backend, Config: *cfg, VK: vkString})
+}
+
+// RegisterMultiCircuitVersion RegisterCircuitVersion of a S3CrossMultiCircuit version (Config.Pseudonyms > 0),
+// each proof of the version stores Config.Pseudonyms pseudonyms
+func (s *SmartContract) RegisterMultiCircuitVersion(
+	ctx contractapi.TransactionContextInterface,
+	version, backend, configJson, vkString string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "RegisterMultiCircuitVersion"); err != nil {
+		return err
+	}
+	cfg, err := parseConfig(configJson)
 	if err != nil {
-		return fmt.Errorf("failed to convert ipkStringJson to gsk string. %v", err)
+		return err
 	}
-	if ipkStr != ipkString {
-		return fmt.Errorf("ipk does not match")
+	if cfg.Pseudonyms <= 0 {
+		return fmt.Errorf("invalid pseudonym number: %d", cfg.Pseudonyms)
 	}
+	return registerCircuitVersion(ctx, &VerifyingKeyRecord{Version: version, Backend: backend, Config: *cfg, VK: vkString})
+}
 
-	indSpk := spk.Bytes()
-	spkStr := base64.StdEncoding.EncodeToString(indSpk[:])
-	spkStringJson, err := ctx.GetStub().GetState("SPK")
+// RegisterHiddenIssuerCircuitVersion RegisterCircuitVersion of a S3CrossHiddenIssuerCircuit version (Config.IssuerDepth > 0),
+// its proofs are checked against the ISSUER_ROOT of UpdateIssuerRoot
+func (s *SmartContract) RegisterHiddenIssuerCircuitVersion(
+	ctx contractapi.TransactionContextInterface,
+	version, backend, configJson, vkString string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "RegisterHiddenIssuerCircuitVersion"); err != nil {
+		return err
+	}
+	cfg, err := parseConfig(configJson)
 	if err != nil {
-		return fmt.Errorf("failed to get spk string from world state. %v", err)
+		return err
 	}
-	var spkString string
-	err = json.Unmarshal(spkStringJson, &spkString)
+	if cfg.IssuerDepth <= 0 {
+		return fmt.Errorf("invalid issuer depth: %d", cfg.IssuerDepth)
+	}
+	return registerCircuitVersion(ctx, &VerifyingKeyRecord{Version: version, Backend: backend, Config: *cfg, VK: vkString})
+}
+
+// RegisterCircuitVersionRecord RegisterCircuitVersion from a VerifyingKeyRecord json
+func (s *SmartContract) RegisterCircuitVersionRecord(
+	ctx contractapi.TransactionContextInterface,
+	vkrJson string,
+) error {
+	if err := assertAttribute(ctx, AdminAttribute, "RegisterCircuitVersionRecord"); err != nil {
+		return err
+	}
+	var vkr VerifyingKeyRecord
+	if err := json.Unmarshal([]byte(vkrJson), &vkr); err != nil {
+		return fmt.Errorf("failed to parse vk record. %v", err)
+	}
+	return registerCircuitVersion(ctx, &vkr)
+}
+
+func (s *SmartContract) CreatePseudonym(
+	ctx contractapi.TransactionContextInterface,
+	proofStr, pubWitStr string,
+) error {
+	version, proofStr, err := parseVersionedProof(proofStr)
 	if err != nil {
-		return fmt.Errorf("failed to convert spkStringJson to spk string. %v", err)
+		return err
 	}
-	if spkStr != spkString {
-		return fmt.Errorf("spk does not match")
+	vkr, err := getVerifyingKey(ctx, version)
+	if err != nil {
+		return err
 	}
-	
-	rootStr := base64.StdEncoding.EncodeToString(root[:])
-	rootStringJson, err := ctx.GetStub().GetState("ROOT")
+	schema, err := publicSchemaOf(vkr)
 	if err != nil {
-		return fmt.Errorf("failed to get root string from world state. %v", err)
+		return err
 	}
-	var rootString string
-	err = json.Unmarshal(rootStringJson, &rootString)
+
+	// convert string to object, of the size of the public inputs of the version
+	publicWitness, err := base64StringToWitness(&pubWitStr, schema.Len())
 	if err != nil {
-		return fmt.Errorf("failed to convert rootStringJson to root string. %v", err)
+		return fmt.Errorf("failed to convert publicWitness string to witness. %v", err)
 	}
-	if rootStr != rootString {
-		return fmt.Errorf("root does not match")
+
+	// verify circuit proof with the key of its version
+	err = verifyCircuitProof(vkr, proofStr, publicWitness)
+	if err != nil {
+		return fmt.Errorf("failed to verify circuit proof. %v", err)
 	}
 
-	// // store the pseudonym
-	pusPubKey := twistededwards.PointAffine{
-		X: values[5],
-		Y: values[6],
+	in, err := schema.Read(publicWitness)
+	if err != nil {
+		return err
 	}
-	c1 := twistededwards.PointAffine{
-		X: values[10],
-		Y: values[11],
+	if err = checkPublicParams(ctx, vkr, in); err != nil {
+		return err
 	}
-	c2 := twistededwards.PointAffine{
-		X: values[12],
-		Y: values[13],
+	pusB64Keys, err := checkPseudonyms(ctx, in, map[string]bool{})
+	if err != nil {
+		return err
 	}
-	indPPK := pusPubKey.Bytes()
-	pusB64Key := base64.StdEncoding.EncodeToString(indPPK[:])
-	indC1 := c1.Bytes()
-	b64C1Key := base64.StdEncoding.EncodeToString(indC1[:])
-	indC2 := c2.Bytes()
-	b64C2Key := base64.StdEncoding.EncodeToString(indC2[:])
-	psu := Pseudonym{
-		PublicKey: pusB64Key,
-		TimeStamp: time.Now().Unix(),
-		Used:      false,
-		C1:        b64C1Key,
-		C2:        b64C2Key,
+	return storePseudonyms(ctx, in, pusB64Keys)
+}
+
+// CreatePseudonymsBatch store k pseudonyms in one transaction
+// the groth16 proofs of a circuit version are verified with one randomized multi-pairing (s3cross.BatchVerifyGroth16),
+// plonk proofs one by one; an invalid entry is rejected and the valid ones are still stored,
+// an entry with a pseudonym already stored, by the ledger or an earlier entry of the batch, is rejected
+func (s *SmartContract) CreatePseudonymsBatch(
+	ctx contractapi.TransactionContextInterface,
+	proofStrs, pubWitStrs []string,
+) (*BatchResult, error) {
+	if len(proofStrs) != len(pubWitStrs) {
+		return nil, fmt.Errorf("got %d proofs and %d public witnesses", len(proofStrs), len(pubWitStrs))
+	}
+	result := &BatchResult{
+		Stored:   []string{},
+		Rejected: []BatchRejection{},
 	}
+	errs := make([]error, len(proofStrs))
+	inputs := make([]*s3cross.PublicInputs, len(proofStrs))
+	vkrs := make([]*VerifyingKeyRecord, len(proofStrs))
+	publicWitnesses := make([]witness.Witness, len(proofStrs))
+	proofBodies := make([]string, len(proofStrs))
 
-	psuJson, err := json.Marshal(psu)
-	if err != nil {
-		return fmt.Errorf("failed to marshal psu. %v", err)
+	// group the entries by circuit version
+	var versions []string
+	groups := make(map[string][]int)
+	for i := range proofStrs {
+		var version string
+		version, proofBodies[i], errs[i] = parseVersionedProof(proofStrs[i])
+		if errs[i] != nil {
+			continue
+		}
+		if _, ok := groups[version]; !ok {
+			versions = append(versions, version)
+		}
+		groups[version] = append(groups[version], i)
 	}
-	err = ctx.GetStub().PutState("PSU_"+pusB64Key, psuJson)
-	if err != nil {
-		return fmt.Errorf("failed to store pseudonym. %v", err)
+
+	for _, version := range versions {
+		idx := groups[version]
+		vkr, err := getVerifyingKey(ctx, version)
+		var schema *s3cross.PublicSchema
+		if err == nil {
+			schema, err = publicSchemaOf(vkr)
+		}
+		if err != nil {
+			for _, i := range idx {
+				errs[i] = err
+			}
+			continue
+		}
+
+		// the public witnesses of the size of the public inputs of the version
+		var decoded []int
+		for _, i := range idx {
+			publicWitnesses[i], errs[i] = base64StringToWitness(&pubWitStrs[i], schema.Len())
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("failed to convert publicWitness string to witness. %v", errs[i])
+				continue
+			}
+			decoded = append(decoded, i)
+		}
+		verifyVersionBatch(vkr, decoded, proofBodies, publicWitnesses, errs)
+		for _, i := range decoded {
+			if errs[i] != nil {
+				continue
+			}
+			inputs[i], errs[i] = schema.Read(publicWitnesses[i])
+			vkrs[i] = vkr
+		}
 	}
-	return nil
+
+	// the keys stored by the batch, the world state does not show the writes of the transaction
+	seen := make(map[string]bool)
+	for i := range proofStrs {
+		if errs[i] == nil {
+			errs[i] = checkPublicParams(ctx, vkrs[i], inputs[i])
+		}
+		var pusB64Keys []string
+		if errs[i] == nil {
+			pusB64Keys, errs[i] = checkPseudonyms(ctx, inputs[i], seen)
+		}
+		if errs[i] != nil {
+			result.Rejected = append(result.Rejected, BatchRejection{Index: i, Error: errs[i].Error()})
+			continue
+		}
+		if err := storePseudonyms(ctx, inputs[i], pusB64Keys); err != nil {
+			return nil, err
+		}
+		result.Stored = append(result.Stored, pusB64Keys...)
+	}
+	return result, nil
 }
 
 // GetAllPseudonymsPaged query given number of Pseudonyms
@@ -330,7 +704,10 @@ func (s *SmartContract) IsPseudonymValid(
 	if err := json.Unmarshal(data, &psu); err != nil {
 		return false, fmt.Errorf("failed to parse psu data: %v", err)
 	}
-	now := time.Now().Unix()
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return false, err
+	}
 	const ExpirySeconds = 7200
 	if psu.Used == false && now-psu.TimeStamp < ExpirySeconds {
 		return true, nil // 有效
@@ -338,180 +715,898 @@ func (s *SmartContract) IsPseudonymValid(
 	return false, nil // 已失效
 }
 
-// 改变 Pseudonym 的 used 状态
-
-// ---------- Ciruit ----------
-
-type Circuit struct {
-	// ordered Merkle tree proof
-	Root frontend.Variable `gnark:",public"`
-	// // left path
-	ProofElements1 []frontend.Variable // private
-	ProofIndex1    frontend.Variable   // private
-	Leaf1          frontend.Variable   `gnark:",public"` // mimc hash of the public key
-	// // right node
-	Leaf2 frontend.Variable `gnark:",public"` // mimc hash of the public key
-
-	// schnorr proof
-	IPkX frontend.Variable `gnark:",public"`
-	IPkY frontend.Variable `gnark:",public"`
-
-	Sig frontend.Variable // private
-	RX  frontend.Variable // private
-	RY  frontend.Variable // private
-
-	MessageX frontend.Variable // private
-	MessageY frontend.Variable // private
-
-	// psu proof
-	PPkX  frontend.Variable `gnark:",public"`
-	PPkY  frontend.Variable `gnark:",public"`
-	Nonce frontend.Variable `gnark:",public"` // current nonce for SR, should mod curve.Order
-	//MaxI  frontend.Variable `gnark:",public"` // max psu number
-	USk frontend.Variable // private
-	I   frontend.Variable // private
-
-	// elgamal proof
-	SPkX frontend.Variable `gnark:",public"`
-	SPkY frontend.Variable `gnark:",public"`
-	C1X  frontend.Variable `gnark:",public"`
-	C1Y  frontend.Variable `gnark:",public"`
-	C2X  frontend.Variable `gnark:",public"`
-	C2Y  frontend.Variable `gnark:",public"`
-	R    frontend.Variable // private
-}
-
-func (circuit *Circuit) Define(api frontend.API) error {
-	numBits := 4 // max psu number is 2^numBits - 1
-
-	curve, err := twistededwards1.NewEdCurve(api, twistededwards2.BN254)
-	if err != nil {
-		return err
-	}
-	base := twistededwards1.Point{
-		X: curve.Params().Base[0],
-		Y: curve.Params().Base[1],
-	}
-
-	h, err := mimc.NewMiMC(api)
-	if err != nil {
-		return err
-	}
-
-	// check Merkle proof
-	// // path 1
-	hashed := circuit.Leaf1
-	depth := len(circuit.ProofElements1)
-	proofIndices := api.ToBinary(circuit.ProofIndex1, depth)
-	for i := 0; i < depth; i++ {
-		sibling := circuit.ProofElements1[i]
-		index := proofIndices[i]
-
-		left := api.Select(index, sibling, hashed)
-		right := api.Select(index, hashed, sibling)
-
-		h.Reset()
-		h.Write(left, right)
-		hashed = h.Sum()
-	}
-	api.AssertIsEqual(hashed, circuit.Root)
-
-	upk := curve.ScalarMul(base, circuit.USk)
-	h.Reset()
-	h.Write(upk.X, upk.Y)
-	hUpk := h.Sum()
-	// // // ProofIndex1 < upk < ProofIndex2
-	api.AssertIsLessOrEqual(api.Add(circuit.Leaf1, 1), hUpk)
-	api.AssertIsLessOrEqual(api.Add(hUpk, 1), circuit.Leaf2)
-
-	// check schnorr signature
-	IPk := twistededwards1.Point{
-		X: circuit.IPkX,
-		Y: circuit.IPkY,
-	}
-	R := twistededwards1.Point{
-		X: circuit.RX,
-		Y: circuit.RY,
-	}
-	h.Reset()
-	h.Write(IPk.X, IPk.Y, R.X, R.Y, circuit.MessageX, circuit.MessageY)
-	c := h.Sum()
-	// // g^{Sig} = R·X^c
-	S := curve.ScalarMul(base, circuit.Sig)
-	Xc := curve.ScalarMul(IPk, c)
-	RXc := curve.Add(R, Xc)
-	api.AssertIsEqual(S.X, RXc.X)
-	api.AssertIsEqual(S.Y, RXc.Y)
-	// // Message = upk
-	api.AssertIsEqual(upk.X, circuit.MessageX)
-	api.AssertIsEqual(upk.Y, circuit.MessageY)
-
-	// check pseudonym
-	// // I > 0
-	api.AssertIsDifferent(circuit.I, 0)
-	//isEqual := api.IsZero(circuit.I)
-	//api.AssertIsEqual(isEqual, 0)
-	// // I <= MaxI
-	//api.AssertIsLessOrEqual(circuit.I, circuit.MaxI)
-	rc := rangecheck.New(api)
-	rc.Check(circuit.I, numBits)
-
-	h.Reset()
-	h.Write(circuit.Nonce, circuit.I)
-	hOut := h.Sum()
-	psk := api.Inverse(api.Add(circuit.USk, hOut))
-	ppk := curve.ScalarMul(base, psk)
-
-	api.AssertIsEqual(ppk.X, circuit.PPkX)
-	api.AssertIsEqual(ppk.Y, circuit.PPkY)
-
-	// check elgamal
-	spk := twistededwards1.Point{
-		X: circuit.SPkX,
-		Y: circuit.SPkY,
+// RecordTrace record the opening of the pseudonym pbk to the user public key upkStr (base64)
+// proofStr: s3cross.DecryptionProof json of the supervisor (DecryptWithProof), verified against SPK and the ciphertext of the pseudonym
+func (s *SmartContract) RecordTrace(
+	ctx contractapi.TransactionContextInterface,
+	pbk, upkStr, proofStr string,
+) error {
+	psu, err := s.QueryPseudonymByPBK(ctx, pbk)
+	if err != nil {
+		return err
 	}
-	C1_ := curve.ScalarMul(base, circuit.R)
-	C2_ := curve.ScalarMul(spk, circuit.R)
-	C2_ = curve.Add(C2_, upk)
-
-	api.AssertIsEqual(circuit.C1X, C1_.X)
-	api.AssertIsEqual(circuit.C1Y, C1_.Y)
-	api.AssertIsEqual(circuit.C2X, C2_.X)
-	api.AssertIsEqual(circuit.C2Y, C2_.Y)
-
-	return nil
-}
-
-func base64StringToVerifyingKey(encoded *string) (*groth16.VerifyingKey, error) {
-	data, err := base64.StdEncoding.DecodeString(*encoded)
+	traceJson, err := ctx.GetStub().GetState("TRACE_" + pbk)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to query state: %v", err)
+	}
+	if traceJson != nil {
+		return fmt.Errorf("pseudonym already traced: %s", pbk)
 	}
-	buf := bytes.NewBuffer(data)
-	vk := groth16.NewVerifyingKey(ecc.BN254)
-	_, err = vk.ReadFrom(buf)
-	return &vk, err
-}
 
-func base64StringToProof(encoded *string) (groth16.Proof, error) {
-	data, err := base64.StdEncoding.DecodeString(*encoded)
+	c1, err := base64StringToPoint(psu.C1)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to convert c1 string to point. %v", err)
 	}
-	buf := bytes.NewBuffer(data)
-	proof := groth16.NewProof(ecc.BN254)
-	_, err = proof.ReadFrom(buf)
+	c2, err := base64StringToPoint(psu.C2)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to convert c2 string to point. %v", err)
 	}
-	return proof, nil
-}
-
-func base64StringToWitness(str *string) (witness.Witness, error) {
+	upk, err := base64StringToPoint(upkStr)
+	if err != nil {
+		return fmt.Errorf("failed to convert upk string to point. %v", err)
+	}
+	spk, err := getSupervisorKey(ctx)
+	if err != nil {
+		return err
+	}
+	var proof s3cross.DecryptionProof
+	if err = json.Unmarshal([]byte(proofStr), &proof); err != nil {
+		return fmt.Errorf("failed to parse decryption proof. %v", err)
+	}
+	if err = s3cross.VerifyDecryption(spk, c1, c2, upk, &proof); err != nil {
+		return err
+	}
+
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	traceJson, err = json.Marshal(TraceRecord{
+		PublicKey: pbk,
+		UserPK:    upkStr,
+		Proof:     proofStr,
+		TimeStamp: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal trace. %v", err)
+	}
+	err = ctx.GetStub().PutState("TRACE_"+pbk, traceJson)
+	if err != nil {
+		return fmt.Errorf("failed to store trace. %v", err)
+	}
+	return nil
+}
+
+// QueryTrace the recorded opening of the pseudonym pbk
+func (s *SmartContract) QueryTrace(
+	ctx contractapi.TransactionContextInterface,
+	pbk string,
+) (*TraceRecord, error) {
+	data, err := ctx.GetStub().GetState("TRACE_" + pbk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query state: %v", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("no trace found for public key: %s", pbk)
+	}
+	var trace TraceRecord
+	if err := json.Unmarshal(data, &trace); err != nil {
+		return nil, fmt.Errorf("failed to parse trace data: %v", err)
+	}
+	return &trace, nil
+}
+
+// 改变 Pseudonym 的 used 状态
+
+// AdvanceEpoch open the next nonce epoch, only for the callers with the EpochAdminAttribute,
+// its nonce is derived from the epoch number and the transaction id (s3cross.EpochNonce)
+// proofs of the nonces of the last AcceptedEpochs epochs are accepted
+// an epoch lasts at least EpochSeconds, each epoch gives every user 2^IndexBits - 1 new pseudonyms
+func (s *SmartContract) AdvanceEpoch(
+	ctx contractapi.TransactionContextInterface,
+) (*EpochRecord, error) {
+	if err := assertAttribute(ctx, EpochAdminAttribute, "AdvanceEpoch"); err != nil {
+		return nil, err
+	}
+
+	current, err := getCurrentEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current > 0 {
+		cur, err := getEpoch(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if now-cur.TimeStamp < EpochSeconds {
+			return nil, fmt.Errorf("epoch %d is still active", current)
+		}
+	}
+	epoch := current + 1
+	seed := ctx.GetStub().GetTxID()
+	nonce := s3cross.EpochNonce(epoch, []byte(seed))
+	hashes := make(map[string]string)
+	for _, hashID := range []string{HashMiMC, HashPoseidon2} {
+		nc, err := s3cross.HashID(hashID).NonceHash(nonce)
+		if err != nil {
+			return nil, err
+		}
+		hashes[hashID] = base64.StdEncoding.EncodeToString(nc.FillBytes(make([]byte, fr.Bytes)))
+	}
+	nonceBytes := nonce.Bytes()
+	record := &EpochRecord{
+		Epoch:       epoch,
+		Nonce:       base64.StdEncoding.EncodeToString(nonceBytes[:]),
+		X:           nonce.X.String(),
+		Y:           nonce.Y.String(),
+		Seed:        seed,
+		NonceHashes: hashes,
+		TimeStamp:   now,
+	}
+
+	recordJson, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal epoch. %v", err)
+	}
+	err = ctx.GetStub().PutState(epochKey(epoch), recordJson)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store epoch. %v", err)
+	}
+	epochJson, err := json.Marshal(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal epoch number. %v", err)
+	}
+	err = ctx.GetStub().PutState("EPOCH", epochJson)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store epoch number. %v", err)
+	}
+	return record, nil
+}
+
+// GetCurrentNonce the current epoch and its nonce point, the nonce of the next pseudonyms
+func (s *SmartContract) GetCurrentNonce(
+	ctx contractapi.TransactionContextInterface,
+) (*EpochRecord, error) {
+	current, err := getCurrentEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current == 0 {
+		return nil, fmt.Errorf("no nonce epoch, see AdvanceEpoch")
+	}
+	return getEpoch(ctx, current)
+}
+
+// ===== Tool Functions =====
+
+// assertAttribute check the caller certificate has the attribute with the value "true", method is the transaction
+func assertAttribute(ctx contractapi.TransactionContextInterface, attribute, method string) error {
+	clientID := ctx.GetClientIdentity()
+	if clientID == nil {
+		return fmt.Errorf("failed to get client identity")
+	}
+	if err := clientID.AssertAttributeValue(attribute, "true"); err != nil {
+		return fmt.Errorf("%s needs the %s attribute. %v", method, attribute, err)
+	}
+	return nil
+}
+
+// epochKey the world state key of an epoch record
+func epochKey(epoch uint64) string {
+	return "EPOCH_" + strconv.FormatUint(epoch, 10)
+}
+
+// getCurrentEpoch the number of the current epoch, 0 before the first AdvanceEpoch
+func getCurrentEpoch(ctx contractapi.TransactionContextInterface) (uint64, error) {
+	epochJson, err := ctx.GetStub().GetState("EPOCH")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get epoch from world state. %v", err)
+	}
+	if epochJson == nil {
+		return 0, nil
+	}
+	var epoch uint64
+	if err = json.Unmarshal(epochJson, &epoch); err != nil {
+		return 0, fmt.Errorf("failed to convert epochJson to epoch. %v", err)
+	}
+	return epoch, nil
+}
+
+func getEpoch(ctx contractapi.TransactionContextInterface, epoch uint64) (*EpochRecord, error) {
+	recordJson, err := ctx.GetStub().GetState(epochKey(epoch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epoch from world state. %v", err)
+	}
+	if recordJson == nil {
+		return nil, fmt.Errorf("unknown epoch: %d", epoch)
+	}
+	var record EpochRecord
+	if err = json.Unmarshal(recordJson, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse epoch data: %v", err)
+	}
+	return &record, nil
+}
+
+// activateRoot make rootStr the ROOT, the replaced roots are kept in ROOTS (newest first) for the RootPolicy
+func activateRoot(ctx contractapi.TransactionContextInterface, rootStr string, epoch uint64) error {
+	roots, err := getRoots(ctx)
+	if err != nil {
+		return err
+	}
+	policy, err := getRootPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	roots = append([]RootRecord{{Root: rootStr, Epoch: epoch, ActivatedAt: now}}, roots...)
+	if len(roots) > policy.Window {
+		roots = roots[:policy.Window]
+	}
+
+	rootsJson, err := json.Marshal(roots)
+	if err != nil {
+		return fmt.Errorf("failed to marshal roots. %v", err)
+	}
+	err = ctx.GetStub().PutState("ROOTS", rootsJson)
+	if err != nil {
+		return fmt.Errorf("failed to store roots. %v", err)
+	}
+	rootStrJson, err := json.Marshal(rootStr)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rootStr. %v", err)
+	}
+	err = ctx.GetStub().PutState("ROOT", rootStrJson)
+	if err != nil {
+		return fmt.Errorf("failed to store rootStr. %v", err)
+	}
+	return nil
+}
+
+// getRoots the revocation roots, newest first, a ledger without ROOTS has only its ROOT
+func getRoots(ctx contractapi.TransactionContextInterface) ([]RootRecord, error) {
+	rootsJson, err := ctx.GetStub().GetState("ROOTS")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roots from world state. %v", err)
+	}
+	var roots []RootRecord
+	if rootsJson != nil {
+		if err = json.Unmarshal(rootsJson, &roots); err != nil {
+			return nil, fmt.Errorf("failed to parse roots data: %v", err)
+		}
+		return roots, nil
+	}
+
+	rootStringJson, err := ctx.GetStub().GetState("ROOT")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root string from world state. %v", err)
+	}
+	if rootStringJson == nil {
+		return nil, nil
+	}
+	var rootString string
+	err = json.Unmarshal(rootStringJson, &rootString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert rootStringJson to root string. %v", err)
+	}
+	return []RootRecord{{Root: rootString}}, nil
+}
+
+// getRootPolicy the stored RootPolicy or the default one
+func getRootPolicy(ctx contractapi.TransactionContextInterface) (*RootPolicy, error) {
+	policyJson, err := ctx.GetStub().GetState("ROOT_POLICY")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root policy from world state. %v", err)
+	}
+	if policyJson == nil {
+		return &RootPolicy{Window: DefaultRootWindow, GracePeriod: DefaultRootGracePeriod}, nil
+	}
+	var policy RootPolicy
+	if err = json.Unmarshal(policyJson, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse root policy data: %v", err)
+	}
+	return &policy, nil
+}
+
+// treeHeadKey the world state key of the tree head of an epoch
+func treeHeadKey(epoch uint64) string {
+	return "TREE_HEAD_" + strconv.FormatUint(epoch, 10)
+}
+
+// getTreeHead the tree head of the key, nil if there is none
+func getTreeHead(ctx contractapi.TransactionContextInterface, key string) (*s3cross.SignedTreeHead, error) {
+	headJson, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree head from world state. %v", err)
+	}
+	if headJson == nil {
+		return nil, nil
+	}
+	var head s3cross.SignedTreeHead
+	if err = json.Unmarshal(headJson, &head); err != nil {
+		return nil, fmt.Errorf("failed to parse tree head data: %v", err)
+	}
+	return &head, nil
+}
+
+// getSupervisorKey the supervisor public key SPK
+func getSupervisorKey(ctx contractapi.TransactionContextInterface) (*twistededwards.PointAffine, error) {
+	spkStringJson, err := ctx.GetStub().GetState("SPK")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spk string from world state. %v", err)
+	}
+	var spkString string
+	err = json.Unmarshal(spkStringJson, &spkString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert spkStringJson to spk string. %v", err)
+	}
+	spk, err := base64StringToPoint(spkString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert spk string to point. %v", err)
+	}
+	return spk, nil
+}
+
+// getLogKey the key of the tree head signatures, set by InitLedger
+func getLogKey(ctx contractapi.TransactionContextInterface) (*twistededwards.PointAffine, error) {
+	lpkStringJson, err := ctx.GetStub().GetState("LPK")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lpk string from world state. %v", err)
+	}
+	if lpkStringJson == nil {
+		return nil, fmt.Errorf("no log key, see InitLedger")
+	}
+	var lpkString string
+	err = json.Unmarshal(lpkStringJson, &lpkString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert lpkStringJson to lpk string. %v", err)
+	}
+	lpk, err := base64StringToPoint(lpkString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert lpk string to point. %v", err)
+	}
+	return lpk, nil
+}
+
+// updateVerifyingKey check and store the default key
+func updateVerifyingKey(ctx contractapi.TransactionContextInterface, vkr *VerifyingKeyRecord) error {
+	if err := checkVerifyingKey(vkr); err != nil {
+		return err
+	}
+	return putVerifyingKey(ctx, vkr)
+}
+
+// parseConfig the s3cross.Config json of a circuit
+func parseConfig(configJson string) (*s3cross.Config, error) {
+	var cfg s3cross.Config
+	if err := json.Unmarshal([]byte(configJson), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse circuit config. %v", err)
+	}
+	return &cfg, nil
+}
+
+// registerCircuitVersion store the key of a new version, an existing version is not overwritten
+func registerCircuitVersion(ctx contractapi.TransactionContextInterface, vkr *VerifyingKeyRecord) error {
+	if vkr.Version == "" {
+		return fmt.Errorf("empty circuit version")
+	}
+	vkrJson, err := ctx.GetStub().GetState(verifyingKeyKey(vkr.Version))
+	if err != nil {
+		return fmt.Errorf("failed to read world state. %v", err)
+	}
+	if vkrJson != nil {
+		return fmt.Errorf("circuit version %s already registered", vkr.Version)
+	}
+	if err = checkVerifyingKey(vkr); err != nil {
+		return err
+	}
+	return putVerifyingKey(ctx, vkr)
+}
+
+// verifyingKeyKey the world state key of a circuit version
+func verifyingKeyKey(version string) string {
+	if version == "" {
+		return "GVK"
+	}
+	return "GVK_" + version
+}
+
+// checkVerifyingKey check the circuit config, parse the key and check its public witness size against the schema
+// of the circuit of the config, a circuit with a public input added or removed is rejected here instead of misread
+// by CreatePseudonym
+func checkVerifyingKey(vkr *VerifyingKeyRecord) error {
+	if err := vkr.Config.Validate(); err != nil {
+		return err
+	}
+	if vkr.Config.Hash != s3cross.HashMiMC && vkr.Config.Hash != s3cross.HashPoseidon2 {
+		return fmt.Errorf("unknown hash: %s", vkr.Config.Hash)
+	}
+	var nbPublic int // size of the public witness, the groth16 K also has the commitment wires
+	switch vkr.Backend {
+	case BackendGroth16:
+		gvk, err := base64StringToVerifyingKey(&vkr.VK)
+		if err != nil {
+			return fmt.Errorf("failed to convert gvk string to verifying key: %w", err)
+		}
+		vk, ok := (*gvk).(*groth16_bn254.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("unsupported groth16 verifying key")
+		}
+		nbPublic = len(vk.G1.K) - len(vk.PublicAndCommitmentCommitted) - 1
+	case BackendPlonk:
+		pvk, err := base64StringToPlonkVerifyingKey(&vkr.VK)
+		if err != nil {
+			return fmt.Errorf("failed to convert plonk vk string to verifying key: %w", err)
+		}
+		vk, ok := pvk.(*plonk_bn254.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("unsupported plonk verifying key")
+		}
+		nbPublic = int(vk.NbPublicVariables)
+	default:
+		return fmt.Errorf("unknown backend: %s", vkr.Backend)
+	}
+
+	schema, err := publicSchemaOf(vkr)
+	if err != nil {
+		return err
+	}
+	if nbPublic != schema.Len() {
+		return fmt.Errorf("verifying key has %d public inputs, the circuit of %d pseudonyms (issuer depth: %d, expiry: %t) has %d",
+			nbPublic, vkr.Config.Pseudonyms, vkr.Config.IssuerDepth, vkr.Config.Expiry, schema.Len())
+	}
+	return nil
+}
+
+func putVerifyingKey(ctx contractapi.TransactionContextInterface, vkr *VerifyingKeyRecord) error {
+	vkrJson, err := json.Marshal(vkr)
+	if err != nil {
+		return fmt.Errorf("failed to marshal vk record. %v", err)
+	}
+	err = ctx.GetStub().PutState(verifyingKeyKey(vkr.Version), vkrJson)
+	if err != nil {
+		return fmt.Errorf("failed to store vk record. %v", err)
+	}
+	return nil
+}
+
+// getVerifyingKey read the vk record of a version, a bare string (older ledgers) is a groth16 key of s3cross.DefaultConfig
+func getVerifyingKey(ctx contractapi.TransactionContextInterface, version string) (*VerifyingKeyRecord, error) {
+	vkrJson, err := ctx.GetStub().GetState(verifyingKeyKey(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gvk string from world state. %v", err)
+	}
+	if vkrJson == nil {
+		return nil, fmt.Errorf("unknown circuit version: %s", version)
+	}
+	var vkr VerifyingKeyRecord
+	if err = json.Unmarshal(vkrJson, &vkr); err == nil {
+		return &vkr, nil
+	}
+	var gvkString string
+	err = json.Unmarshal(vkrJson, &gvkString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert gvkStringJson to gvk string. %v", err)
+	}
+	return &VerifyingKeyRecord{
+		Backend: BackendGroth16,
+		Config:  s3cross.DefaultConfig,
+		VK:      gvkString,
+	}, nil
+}
+
+// parseVersionedProof split a VersionedProof json, a bare base64 proof has version ""
+func parseVersionedProof(proofStr string) (string, string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(proofStr), "{") {
+		return "", proofStr, nil
+	}
+	var vp VersionedProof
+	if err := json.Unmarshal([]byte(proofStr), &vp); err != nil {
+		return "", "", fmt.Errorf("failed to parse versioned proof. %v", err)
+	}
+	if vp.Version == "" {
+		return "", "", fmt.Errorf("versioned proof without version")
+	}
+	return vp.Version, vp.Proof, nil
+}
+
+// publicSchemaOf the public witness schema of the circuit of a version
+func publicSchemaOf(vkr *VerifyingKeyRecord) (*s3cross.PublicSchema, error) {
+	return s3cross.PublicSchemaOf(vkr.Config)
+}
+
+// checkPublicParams check the consistency of ipk (or the issuer root), spk, root, nonce and current time of the public witness
+// with the world state and the transaction, the nonce is hashed with the hash of the circuit version vkr
+func checkPublicParams(ctx contractapi.TransactionContextInterface, vkr *VerifyingKeyRecord, in *s3cross.PublicInputs) error {
+	r := in.Root()
+	root := r.Bytes()
+	spk := in.SupervisorPK()
+
+	if err := checkIssuer(ctx, in); err != nil {
+		return err
+	}
+	if err := checkNonce(ctx, vkr.Config.Hash.String(), in); err != nil {
+		return err
+	}
+	if err := checkCurrentTime(ctx, in); err != nil {
+		return err
+	}
+
+	indSpk := spk.Bytes()
+	spkStr := base64.StdEncoding.EncodeToString(indSpk[:])
+	spkStringJson, err := ctx.GetStub().GetState("SPK")
+	if err != nil {
+		return fmt.Errorf("failed to get spk string from world state. %v", err)
+	}
+	var spkString string
+	err = json.Unmarshal(spkStringJson, &spkString)
+	if err != nil {
+		return fmt.Errorf("failed to convert spkStringJson to spk string. %v", err)
+	}
+	if spkStr != spkString {
+		return fmt.Errorf("spk does not match")
+	}
+
+	rootStr := base64.StdEncoding.EncodeToString(root[:])
+	return checkRoot(ctx, rootStr)
+}
+
+// checkRoot check the root of the public witness is one of the accepted revocation roots (RootPolicy)
+func checkRoot(ctx contractapi.TransactionContextInterface, rootStr string) error {
+	roots, err := getRoots(ctx)
+	if err != nil {
+		return err
+	}
+	policy, err := getRootPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	for i, r := range roots {
+		if r.Root == rootStr {
+			return rootAccepted(roots, i, policy, now)
+		}
+	}
+	return fmt.Errorf("root does not match")
+}
+
+// rootAccepted nil if the root i of the history (newest first) is accepted at the time now
+func rootAccepted(roots []RootRecord, i int, policy *RootPolicy, now int64) error {
+	if i == 0 {
+		return nil
+	}
+	if i >= policy.Window {
+		return fmt.Errorf("root is out of the window of the last %d roots", policy.Window)
+	}
+	if replaced := now - roots[i-1].ActivatedAt; replaced > policy.GracePeriod {
+		return fmt.Errorf("root was replaced %d seconds ago, the grace period is %d seconds", replaced, policy.GracePeriod)
+	}
+	return nil
+}
+
+// checkIssuer check the IPK of the public witness, or the ISSUER_ROOT for a hidden issuer
+func checkIssuer(ctx contractapi.TransactionContextInterface, in *s3cross.PublicInputs) error {
+	if in.HiddenIssuer() {
+		ir := in.IssuerRoot()
+		issuerRoot := ir.Bytes()
+		issuerRootStr := base64.StdEncoding.EncodeToString(issuerRoot[:])
+		issuerRootJson, err := ctx.GetStub().GetState("ISSUER_ROOT")
+		if err != nil {
+			return fmt.Errorf("failed to get issuer root string from world state. %v", err)
+		}
+		if issuerRootJson == nil {
+			return fmt.Errorf("no issuer set, see UpdateIssuerRoot")
+		}
+		var issuerRootString string
+		err = json.Unmarshal(issuerRootJson, &issuerRootString)
+		if err != nil {
+			return fmt.Errorf("failed to convert issuerRootJson to issuer root string. %v", err)
+		}
+		if issuerRootStr != issuerRootString {
+			return fmt.Errorf("issuer root does not match")
+		}
+		return nil
+	}
+
+	ipk := in.IssuerPK()
+	indIpk := ipk.Bytes()
+	ipkStr := base64.StdEncoding.EncodeToString(indIpk[:])
+	ipkStringJson, err := ctx.GetStub().GetState("IPK")
+	if err != nil {
+		return fmt.Errorf("failed to get ipk string from world state. %v", err)
+	}
+	var ipkString string
+	err = json.Unmarshal(ipkStringJson, &ipkString)
+	if err != nil {
+		return fmt.Errorf("failed to convert ipkStringJson to gsk string. %v", err)
+	}
+	if ipkStr != ipkString {
+		return fmt.Errorf("ipk does not match")
+	}
+	return nil
+}
+
+// checkPseudonyms return the base64 public keys of the pseudonyms of the public witness,
+// a key already in the world state, in seen (the keys stored earlier by the transaction) or repeated
+// in the witness rejects all of them; the keys are added to seen
+func checkPseudonyms(ctx contractapi.TransactionContextInterface, in *s3cross.PublicInputs, seen map[string]bool) ([]string, error) {
+	pusB64Keys := make([]string, in.Pseudonyms())
+	inWitness := make(map[string]bool, len(pusB64Keys))
+	for j := range pusB64Keys {
+		pusPubKey := in.PseudonymPK(j)
+		indPPK := pusPubKey.Bytes()
+		pusB64Keys[j] = base64.StdEncoding.EncodeToString(indPPK[:])
+		if seen[pusB64Keys[j]] || inWitness[pusB64Keys[j]] {
+			return nil, fmt.Errorf("pseudonym already stored: %s", pusB64Keys[j])
+		}
+		psuJson, err := ctx.GetStub().GetState("PSU_" + pusB64Keys[j])
+		if err != nil {
+			return nil, fmt.Errorf("failed to query state: %v", err)
+		}
+		if psuJson != nil {
+			return nil, fmt.Errorf("pseudonym already stored: %s", pusB64Keys[j])
+		}
+		inWitness[pusB64Keys[j]] = true
+	}
+	for _, pusB64Key := range pusB64Keys {
+		seen[pusB64Key] = true
+	}
+	return pusB64Keys, nil
+}
+
+// storePseudonyms store the pseudonyms of the public witness under their keys (checkPseudonyms),
+// with the timestamp of the transaction
+func storePseudonyms(ctx contractapi.TransactionContextInterface, in *s3cross.PublicInputs, pusB64Keys []string) error {
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	for j, pusB64Key := range pusB64Keys {
+		if err := storePseudonym(ctx, in, j, pusB64Key, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storePseudonym store the pseudonym j of the public witness under its base64 public key
+func storePseudonym(ctx contractapi.TransactionContextInterface, in *s3cross.PublicInputs, j int, pusB64Key string, now int64) error {
+	c1, c2 := in.Ciphertext(j)
+	indC1 := c1.Bytes()
+	b64C1Key := base64.StdEncoding.EncodeToString(indC1[:])
+	indC2 := c2.Bytes()
+	b64C2Key := base64.StdEncoding.EncodeToString(indC2[:])
+	r := in.Root()
+	root := r.Bytes()
+	psu := Pseudonym{
+		PublicKey: pusB64Key,
+		TimeStamp: now,
+		Used:      false,
+		Root:      base64.StdEncoding.EncodeToString(root[:]),
+		C1:        b64C1Key,
+		C2:        b64C2Key,
+	}
+
+	psuJson, err := json.Marshal(psu)
+	if err != nil {
+		return fmt.Errorf("failed to marshal psu. %v", err)
+	}
+	err = ctx.GetStub().PutState("PSU_"+pusB64Key, psuJson)
+	if err != nil {
+		return fmt.Errorf("failed to store pseudonym. %v", err)
+	}
+	return nil
+}
+
+// verifyVersionBatch verify the entries idx of the circuit version vkr, set errs[i] of the invalid ones
+func verifyVersionBatch(
+	vkr *VerifyingKeyRecord, idx []int,
+	proofStrs []string, publicWitnesses []witness.Witness, errs []error,
+) {
+	if vkr.Backend != BackendGroth16 {
+		for _, i := range idx {
+			if err := verifyCircuitProof(vkr, proofStrs[i], publicWitnesses[i]); err != nil {
+				errs[i] = fmt.Errorf("failed to verify circuit proof. %v", err)
+			}
+		}
+		return
+	}
+
+	gvk, err := base64StringToVerifyingKey(&vkr.VK)
+	if err != nil {
+		for _, i := range idx {
+			errs[i] = fmt.Errorf("failed to convert gvk string to verifying key. %v", err)
+		}
+		return
+	}
+	var batch []int
+	var proofs []groth16.Proof
+	var wits []witness.Witness
+	for _, i := range idx {
+		proof, err := base64StringToProof(&proofStrs[i])
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to convert proof string to proof. %v", err)
+			continue
+		}
+		batch = append(batch, i)
+		proofs = append(proofs, proof)
+		wits = append(wits, publicWitnesses[i])
+	}
+	for j, err := range s3cross.BatchVerifyGroth16(*gvk, proofs, wits) {
+		if err != nil {
+			errs[batch[j]] = fmt.Errorf("failed to verify circuit proof. %v", err)
+		}
+	}
+}
+
+func verifyCircuitProof(vkr *VerifyingKeyRecord, proofStr string, publicWitness witness.Witness) error {
+	switch vkr.Backend {
+	case BackendGroth16:
+		proof, err := base64StringToProof(&proofStr)
+		if err != nil {
+			return fmt.Errorf("failed to convert proof string to proof. %v", err)
+		}
+		gvk, err := base64StringToVerifyingKey(&vkr.VK)
+		if err != nil {
+			return fmt.Errorf("failed to convert gvk string to verifying key. %v", err)
+		}
+		return groth16.Verify(proof, *gvk, publicWitness)
+	case BackendPlonk:
+		proof, err := base64StringToPlonkProof(&proofStr)
+		if err != nil {
+			return fmt.Errorf("failed to convert proof string to plonk proof. %v", err)
+		}
+		pvk, err := base64StringToPlonkVerifyingKey(&vkr.VK)
+		if err != nil {
+			return fmt.Errorf("failed to convert vk string to plonk verifying key. %v", err)
+		}
+		return plonk.Verify(proof, pvk, publicWitness)
+	}
+	return fmt.Errorf("unknown backend: %s", vkr.Backend)
+}
+
+func base64StringToVerifyingKey(encoded *string) (*groth16.VerifyingKey, error) {
+	data, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(data)
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	_, err = vk.ReadFrom(buf)
+	return &vk, err
+}
+
+func base64StringToProof(encoded *string) (groth16.Proof, error) {
+	data, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(data)
+	proof := groth16.NewProof(ecc.BN254)
+	_, err = proof.ReadFrom(buf)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+func base64StringToPlonkVerifyingKey(encoded *string) (plonk.VerifyingKey, error) {
+	data, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, err
+	}
+	vk := plonk.NewVerifyingKey(ecc.BN254)
+	_, err = vk.ReadFrom(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	return vk, nil
+}
+
+func base64StringToPlonkProof(encoded *string) (plonk.Proof, error) {
+	data, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, err
+	}
+	proof := plonk.NewProof(ecc.BN254)
+	_, err = proof.ReadFrom(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// base64StringToPoint a compressed twisted Edwards point, as stored for IPK, SPK and the pseudonyms
+func base64StringToPoint(str string) (*twistededwards.PointAffine, error) {
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+	p := new(twistededwards.PointAffine)
+	if _, err = p.SetBytes(data); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// checkNonce check the Nonce of the public witness is the nonce of one of the last AcceptedEpochs epochs,
+// hashed with hashID (the hash of the circuit), so each epoch gives a user one set of pseudonyms
+func checkNonce(ctx contractapi.TransactionContextInterface, hashID string, in *s3cross.PublicInputs) error {
+	n := in.Nonce()
+	nc := n.Bytes()
+	ncStr := base64.StdEncoding.EncodeToString(nc[:])
+	current, err := getCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if current == 0 {
+		return fmt.Errorf("no nonce epoch, see AdvanceEpoch")
+	}
+	for epoch := current; epoch > 0 && current-epoch < AcceptedEpochs; epoch-- {
+		record, err := getEpoch(ctx, epoch)
+		if err != nil {
+			return err
+		}
+		if record.NonceHashes[hashID] == ncStr {
+			return nil
+		}
+	}
+	return fmt.Errorf("nonce does not match the current epoch %d", current)
+}
+
+// checkCurrentTime check the CurrentTime of an Expiry proof is the transaction timestamp, up to MaxClockSkew,
+// the circuit has checked the credential validity window against it
+func checkCurrentTime(ctx contractapi.TransactionContextInterface, in *s3cross.PublicInputs) error {
+	if !in.Expiry() {
+		return nil
+	}
+	ct := in.CurrentTime()
+	if !ct.IsUint64() || ct.Uint64() > math.MaxInt64 {
+		return fmt.Errorf("invalid current time")
+	}
+	currentTime := int64(ct.Uint64())
+	now, err := txTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	if currentTime < now-MaxClockSkew || currentTime > now+MaxClockSkew {
+		return fmt.Errorf("current time %d is more than %ds away from the transaction time %d", currentTime, MaxClockSkew, now)
+	}
+	return nil
+}
+
+// txTimestamp the transaction timestamp (seconds), identical on every endorser
+func txTimestamp(ctx contractapi.TransactionContextInterface) (int64, error) {
+	ts, err := ctx.GetStub().GetTxTimestamp()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get transaction timestamp. %v", err)
+	}
+	return ts.GetSeconds(), nil
+}
+
+// base64StringToWitness a public witness of nbPublic inputs, its encoding is the numbers of public and secret inputs,
+// the vector length (uint32 each) and the vector, the size is checked before the vector is allocated
+func base64StringToWitness(str *string, nbPublic int) (witness.Witness, error) {
 	data, err := base64.StdEncoding.DecodeString(*str)
 	if err != nil {
 		return nil, err
 	}
+	if len(data) != 12+fr.Bytes*nbPublic {
+		return nil, fmt.Errorf("public witness of %d bytes, the %d public inputs are %d bytes", len(data), nbPublic, 12+fr.Bytes*nbPublic)
+	}
+	if binary.BigEndian.Uint32(data[0:4]) != uint32(nbPublic) || binary.BigEndian.Uint32(data[4:8]) != 0 ||
+		binary.BigEndian.Uint32(data[8:12]) != uint32(nbPublic) {
+		return nil, fmt.Errorf("not a public witness of %d public inputs", nbPublic)
+	}
 	buf := bytes.NewBuffer(data)
 	wit, err := frontend.NewWitness(nil, ecc.BN254.ScalarField())
 	if err != nil {
@@ -1,48 +1,709 @@
 package chaincode_test
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/constraint"
 	_ "github.com/hyperledger/fabric-chaincode-go/v2/shim"
 	_ "github.com/hyperledger/fabric-contract-api-go/v2/contractapi"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"s3cross-zksnarks/chaincode-go/chaincode"
 	"s3cross-zksnarks/chaincode-go/chaincode/mocks"
+	s3cross "zkMemMerkle/S3CrossMiMC"
 )
 
 func TestInitLedger(t *testing.T) {
-	chaincodeStub := &mocks.FakeChaincodeStubInterface{}
-	transactionContext := &mocks.FakeTransactionContextInterface{}
-	transactionContext.GetStubReturns(chaincodeStub)
+	l := newTestLedger()
 
-	//ipkStr, spkStr, rootStr, gvkStr
+	//ipkStr, spkStr, rootStr, gvkStr, lpkStr
 	ipkStr := "57db16be194b3619334f40648edcb9fc6639f7d7ed4fc64e946e34d063354aac"
 	spkStr := "ebce1ff751e1d520021fafc0a268c61ad0fe00133f17bed5a6a2c78a82451e0f"
 	rootStr := "2a638ffc1281dbd26549352aa39ab398441c73b053e6ad2b58696f6e0ff74f29"
 	gvkStr := "e38078ab78d1fd6b2e7a8ef147143f6753631b85c17a2b7f8409270c9d39c2fcaac300077344523c35d7dc390cc8ca1530ddadba2b5a19413f4ac934fbc5a686a779775dd6a0e5e4bdf63d8470ca2d39e2b1153368163df9fb9398bdad0a8c48251b93185b0ad52328193eb76532e5e3e0e97c60fc9bce314665bfd5b754635f818b2d29895aab71fac03e61d5bb509cd80f9ba9c2aec440448e33a0386de1a60593092175d9ff46eba9e416b5ccdd00d55df277f0c7e33f1f86ecacbc637124ad1824170ba0c501eb1faa45af1c147207f0f1d70a35ad704d8cb46337b954bc9eb3afe6553d8b94f1f53458ee3b083f40914d75b7baaec53db9fd9438961b4a226d28a41189806b6e0fd77d2811678473151c54a9bbc59d725655887573d80100000010d4daab6321a501776a4d8b60afaaf84df7b7fc205ddb7ff174dcfbd3604de953e81ad1b99f502291955339c04c15a859b622f347aee4ea4d42ae3269b39575ddad1f6129eb216811a1834ad84323a1a41a7d2a4b66d1aa1c32c1e2e6e5e1c0c0ded66ba58f6174fe0a2ba49363e4fa21e3ff7ab956452ff0d4ca4a7b7b666930cc8d2ed189b2e4fd7d77cfbc2f58bdb07525b3f03f303136a48a820d350c51ff8885a8ef88df305088777f7dc135caaa7e5ef85f2a0ec795c0c3f48644214893868338f25b4663a7e939a322b37599cbd6844147fdee43305862f5884b373cbee3df633fdfd2c4584bba1cac6eefabae802b401e3043354506a63416688f703aa579527c5139207796121f967918b928746bba89b439ade96c094586be95f868e396554cddda46e5a667ec28f7bea79327e35c51aba8b027f6b7aa62539174db9956fe3d2ee8fac975b5149d2117b4a449826ba29adeaee9d1c82441433422c4ecc38e86a05e52755d6b61f75ab868afce96683e794ba6785d8e57330c1294b1974c55ccd09901c501d5f9bc90f62bc209f0d2bdd8b8d0ac343f9678dc48c489a8f850eb8362b229b82ec80e67745c08a7cb7ffdb84f7cf8b36bd787e71433189b9b4c3e1740e2ab3655fb8d69bf2050944fb97210e263bfd9d34a13da85b565e096ed0e4ca97c170b9f89f7987578b6034a4b0c15cf56568f787e9ca847f63c0000000100000000000000019bfa7469f1c6973950ec83b7f20ee034ec7fba36703cf39d8fd01506775f8b742b043c5563dbd078ebc60adb7594c9f10ca70a35d743ec7eeafc3d29e138ea14c3ad18b0ba73b60bc60277b02cfc05d626e7e7c502d899aefee5d6f87d47e4fe232db1ab3741add7815f9debacfffc1ac2242f17065f75d063f99c6d6713976b"
 
+	lpkStr := pointToBase64(newKeyPair(t).Pk)
+
 	psuManager := chaincode.SmartContract{}
-	err := psuManager.InitLedger(transactionContext, ipkStr, spkStr, rootStr, gvkStr)
+	// not an admin
+	require.Error(t, l.commit(psuManager.InitLedger(l.ctx, ipkStr, spkStr, rootStr, gvkStr, lpkStr)))
+	l.as(admin)
+	require.Error(t, l.commit(psuManager.InitLedger(l.ctx, ipkStr, spkStr, rootStr, gvkStr, "not a key")))
+	require.NoError(t, l.commit(psuManager.InitLedger(l.ctx, ipkStr, spkStr, rootStr, gvkStr, lpkStr)))
+
+	// a second InitLedger does not replace the keys nor the ROOT of UpdateRoot
+	otherLpkStr := pointToBase64(newKeyPair(t).Pk)
+	require.Error(t, l.commit(psuManager.InitLedger(l.ctx, ipkStr, spkStr, rootStr, gvkStr, otherLpkStr)))
+	var stored string
+	require.NoError(t, json.Unmarshal(l.state["LPK"], &stored))
+	require.Equal(t, lpkStr, stored)
+
+}
+
+// testLedger a world state kept in memory behind the counterfeiter stub, the writes of a transaction
+// are read by the next transactions once it is committed (the peer does not read the writes of a transaction back)
+type testLedger struct {
+	state   map[string][]byte
+	pending map[string][]byte
+	stub    *mocks.FakeChaincodeStubInterface
+	ctx     *mocks.FakeTransactionContextInterface
+}
+
+func newTestLedger() *testLedger {
+	l := &testLedger{
+		state:   make(map[string][]byte),
+		pending: make(map[string][]byte),
+		stub:    &mocks.FakeChaincodeStubInterface{},
+		ctx:     &mocks.FakeTransactionContextInterface{},
+	}
+	l.stub.GetStateCalls(func(key string) ([]byte, error) {
+		return l.state[key], nil
+	})
+	l.stub.PutStateCalls(func(key string, value []byte) error {
+		l.pending[key] = value
+		return nil
+	})
+	l.ctx.GetStubReturns(l.stub)
+	l.ctx.GetClientIdentityReturns(&testIdentity{})
+	l.at(1700000000, "tx0")
+	return l
+}
+
+// at the timestamp and id of the next transaction
+func (l *testLedger) at(seconds int64, txID string) {
+	l.stub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: seconds}, nil)
+	l.stub.GetTxIDReturns(txID)
+}
+
+// as the client of the next transaction, with the certificate attributes
+func (l *testLedger) as(attrs map[string]string) {
+	l.ctx.GetClientIdentityReturns(&testIdentity{attrs: attrs})
+}
+
+// commit the writes of the transaction that returned err, a failed transaction leaves the world state as it was
+func (l *testLedger) commit(err error) error {
+	if err == nil {
+		for key, value := range l.pending {
+			l.state[key] = value
+		}
+	}
+	l.pending = make(map[string][]byte)
+	return err
+}
+
+// testIdentity a client identity with its certificate attributes
+type testIdentity struct {
+	attrs map[string]string
+}
+
+func (id *testIdentity) GetID() (string, error)    { return "client", nil }
+func (id *testIdentity) GetMSPID() (string, error) { return "Org1MSP", nil }
+
+func (id *testIdentity) GetAttributeValue(attrName string) (string, bool, error) {
+	v, ok := id.attrs[attrName]
+	return v, ok, nil
+}
+
+func (id *testIdentity) AssertAttributeValue(attrName, attrValue string) error {
+	if v, ok := id.attrs[attrName]; !ok || v != attrValue {
+		return fmt.Errorf("attribute %s is not %s", attrName, attrValue)
+	}
+	return nil
+}
+
+func (id *testIdentity) GetX509Certificate() (*x509.Certificate, error) { return nil, nil }
+
+var epochAdmin = map[string]string{chaincode.EpochAdminAttribute: "true"}
+
+// testCircuit the groth16 keys of a small circuit, set up once per config for the tests of the package
+type testCircuit struct {
+	cfg s3cross.Config
+	ccs constraint.ConstraintSystem
+	pk  s3cross.ProvingKey
+	vk  string // base64
+}
+
+var testCircuits = struct {
+	sync.Mutex
+	byConfig map[s3cross.Config]*testCircuit
+}{byConfig: make(map[s3cross.Config]*testCircuit)}
+
+func circuitOf(t *testing.T, cfg s3cross.Config) *testCircuit {
+	testCircuits.Lock()
+	defer testCircuits.Unlock()
+	if c, ok := testCircuits.byConfig[cfg]; ok {
+		return c
+	}
+	circuit, err := s3cross.NewCircuit(cfg)
+	require.NoError(t, err)
+	ccs, err := s3cross.BackendGroth16.Compile(circuit)
+	require.NoError(t, err)
+	pk, vk, err := s3cross.BackendGroth16.Setup(ccs, nil, nil)
+	require.NoError(t, err)
+	c := &testCircuit{cfg: cfg, ccs: ccs, pk: pk, vk: toBase64(t, vk)}
+	testCircuits.byConfig[cfg] = c
+	return c
+}
+
+var testConfig = s3cross.Config{Depth: 4, IndexBits: 4, Hash: s3cross.HashMiMC}
+
+// testParties the issuer, the supervisor with its ElGamal key and its log key, and a user with a credential
+// of the issuer, the revocation tree has only its sentinels
+type testParties struct {
+	cfg        s3cross.Config
+	issuer     *s3cross.KeyPair
+	supervisor *s3cross.KeyPair
+	logKey     *s3cross.KeyPair
+	user       *s3cross.S3Cross
+	leaves     []*big.Int
+}
+
+func newTestParties(t *testing.T, cfg s3cross.Config) *testParties {
+	issuer, supervisor, user := newKeyPair(t), newKeyPair(t), newKeyPair(t)
+	sig, _, err := issuer.SignWith(cfg.Hash, user.Pk)
+	require.NoError(t, err)
+	leaves, err := s3cross.RevocationLeaves(nil)
+	require.NoError(t, err)
+	return &testParties{
+		cfg:        cfg,
+		issuer:     issuer,
+		supervisor: supervisor,
+		logKey:     newKeyPair(t),
+		user:       &s3cross.S3Cross{KeyPair: user, Signature: sig},
+		leaves:     leaves,
+	}
+}
+
+func newKeyPair(t *testing.T) *s3cross.KeyPair {
+	curve := twistededwards.GetEdwardsCurve()
+	sk, err := rand.Int(rand.Reader, &curve.Order)
 	require.NoError(t, err)
+	return &s3cross.KeyPair{Sk: sk, Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk)}
+}
+
+// initLedger InitLedger with the keys of the parties, the revocation root of their tree and the key of the circuit,
+// the circuit config replaces s3cross.DefaultConfig of InitLedger
+func (p *testParties) initLedger(t *testing.T, l *testLedger, psuManager *chaincode.SmartContract) {
+	mp, _, err := p.user.GenNonMemProofDepth(p.leaves, p.cfg.Depth)
+	require.NoError(t, err)
+	vk := circuitOf(t, p.cfg).vk
+	l.as(admin)
+	defer l.as(nil)
+	err = psuManager.InitLedger(l.ctx, pointToBase64(p.issuer.Pk), pointToBase64(p.supervisor.Pk),
+		base64.StdEncoding.EncodeToString(mp.Root), vk, pointToBase64(p.logKey.Pk))
+	require.NoError(t, l.commit(err))
+	require.NoError(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16, configJson(t, p.cfg), vk)))
+}
+
+func configJson(t *testing.T, cfg s3cross.Config) string {
+	data, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// advanceEpoch open the next nonce epoch as an epoch admin
+func advanceEpoch(t *testing.T, l *testLedger, psuManager *chaincode.SmartContract) *chaincode.EpochRecord {
+	l.as(epochAdmin)
+	defer l.as(nil)
+	record, err := psuManager.AdvanceEpoch(l.ctx)
+	require.NoError(t, l.commit(err))
+	return record
+}
+
+// prove the proof and the public witness strings of the pseudonym i of the user for the nonce of the epoch
+func (p *testParties) prove(t *testing.T, record *chaincode.EpochRecord, i int64) (string, string, *s3cross.PseudonymWitness) {
+	nonce, err := base64StringToPoint(record.Nonce)
+	require.NoError(t, err)
+	pw, err := p.user.NewPseudonymWitness(p.cfg, p.leaves, nonce, big.NewInt(i), p.supervisor.Pk)
+	require.NoError(t, err)
+	c := circuitOf(t, p.cfg)
+	proof, err := s3cross.BackendGroth16.Prove(c.ccs, c.pk, pw.Witness)
+	require.NoError(t, err)
+	return toBase64(t, proof), toBase64(t, pw.PublicWitness), pw
+}
+
+func toBase64(t *testing.T, w io.WriterTo) string {
+	var buf bytes.Buffer
+	_, err := w.WriteTo(&buf)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
 
+func pointToBase64(p *twistededwards.PointAffine) string {
+	b := p.Bytes()
+	return base64.StdEncoding.EncodeToString(b[:])
+}
+
+func base64StringToPoint(str string) (*twistededwards.PointAffine, error) {
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+	p := new(twistededwards.PointAffine)
+	if _, err = p.SetBytes(data); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 func TestCreatePseudonym(t *testing.T) {
-	chaincodeStub := &mocks.FakeChaincodeStubInterface{}
-	transactionContext := &mocks.FakeTransactionContextInterface{}
-	transactionContext.GetStubReturns(chaincodeStub)
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	record := advanceEpoch(t, l, &psuManager)
 
-	ipkStr := "57db16be194b3619334f40648edcb9fc6639f7d7ed4fc64e946e34d063354aac"
-	spkStr := "ebce1ff751e1d520021fafc0a268c61ad0fe00133f17bed5a6a2c78a82451e0f"
-	rootStr := "2a638ffc1281dbd26549352aa39ab398441c73b053e6ad2b58696f6e0ff74f29"
-	gvkStr := "e38078ab78d1fd6b2e7a8ef147143f6753631b85c17a2b7f8409270c9d39c2fcaac300077344523c35d7dc390cc8ca1530ddadba2b5a19413f4ac934fbc5a686a779775dd6a0e5e4bdf63d8470ca2d39e2b1153368163df9fb9398bdad0a8c48251b93185b0ad52328193eb76532e5e3e0e97c60fc9bce314665bfd5b754635f818b2d29895aab71fac03e61d5bb509cd80f9ba9c2aec440448e33a0386de1a60593092175d9ff46eba9e416b5ccdd00d55df277f0c7e33f1f86ecacbc637124ad1824170ba0c501eb1faa45af1c147207f0f1d70a35ad704d8cb46337b954bc9eb3afe6553d8b94f1f53458ee3b083f40914d75b7baaec53db9fd9438961b4a226d28a41189806b6e0fd77d2811678473151c54a9bbc59d725655887573d80100000010d4daab6321a501776a4d8b60afaaf84df7b7fc205ddb7ff174dcfbd3604de953e81ad1b99f502291955339c04c15a859b622f347aee4ea4d42ae3269b39575ddad1f6129eb216811a1834ad84323a1a41a7d2a4b66d1aa1c32c1e2e6e5e1c0c0ded66ba58f6174fe0a2ba49363e4fa21e3ff7ab956452ff0d4ca4a7b7b666930cc8d2ed189b2e4fd7d77cfbc2f58bdb07525b3f03f303136a48a820d350c51ff8885a8ef88df305088777f7dc135caaa7e5ef85f2a0ec795c0c3f48644214893868338f25b4663a7e939a322b37599cbd6844147fdee43305862f5884b373cbee3df633fdfd2c4584bba1cac6eefabae802b401e3043354506a63416688f703aa579527c5139207796121f967918b928746bba89b439ade96c094586be95f868e396554cddda46e5a667ec28f7bea79327e35c51aba8b027f6b7aa62539174db9956fe3d2ee8fac975b5149d2117b4a449826ba29adeaee9d1c82441433422c4ecc38e86a05e52755d6b61f75ab868afce96683e794ba6785d8e57330c1294b1974c55ccd09901c501d5f9bc90f62bc209f0d2bdd8b8d0ac343f9678dc48c489a8f850eb8362b229b82ec80e67745c08a7cb7ffdb84f7cf8b36bd787e71433189b9b4c3e1740e2ab3655fb8d69bf2050944fb97210e263bfd9d34a13da85b565e096ed0e4ca97c170b9f89f7987578b6034a4b0c15cf56568f787e9ca847f63c0000000100000000000000019bfa7469f1c6973950ec83b7f20ee034ec7fba36703cf39d8fd01506775f8b742b043c5563dbd078ebc60adb7594c9f10ca70a35d743ec7eeafc3d29e138ea14c3ad18b0ba73b60bc60277b02cfc05d626e7e7c502d899aefee5d6f87d47e4fe232db1ab3741add7815f9debacfffc1ac2242f17065f75d063f99c6d6713976b"
+	proofStr, witnessStr, pw := p.prove(t, record, 1)
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proofStr, witnessStr)))
+	psu, err := psuManager.QueryPseudonymByPBK(l.ctx, pointToBase64(pw.Pseudonym.Pk))
+	require.NoError(t, err)
+	require.False(t, psu.Used)
+	require.Equal(t, pointToBase64(pw.Ciphertext.C1), psu.C1)
+
+	// a public witness of another size is rejected before its vector is read
+	data, err := base64.StdEncoding.DecodeString(witnessStr)
+	require.NoError(t, err)
+	huge := append([]byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}, data[12:]...)
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, proofStr, base64.StdEncoding.EncodeToString(huge)))
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, proofStr, base64.StdEncoding.EncodeToString(data[:len(data)-32])))
+	// the old hex fixtures
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, proofStr, "0000000e000000000000000e2a638ffc1281dbd26549352aa39ab398441c73b0"))
+
+	// a proof of another statement
+	otherProof, _, _ := p.prove(t, record, 2)
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, otherProof, witnessStr))
+}
+
+var admin = map[string]string{chaincode.AdminAttribute: "true"}
 
+func TestUpdateVerifyingKey(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
 	psuManager := chaincode.SmartContract{}
-	err := psuManager.InitLedger(transactionContext, ipkStr, spkStr, rootStr, gvkStr)
+	p.initLedger(t, l, &psuManager)
+	vk := circuitOf(t, testConfig).vk
+	cfg := configJson(t, testConfig)
+	multiVK := circuitOf(t, testMultiConfig).vk
+
+	// not an admin, the epoch admins are not either
+	require.Error(t, l.commit(psuManager.UpdateGVK(l.ctx, vk)))
+	require.Error(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16, cfg, vk)))
+	l.as(epochAdmin)
+	require.Error(t, l.commit(psuManager.UpdateGVK(l.ctx, vk)))
+
+	l.as(admin)
+	require.NoError(t, l.commit(psuManager.UpdateGVK(l.ctx, vk)))
+	require.NoError(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16, cfg, vk)))
+	require.Error(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendPlonk, cfg, vk)))
+	require.Error(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16,
+		`{"depth":4,"indexBits":4,"hash":"sha256"}`, vk)))
+	require.Error(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16, `{"depth":0}`, vk)))
+	require.Error(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16, cfg, "not a key")))
+	// the key of the multi pseudonym circuit has more public inputs than the circuit of its config
+	require.Error(t, l.commit(psuManager.UpdateGVK(l.ctx, multiVK)))
+	require.Error(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16, cfg, multiVK)))
+	require.NoError(t, l.commit(psuManager.UpdateVerifyingKey(l.ctx, chaincode.BackendGroth16,
+		configJson(t, testMultiConfig), multiVK)))
+}
+
+var testMultiConfig = s3cross.Config{Depth: 4, IndexBits: 4, Hash: s3cross.HashMiMC, Pseudonyms: 2}
+
+func TestRegisterCircuitVersion(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	record := advanceEpoch(t, l, &psuManager)
+	vk := circuitOf(t, testConfig).vk
+	cfg := configJson(t, testConfig)
+	multiVK := circuitOf(t, testMultiConfig).vk
+	multiCfg := configJson(t, testMultiConfig)
+
+	// not an admin
+	require.Error(t, l.commit(psuManager.RegisterCircuitVersion(l.ctx, "v1", chaincode.BackendGroth16, cfg, vk)))
+	require.Error(t, l.commit(psuManager.RegisterMultiCircuitVersion(l.ctx, "m1", chaincode.BackendGroth16, multiCfg, multiVK)))
+	require.Error(t, l.commit(psuManager.RegisterHiddenIssuerCircuitVersion(l.ctx, "h1", chaincode.BackendGroth16, cfg, vk)))
+	vkr, err := json.Marshal(&chaincode.VerifyingKeyRecord{Version: "v1", Backend: chaincode.BackendGroth16, Config: testConfig, VK: vk})
 	require.NoError(t, err)
+	require.Error(t, l.commit(psuManager.RegisterCircuitVersionRecord(l.ctx, string(vkr))))
+	l.as(epochAdmin)
+	require.Error(t, l.commit(psuManager.RegisterCircuitVersion(l.ctx, "v1", chaincode.BackendGroth16, cfg, vk)))
+
+	l.as(admin)
+	require.NoError(t, l.commit(psuManager.RegisterCircuitVersionRecord(l.ctx, string(vkr))))
+	require.NoError(t, l.commit(psuManager.RegisterMultiCircuitVersion(l.ctx, "m1", chaincode.BackendGroth16, multiCfg, multiVK)))
+	// a registered version is not replaced
+	require.Error(t, l.commit(psuManager.RegisterCircuitVersion(l.ctx, "v1", chaincode.BackendGroth16, cfg, vk)))
+	require.Error(t, l.commit(psuManager.RegisterMultiCircuitVersion(l.ctx, "m1", chaincode.BackendGroth16, multiCfg, multiVK)))
+	require.Error(t, l.commit(psuManager.RegisterCircuitVersion(l.ctx, "", chaincode.BackendGroth16, cfg, vk)))
+	// the key does not match the circuit of the config, or the config is not of the transaction
+	require.Error(t, l.commit(psuManager.RegisterCircuitVersion(l.ctx, "v2", chaincode.BackendGroth16, multiCfg, vk)))
+	require.Error(t, l.commit(psuManager.RegisterMultiCircuitVersion(l.ctx, "m2", chaincode.BackendGroth16, cfg, vk)))
+	require.Error(t, l.commit(psuManager.RegisterHiddenIssuerCircuitVersion(l.ctx, "h1", chaincode.BackendGroth16, cfg, vk)))
+
+	// a proof tagged with a registered version, or an unknown one
+	proofStr, witnessStr, _ := p.prove(t, record, 1)
+	versioned := func(version string) string {
+		data, err := json.Marshal(&chaincode.VersionedProof{Version: version, Proof: proofStr})
+		require.NoError(t, err)
+		return string(data)
+	}
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, versioned("v2"), witnessStr))
+	require.Error(t, psuManager.CreatePseudonym(l.ctx, versioned("m1"), witnessStr))
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, versioned("v1"), witnessStr)))
+}
+
+func TestCreatePseudonymOnce(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	record := advanceEpoch(t, l, &psuManager)
+
+	proofStr, witnessStr, pw := p.prove(t, record, 1)
+	l.at(1700000100, "tx1")
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proofStr, witnessStr)))
+	pbk := pointToBase64(pw.Pseudonym.Pk)
+	psu, err := psuManager.QueryPseudonymByPBK(l.ctx, pbk)
+	require.NoError(t, err)
+	// the pseudonym expires 7200s after the timestamp of its transaction
+	require.Equal(t, int64(1700000100), psu.TimeStamp)
+	l.at(1700007299, "tx2")
+	valid, err := psuManager.IsPseudonymValid(l.ctx, pbk)
+	require.NoError(t, err)
+	require.True(t, valid)
+	l.at(1700007300, "tx3")
+	valid, err = psuManager.IsPseudonymValid(l.ctx, pbk)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// the resubmitted proof does not reset the pseudonym
+	l.at(1700007300, "tx4")
+	require.Error(t, l.commit(psuManager.CreatePseudonym(l.ctx, proofStr, witnessStr)))
+	psu, err = psuManager.QueryPseudonymByPBK(l.ctx, pbk)
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000100), psu.TimeStamp)
+	result, err := psuManager.CreatePseudonymsBatch(l.ctx, []string{proofStr}, []string{witnessStr})
+	require.NoError(t, l.commit(err))
+	require.Empty(t, result.Stored)
+	require.Len(t, result.Rejected, 1)
+}
+
+func TestCreatePseudonymsBatch(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	record := advanceEpoch(t, l, &psuManager)
+
+	proof1, witness1, pw1 := p.prove(t, record, 1)
+	proof2, witness2, pw2 := p.prove(t, record, 2)
+	// the entry 1 repeats the entry 0, the entry 2 has the proof of another statement
+	result, err := psuManager.CreatePseudonymsBatch(l.ctx,
+		[]string{proof1, proof1, proof1, proof2, "not a proof"},
+		[]string{witness1, witness1, witness2, witness2, witness2})
+	require.NoError(t, l.commit(err))
+	require.Equal(t, []string{pointToBase64(pw1.Pseudonym.Pk), pointToBase64(pw2.Pseudonym.Pk)}, result.Stored)
+	var rejected []int
+	for _, r := range result.Rejected {
+		rejected = append(rejected, r.Index)
+	}
+	require.Equal(t, []int{1, 2, 4}, rejected)
+	for _, pw := range []*s3cross.PseudonymWitness{pw1, pw2} {
+		_, err := psuManager.QueryPseudonymByPBK(l.ctx, pointToBase64(pw.Pseudonym.Pk))
+		require.NoError(t, err)
+	}
+
+	_, err = psuManager.CreatePseudonymsBatch(l.ctx, []string{proof1}, nil)
+	require.Error(t, err)
+}
+
+func TestUpdateIssuerRoot(t *testing.T) {
+	l := newTestLedger()
+	psuManager := chaincode.SmartContract{}
+	issuers, err := s3cross.NewIssuerSet(s3cross.HashMiMC, 2, []*twistededwards.PointAffine{newKeyPair(t).Pk, newKeyPair(t).Pk})
+	require.NoError(t, err)
+	rootStr := base64.StdEncoding.EncodeToString(issuers.Root())
+
+	// not an admin, the epoch admins are not either
+	require.Error(t, l.commit(psuManager.UpdateIssuerRoot(l.ctx, rootStr)))
+	l.as(epochAdmin)
+	require.Error(t, l.commit(psuManager.UpdateIssuerRoot(l.ctx, rootStr)))
+	require.Nil(t, l.state["ISSUER_ROOT"])
+
+	l.as(admin)
+	require.Error(t, l.commit(psuManager.UpdateIssuerRoot(l.ctx, "not a root")))
+	require.Error(t, l.commit(psuManager.UpdateIssuerRoot(l.ctx, base64.StdEncoding.EncodeToString(make([]byte, 31)))))
+	require.NoError(t, l.commit(psuManager.UpdateIssuerRoot(l.ctx, rootStr)))
+	var stored string
+	require.NoError(t, json.Unmarshal(l.state["ISSUER_ROOT"], &stored))
+	require.Equal(t, rootStr, stored)
+}
+
+// testLog the revocation log of the supervisor and its tree, the heads are signed with the log key
+type testLog struct {
+	log     *s3cross.RevocationLog
+	tree    *s3cross.OrderedMerkleTree
+	applied uint64
+}
+
+func newTestLog(t *testing.T, cfg s3cross.Config) *testLog {
+	store := s3cross.NewMemoryStore()
+	log, err := s3cross.NewRevocationLog(store)
+	require.NoError(t, err)
+	tree, err := s3cross.NewOrderedMerkleTree(cfg.Hash, cfg.Depth, store)
+	require.NoError(t, err)
+	return &testLog{log: log, tree: tree}
+}
+
+// revoke append the revocation of a random value
+func (l *testLog) revoke(t *testing.T) {
+	v, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	require.NoError(t, l.log.Append(s3cross.RevocationEntry{Value: v.Add(v, big.NewInt(1))}))
+}
+
+// head the tree head json of the epoch signed with sk, and the consistency proof json from the log size oldSize
+func (l *testLog) head(t *testing.T, sk *big.Int, epoch, oldSize uint64) (string, string) {
+	entries, err := l.log.Entries(l.applied, l.log.Size())
+	require.NoError(t, err)
+	require.NoError(t, s3cross.ApplyRevocations(l.tree, entries))
+	l.applied = l.log.Size()
+	root, err := l.tree.Root()
+	require.NoError(t, err)
+	sth, err := s3cross.SignTreeHead(sk, l.log.TreeHead(epoch, root))
+	require.NoError(t, err)
+	sthJson, err := json.Marshal(sth)
+	require.NoError(t, err)
+	proof, err := l.log.ConsistencyProof(oldSize)
+	require.NoError(t, err)
+	proofJson, err := json.Marshal(proof)
+	require.NoError(t, err)
+	return string(sthJson), string(proofJson)
+}
+
+func TestUpdateRoot(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	_, err := psuManager.GetTreeHead(l.ctx)
+	require.Error(t, err)
+
+	log := newTestLog(t, testConfig)
+	log.revoke(t)
+	// the supervisor ElGamal key does not sign the heads, the next epoch is 1
+	sthJson, proofJson := log.head(t, p.supervisor.Sk, 1, 0)
+	require.Error(t, l.commit(psuManager.UpdateRoot(l.ctx, sthJson, proofJson)))
+	sthJson, proofJson = log.head(t, p.logKey.Sk, 2, 0)
+	require.Error(t, l.commit(psuManager.UpdateRoot(l.ctx, sthJson, proofJson)))
+	require.Error(t, l.commit(psuManager.UpdateRoot(l.ctx, "not a head", "")))
+
+	head1, proofJson := log.head(t, p.logKey.Sk, 1, 0)
+	l.at(1700000100, "tx1")
+	require.NoError(t, l.commit(psuManager.UpdateRoot(l.ctx, head1, proofJson)))
+	// a head is published once
+	require.Error(t, l.commit(psuManager.UpdateRoot(l.ctx, head1, proofJson)))
+	sth, err := psuManager.GetTreeHead(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sth.Epoch)
+	roots, err := psuManager.GetAcceptedRoots(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(sth.Root), roots[0].Root)
+	require.Equal(t, uint64(1), roots[0].Epoch)
+
+	// a log of other entries does not extend the published one
+	other := newTestLog(t, testConfig)
+	other.revoke(t)
+	other.revoke(t)
+	sthJson, proofJson = other.head(t, p.logKey.Sk, 2, 1)
+	require.Error(t, l.commit(psuManager.UpdateRoot(l.ctx, sthJson, proofJson)))
+
+	log.revoke(t)
+	head2, proofJson := log.head(t, p.logKey.Sk, 2, 1)
+	require.Error(t, l.commit(psuManager.UpdateRoot(l.ctx, head2, "")))
+	require.NoError(t, l.commit(psuManager.UpdateRoot(l.ctx, head2, proofJson)))
+	sth, err = psuManager.QueryTreeHead(l.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sth.Epoch)
+	sth, err = psuManager.GetTreeHead(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sth.Epoch)
+	_, err = psuManager.QueryTreeHead(l.ctx, 3)
+	require.Error(t, err)
+}
+
+func TestRootPolicy(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	record := advanceEpoch(t, l, &psuManager)
+
+	// not an admin, the epoch admins are not either
+	require.Error(t, l.commit(psuManager.SetRootPolicy(l.ctx, 2, 100)))
+	l.as(epochAdmin)
+	require.Error(t, l.commit(psuManager.SetRootPolicy(l.ctx, 2, 100)))
+	policy, err := psuManager.GetRootPolicy(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, &chaincode.RootPolicy{Window: chaincode.DefaultRootWindow, GracePeriod: chaincode.DefaultRootGracePeriod}, policy)
+
+	l.as(admin)
+	require.Error(t, l.commit(psuManager.SetRootPolicy(l.ctx, 0, 100)))
+	require.Error(t, l.commit(psuManager.SetRootPolicy(l.ctx, chaincode.MaxRootWindow+1, 100)))
+	require.Error(t, l.commit(psuManager.SetRootPolicy(l.ctx, 2, -1)))
+	require.Error(t, l.commit(psuManager.SetRootPolicy(l.ctx, 2, chaincode.MaxRootGracePeriod+1)))
+	require.NoError(t, l.commit(psuManager.SetRootPolicy(l.ctx, 2, 100)))
+	l.as(nil)
+	policy, err = psuManager.GetRootPolicy(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, &chaincode.RootPolicy{Window: 2, GracePeriod: 100}, policy)
+
+	// the proofs of the root of InitLedger, after it is replaced
+	proof1, witness1, _ := p.prove(t, record, 1)
+	proof2, witness2, _ := p.prove(t, record, 2)
+	log := newTestLog(t, testConfig)
+	log.revoke(t)
+	head1, proofJson := log.head(t, p.logKey.Sk, 1, 0)
+	l.at(1700001000, "tx1")
+	require.NoError(t, l.commit(psuManager.UpdateRoot(l.ctx, head1, proofJson)))
+
+	// in the grace period, then after it
+	l.at(1700001100, "tx2")
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof1, witness1)))
+	l.at(1700001101, "tx3")
+	require.Error(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof2, witness2)))
+	l.as(admin)
+	require.NoError(t, l.commit(psuManager.SetRootPolicy(l.ctx, 2, chaincode.MaxRootGracePeriod)))
+	l.as(nil)
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof2, witness2)))
+
+	// out of the window of the last 2 roots
+	proof3, witness3, _ := p.prove(t, record, 3)
+	log.revoke(t)
+	head2, proofJson := log.head(t, p.logKey.Sk, 2, 1)
+	l.at(1700001200, "tx4")
+	require.NoError(t, l.commit(psuManager.UpdateRoot(l.ctx, head2, proofJson)))
+	require.Error(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof3, witness3)))
+	roots, err := psuManager.GetAcceptedRoots(l.ctx)
+	require.NoError(t, err)
+	require.Len(t, roots, 2)
+}
+
+func TestAdvanceEpoch(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+
+	// not an epoch admin, the admins are not either
+	_, err := psuManager.AdvanceEpoch(l.ctx)
+	require.Error(t, l.commit(err))
+	l.as(admin)
+	_, err = psuManager.AdvanceEpoch(l.ctx)
+	require.Error(t, l.commit(err))
+	l.as(nil)
+
+	// the nonce is derived from the transaction id, the same on each endorser
+	l.at(1700000100, "tx1")
+	record1 := advanceEpoch(t, l, &psuManager)
+	require.Equal(t, uint64(1), record1.Epoch)
+	require.Equal(t, "tx1", record1.Seed)
+	other := newTestLedger()
+	p.initLedger(t, other, &psuManager)
+	other.at(1700000100, "tx1")
+	require.Equal(t, record1.Nonce, advanceEpoch(t, other, &psuManager).Nonce)
+	current, err := psuManager.GetCurrentNonce(l.ctx)
+	require.NoError(t, err)
+	require.Equal(t, record1, current)
+
+	// the proofs of the current epoch and the previous one land
+	proof1, witness1, _ := p.prove(t, record1, 1)
+	proof2, witness2, _ := p.prove(t, record1, 2)
+	// an epoch lasts EpochSeconds
+	l.as(epochAdmin)
+	l.at(1700000100+chaincode.EpochSeconds-1, "tx2")
+	_, err = psuManager.AdvanceEpoch(l.ctx)
+	require.Error(t, l.commit(err))
+	l.as(nil)
+	l.at(1700000100+chaincode.EpochSeconds, "tx2")
+	record2 := advanceEpoch(t, l, &psuManager)
+	require.Equal(t, uint64(2), record2.Epoch)
+	require.NotEqual(t, record1.Nonce, record2.Nonce)
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof1, witness1)))
+	proof3, witness3, _ := p.prove(t, record2, 3)
+
+	// an older one does not
+	l.at(1700000100+2*chaincode.EpochSeconds, "tx3")
+	advanceEpoch(t, l, &psuManager)
+	require.Error(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof2, witness2)))
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proof3, witness3)))
+}
+
+func TestRecordTrace(t *testing.T) {
+	l := newTestLedger()
+	p := newTestParties(t, testConfig)
+	psuManager := chaincode.SmartContract{}
+	p.initLedger(t, l, &psuManager)
+	record := advanceEpoch(t, l, &psuManager)
+
+	proofStr, witnessStr, pw := p.prove(t, record, 1)
+	require.NoError(t, l.commit(psuManager.CreatePseudonym(l.ctx, proofStr, witnessStr)))
+	pbk := pointToBase64(pw.Pseudonym.Pk)
+	upk, proof, err := p.supervisor.DecryptWithProof(pw.Ciphertext)
+	require.NoError(t, err)
+	require.True(t, upk.Equal(p.user.Pk))
+	proofJson, err := json.Marshal(proof)
+	require.NoError(t, err)
+	upkStr := pointToBase64(upk)
+
+	// a proof of another opening, of another key, or no proof
+	require.Error(t, l.commit(psuManager.RecordTrace(l.ctx, pbk, pointToBase64(p.issuer.Pk), string(proofJson))))
+	_, forged, err := newKeyPair(t).DecryptWithProof(pw.Ciphertext)
+	require.NoError(t, err)
+	forgedJson, err := json.Marshal(forged)
+	require.NoError(t, err)
+	require.Error(t, l.commit(psuManager.RecordTrace(l.ctx, pbk, upkStr, string(forgedJson))))
+	require.Error(t, l.commit(psuManager.RecordTrace(l.ctx, pbk, upkStr, "not a proof")))
+	require.Error(t, l.commit(psuManager.RecordTrace(l.ctx, pointToBase64(p.user.Pk), upkStr, string(proofJson))))
+	_, err = psuManager.QueryTrace(l.ctx, pbk)
+	require.Error(t, err)
+
+	// upk plus the point T = (0, -1) of order 2, the proof of an even challenge would verify without
+	// the subgroup check, and the trace of the pseudonym could not be corrected
+	var torsion twistededwards.PointAffine
+	torsion.Y.SetOne()
+	torsion.Y.Neg(&torsion.Y)
+	forgedUpk := new(twistededwards.PointAffine).Add(upk, &torsion)
+	forgedD := new(twistededwards.PointAffine).Add(pw.Ciphertext.C2, new(twistededwards.PointAffine).Neg(forgedUpk))
+	for {
+		forged, err := s3cross.ProveDLEQ(p.supervisor.Sk, p.supervisor.Pk, pw.Ciphertext.C1, forgedD)
+		require.NoError(t, err)
+		if forged.C.Bit(0) != 0 {
+			continue
+		}
+		forgedJson, err := json.Marshal(forged)
+		require.NoError(t, err)
+		err = l.commit(psuManager.RecordTrace(l.ctx, pbk, pointToBase64(forgedUpk), string(forgedJson)))
+		require.ErrorContains(t, err, "subgroup")
+		break
+	}
 
-	proofStr := "ed469b839e884d7c2217b66c5a43d6af69138514120e80278bb3a6731b802b5cd1dae1fb7f1f9d8fc2705e47890ebbad8a258bf2eaec63e370c9ef991f3bc51c0a2dc574e71a0d43381a34220dd7602b3349903dbd2c7cfc5cda5722d66562ebde35ed0ec7c548b31dd0ff93dc706a4aafe28b9f4c751854eda630e3130335750000000196746964073e698d6ca70680336ed99ea7d6568c202f5da8ed9987f8da658305a563646188368b35277c320ffb6acd8f1d04074272891a99b6b127c6bfa7ff6c"
-	witnessStr := "0000000e000000000000000e2a638ffc1281dbd26549352aa39ab398441c73b053e6ad2b58696f6e0ff74f292f748f112e4b5309efa050a417bba65af99477b782a23834159dd7e5f3bf673b2f89efa3a4fbb642986a12f00ac8a46c2919a16e59df460033ad510d666627891883b8af8c0a099750f7d415c9c5bce728e97cb9d2779d5606f7362b54592d042c4a3563d0346e944ec64fedd7f73966fcb9dc8e64404f3319364b19be16db5709e3e51317c1fdf1e83df24affa16565708ea948f5c248d86b0f0d5f847afe4527512f8d682afaf49a3e3504b0855ad71d8cf57860b8ee08a436d7733d0a4d9f049d36048844bee0d63a7a253dfb7d5cc677508a1b6992bb688cc6d56e0e2c590097b358ff093bc25895174b910e45ca712e718da1b78b57bba3308ab1f7cf6b0f1e45828ac7a2a6d5be173f1300fed01ac668a2c0af1f0220d5e151f71fceeb0754340b95b2f95b180f1c914301fabd88f06d52f32dd106099b6367be1adc5a09c123232a8485ae785b7c9f91f856ccdb5e9be16eaa05fa9f91e2da7f9e192e05310b7c97830678f7014ed039ed69d035dcb4c7d903f286fb46b44b009a0c7d115c6f2ea033485f8033f8d90ed42d9579dcfd97e3127a8be3a26eb75d539328"
-	err = psuManager.CreatePseudonym(transactionContext, proofStr, witnessStr)
+	l.at(1700000100, "tx1")
+	require.NoError(t, l.commit(psuManager.RecordTrace(l.ctx, pbk, upkStr, string(proofJson))))
+	trace, err := psuManager.QueryTrace(l.ctx, pbk)
 	require.NoError(t, err)
+	require.Equal(t, &chaincode.TraceRecord{PublicKey: pbk, UserPK: upkStr, Proof: string(proofJson), TimeStamp: 1700000100}, trace)
+	// a pseudonym is traced once
+	require.Error(t, l.commit(psuManager.RecordTrace(l.ctx, pbk, upkStr, string(proofJson))))
 }
@@ -10,6 +10,7 @@ require (
 	github.com/hyperledger/fabric-protos-go-apiv2 v0.3.4
 	github.com/stretchr/testify v1.10.0
 	google.golang.org/protobuf v1.36.1
+	zkMemMerkle v0.0.0
 )
 
 require (
@@ -43,3 +44,6 @@ require (
 	google.golang.org/grpc v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// the S3CrossMiMC package of PMS/zkSNARKs, vendored with the chaincode
+replace zkMemMerkle => ../../../PMS/zkSNARKs
@@ -0,0 +1,127 @@
+package S3Cross
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// LinkProof proof that two pseudonyms are generated from the same secret y
+// p_i = nonce_i/(y+v_i+1), the proof shows (y+v_i)·pk_i = nonce_i·h - pk_i with a shared y,
+// and that v_i is the index committed in C_i = v_i·H + r_i·G, the commitment of the S3CProof of pseudonym i.
+// The range and quota proofs of the S3CProof show 0 <= v_i < q for the quota q certified in the credential,
+// so the link reuses them: otherwise any two pseudonyms could be linked by choosing v_i freely,
+// and a linked pair could use indices beyond the quota
+type LinkProof struct {
+	c                      *big.Int
+	sY, sV1, sV2, sR1, sR2 *big.Int
+}
+
+// ProveSameOwner prove that the pseudonyms kp1 and kp2 share the same secret y
+// The proof reveals nothing beyond the link, v1 and v2 stay hidden in the commitments of the S3CProofs.
+// kp1, kp2: pseudonyms from GenPseudonym under nonce1 and nonce2
+// (for scoped pseudonyms nonce_i is ScopedNonce(nonce, scope_i))
+func (s *S3Cross) ProveSameOwner(kp1, kp2 *KeyPair, y, nonce1, nonce2 *big.Int) (*LinkProof, error) {
+	mod := bn254.ID.ScalarField()
+	if kp1.v == nil || kp1.r == nil || kp2.v == nil || kp2.r == nil {
+		return nil, errors.New("ProveSameOwner: the key pairs should come from GenPseudonym")
+	}
+	// C_i of the S3CProofs
+	C1 := s.PedersenParams.Commit(kp1.v, kp1.r)
+	C2 := s.PedersenParams.Commit(kp2.v, kp2.r)
+
+	// random mask
+	rY, err := rand.Int(rand.Reader, mod)
+	if err != nil {
+		return nil, errors.New("ProveSameOwner: failed to generate random mask -- " + err.Error())
+	}
+	rV1, _ := rand.Int(rand.Reader, mod)
+	rV2, _ := rand.Int(rand.Reader, mod)
+	rR1, _ := rand.Int(rand.Reader, mod)
+	rR2, _ := rand.Int(rand.Reader, mod)
+
+	T1 := new(bn254.G1Affine).ScalarMultiplication(kp1.pk, new(big.Int).Add(rY, rV1))
+	T2 := new(bn254.G1Affine).ScalarMultiplication(kp2.pk, new(big.Int).Add(rY, rV2))
+	T3 := s.PedersenParams.Commit(rV1, rR1)
+	T4 := s.PedersenParams.Commit(rV2, rR2)
+
+	c := linkChallenge(s.PedersenParams, s.h, kp1.pk, kp2.pk, nonce1, nonce2, C1, C2, T1, T2, T3, T4)
+
+	response := func(r, x *big.Int) *big.Int {
+		res := new(big.Int).Add(r, new(big.Int).Mul(c, x))
+		return res.Mod(res, mod)
+	}
+
+	return &LinkProof{
+		c:   c,
+		sY:  response(rY, y),
+		sV1: response(rV1, kp1.v),
+		sV2: response(rV2, kp2.v),
+		sR1: response(rR1, kp1.r),
+		sR2: response(rR2, kp2.r),
+	}, nil
+}
+
+// VerifySameOwner verify that the pseudonyms of the proofs psu1 and psu2 belong to the same user
+// psu_i: the S3CProof of pseudonym i (its public key is C1), verified under nonce_i
+func VerifySameOwner(lp *LinkProof, psu1, psu2 *S3CProof, pp *PedersenParams, gp *Params, nonce1, nonce2 *big.Int, bits int) error {
+	// the indices are below the certified quotas
+	err := VerifyPseudonym(psu1, pp, gp, nonce1, "", bits)
+	if err != nil {
+		return errors.New("LinkProof: VerifyPseudonym failed due to -- " + err.Error())
+	}
+	err = VerifyPseudonym(psu2, pp, gp, nonce2, "", bits)
+	if err != nil {
+		return errors.New("LinkProof: VerifyPseudonym failed due to -- " + err.Error())
+	}
+	pk1, pk2 := psu1.C1, psu2.C1
+
+	// BK_i = nonce_i·h - pk_i
+	BK1 := new(bn254.G1Affine).Sub(new(bn254.G1Affine).ScalarMultiplication(gp.h, nonce1), pk1)
+	BK2 := new(bn254.G1Affine).Sub(new(bn254.G1Affine).ScalarMultiplication(gp.h, nonce2), pk2)
+
+	T1 := new(bn254.G1Affine).ScalarMultiplication(pk1, new(big.Int).Add(lp.sY, lp.sV1))
+	T1.Sub(T1, new(bn254.G1Affine).ScalarMultiplication(BK1, lp.c))
+	T2 := new(bn254.G1Affine).ScalarMultiplication(pk2, new(big.Int).Add(lp.sY, lp.sV2))
+	T2.Sub(T2, new(bn254.G1Affine).ScalarMultiplication(BK2, lp.c))
+
+	T3 := pp.Commit(lp.sV1, lp.sR1)
+	T3.Sub(T3, new(bn254.G1Affine).ScalarMultiplication(psu1.BorromeanProof.C, lp.c))
+	T4 := pp.Commit(lp.sV2, lp.sR2)
+	T4.Sub(T4, new(bn254.G1Affine).ScalarMultiplication(psu2.BorromeanProof.C, lp.c))
+
+	c := linkChallenge(pp, gp.h, pk1, pk2, nonce1, nonce2, psu1.BorromeanProof.C, psu2.BorromeanProof.C, T1, T2, T3, T4)
+	if c.Cmp(lp.c) != 0 {
+		return errors.New("LinkProof: verification failed")
+	}
+	return nil
+}
+
+// linkChallenge H("S3Cross-Link" || G || H || h || pk1 || pk2 || nonce1 || nonce2 || C1 || C2 || T1 .. T4) mod r,
+// the nonces are written as 32 bytes so the (nonce1, nonce2) pairs stay apart
+func linkChallenge(pp *PedersenParams, h, pk1, pk2 *bn254.G1Affine, nonce1, nonce2 *big.Int, C1, C2, T1, T2, T3, T4 *bn254.G1Affine) *big.Int {
+	mod := bn254.ID.ScalarField()
+	nc1 := new(big.Int).Mod(nonce1, mod)
+	nc2 := new(big.Int).Mod(nonce2, mod)
+	hs := sha256.New()
+	hs.Write([]byte("S3Cross-Link"))
+	hs.Write(pp.G.Marshal())
+	hs.Write(pp.H.Marshal())
+	hs.Write(h.Marshal())
+	hs.Write(pk1.Marshal())
+	hs.Write(pk2.Marshal())
+	hs.Write(nc1.FillBytes(make([]byte, fr.Bytes)))
+	hs.Write(nc2.FillBytes(make([]byte, fr.Bytes)))
+	hs.Write(C1.Marshal())
+	hs.Write(C2.Marshal())
+	hs.Write(T1.Marshal())
+	hs.Write(T2.Marshal())
+	hs.Write(T3.Marshal())
+	hs.Write(T4.Marshal())
+	c := new(big.Int).SetBytes(hs.Sum(nil))
+	return c.Mod(c, mod)
+}
@@ -3,8 +3,10 @@ package S3Cross
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"math/big"
 )
 
@@ -16,34 +18,82 @@ type S3Cross struct {
 type KeyPair struct {
 	sk *big.Int
 	pk *bn254.G1Affine
+
+	v, r *big.Int // index and blinding of the commitment C = v·H + r·G of the S3CProof, for ProveSameOwner
 }
 
 type PsuProof struct {
 	cp                 *big.Int
 	sYP, sVP, sRP, sPP *big.Int
+	sQP, sSP, sRQP     *big.Int // quota: C + Cd + H = q·H + (r+rd)·G and Cq = q·h1 + rq·h0
 }
 
 type S3CProof struct {
 	*BorromeanProof
+	Quota *BorromeanProof // range proof of d = q-v-1
 	*GroupSignature
 	*PsuProof
 }
 
+// PsuSignature Schnorr signature of a pseudonym
+type PsuSignature struct {
+	c, s *big.Int
+}
+
+// ScopedNonce bind the nonce to a scope (service ID)
+// Pseudonyms of one user at different scopes are unlinkable even within one epoch.
+// The empty scope keeps the nonce as it is.
+// H("S3Cross-Scope" || len(scope) || scope || nonce), the length (8 bytes) and the 32 bytes nonce
+// keep the (scope, nonce) pairs apart
+func ScopedNonce(nonce *big.Int, scope string) *big.Int {
+	if scope == "" {
+		return nonce
+	}
+	var ind [8]byte
+	binary.BigEndian.PutUint64(ind[:], uint64(len(scope)))
+	nc := new(big.Int).Mod(nonce, bn254.ID.ScalarField())
+	h := sha256.New()
+	h.Write([]byte("S3Cross-Scope"))
+	h.Write(ind[:])
+	h.Write([]byte(scope))
+	h.Write(nc.FillBytes(make([]byte, fr.Bytes)))
+	sn := new(big.Int).SetBytes(h.Sum(nil))
+	return sn.Mod(sn, bn254.ID.ScalarField())
+}
+
 // GenPseudonym generate the pseudonym with zkp
-func (s *S3Cross) GenPseudonym(M *bn254.G1Affine, nonce, v *big.Int, bits int) (*KeyPair, *S3CProof, error) {
+// scope: the service ID the pseudonym is used for ("" for the unscoped pseudonym)
+// v: the pseudonym index, 0 <= v < q where q is the quota certified in the user key (q <= 2^bits)
+func (s *S3Cross) GenPseudonym(M *bn254.G1Affine, nonce *big.Int, scope string, v *big.Int, bits int) (*KeyPair, *S3CProof, error) {
+	nonce = ScopedNonce(nonce, scope)
+
+	if v.Cmp(s.q) >= 0 {
+		return nil, nil, errors.New("GenPseudonym: v exceeds the certified quota")
+	}
+
 	// range proof
 	// // 0 < v < 2^bits
 	boProof, r, err := BorromeanProve(s.PedersenParams, v, bits)
 	if err != nil {
 		panic(errors.New("GenPseudonym: BorromeanProve error due to -- " + err.Error()))
 	}
+	// // 0 <= q-v-1 < 2^bits
+	d := new(big.Int).Sub(new(big.Int).Sub(s.q, v), big.NewInt(1))
+	quProof, rd, err := BorromeanProve(s.PedersenParams, d, bits)
+	if err != nil {
+		return nil, nil, errors.New("GenPseudonym: BorromeanProve error due to -- " + err.Error())
+	}
 
 	// generate pseudonym
 	// // p = nonce/(y+v+1)
 	p := new(big.Int).Mul(nonce, new(big.Int).ModInverse(new(big.Int).Add(new(big.Int).Add(s.y, v), big.NewInt(1)), s.Mod))
 
 	// group signature
-	gs, err := s.GroupSign(M, p)
+	rq, err := rand.Int(rand.Reader, s.Mod)
+	if err != nil {
+		panic(err)
+	}
+	gs, err := s.groupSign(M, p, rq)
 	if err != nil {
 		panic(err)
 	}
@@ -56,47 +106,70 @@ func (s *S3Cross) GenPseudonym(M *bn254.G1Affine, nonce, v *big.Int, bits int) (
 	r_v, _ := rand.Int(rand.Reader, s.Mod)
 	r_r, _ := rand.Int(rand.Reader, s.Mod)
 	r_p, _ := rand.Int(rand.Reader, s.Mod)
+	r_q, _ := rand.Int(rand.Reader, s.Mod)
+	r_s, _ := rand.Int(rand.Reader, s.Mod)
+	r_rq, _ := rand.Int(rand.Reader, s.Mod)
 
 	PM1 := new(bn254.G1Affine).ScalarMultiplication(gs.C1, new(big.Int).Add(r_y, r_v))
 	PM2 := s.PedersenParams.Commit(r_v, r_r)
 	PM3 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(s.pk, r_p), new(bn254.G1Affine).ScalarMultiplication(s.h, new(big.Int).Neg(r_y)))
+	PM4 := s.PedersenParams.Commit(r_q, r_s)
+	PM5 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(s.h1, r_q), new(bn254.G1Affine).ScalarMultiplication(s.h0, r_rq))
 	h := sha256.New()
 	h.Write(gs.c.Bytes())
 	h.Write(s.G.Marshal())
 	h.Write(s.H.Marshal())
 	h.Write(boProof.C.Marshal())
+	h.Write(quProof.C.Marshal())
 	h.Write(PM1.Marshal())
 	h.Write(PM2.Marshal())
 	h.Write(PM3.Marshal())
+	h.Write(PM4.Marshal())
+	h.Write(PM5.Marshal())
 	cp := new(big.Int).SetBytes(h.Sum(nil))
 
 	sYP := new(big.Int).Add(r_y, new(big.Int).Mul(cp, s.y))
 	sVP := new(big.Int).Add(r_v, new(big.Int).Mul(cp, v))
 	sRP := new(big.Int).Add(r_r, new(big.Int).Mul(cp, r))
 	sPP := new(big.Int).Add(r_p, new(big.Int).Mul(cp, p))
+	sQP := new(big.Int).Add(r_q, new(big.Int).Mul(cp, s.q))
+	sSP := new(big.Int).Add(r_s, new(big.Int).Mul(cp, new(big.Int).Add(r, rd)))
+	sRQP := new(big.Int).Add(r_rq, new(big.Int).Mul(cp, rq))
 
 	return &KeyPair{
 			sk: p,
 			pk: gs.C1,
+			v:  new(big.Int).Set(v),
+			r:  r,
 		}, &S3CProof{
 			BorromeanProof: boProof,
+			Quota:          quProof,
 			GroupSignature: gs,
 			PsuProof: &PsuProof{
-				cp:  cp,
-				sYP: sYP,
-				sVP: sVP,
-				sRP: sRP,
-				sPP: sPP,
+				cp:   cp,
+				sYP:  sYP,
+				sVP:  sVP,
+				sRP:  sRP,
+				sPP:  sPP,
+				sQP:  sQP,
+				sSP:  sSP,
+				sRQP: sRQP,
 			},
 		}, nil
 }
 
-func VerifyPseudonym(s3cP *S3CProof, pp *PedersenParams, gp *Params, nonce *big.Int, bits int) error {
+func VerifyPseudonym(s3cP *S3CProof, pp *PedersenParams, gp *Params, nonce *big.Int, scope string, bits int) error {
+	nonce = ScopedNonce(nonce, scope)
+
 	// verify range proof
 	err := BorromeanVerify(pp, s3cP.BorromeanProof, bits)
 	if err != nil {
 		return errors.New("S3CProof: BorromeanVerify failed due to -- " + err.Error())
 	}
+	err = BorromeanVerify(pp, s3cP.Quota, bits)
+	if err != nil {
+		return errors.New("S3CProof: quota BorromeanVerify failed due to -- " + err.Error())
+	}
 
 	// verify group signature
 	err = GroupVerify(s3cP.GroupSignature, gp)
@@ -115,14 +188,26 @@ func VerifyPseudonym(s3cP *S3CProof, pp *PedersenParams, gp *Params, nonce *big.
 	PM3 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(gp.pk, s3cP.PsuProof.sPP), new(bn254.G1Affine).ScalarMultiplication(gp.h, new(big.Int).Neg(s3cP.PsuProof.sYP)))
 	PM3.Sub(PM3, new(bn254.G1Affine).ScalarMultiplication(s3cP.C2, s3cP.cp))
 
+	// // C + Cd + H = q·H + (r+rd)·G, the same q as in Cq
+	CS := new(bn254.G1Affine).Add(s3cP.C, s3cP.Quota.C)
+	CS.Add(CS, pp.H)
+	PM4 := pp.Commit(s3cP.PsuProof.sQP, s3cP.PsuProof.sSP)
+	PM4.Sub(PM4, new(bn254.G1Affine).ScalarMultiplication(CS, s3cP.cp))
+
+	PM5 := new(bn254.G1Affine).Add(new(bn254.G1Affine).ScalarMultiplication(gp.h1, s3cP.PsuProof.sQP), new(bn254.G1Affine).ScalarMultiplication(gp.h0, s3cP.PsuProof.sRQP))
+	PM5.Sub(PM5, new(bn254.G1Affine).ScalarMultiplication(s3cP.Cq, s3cP.cp))
+
 	h := sha256.New()
 	h.Write(s3cP.c.Bytes())
 	h.Write(pp.G.Marshal())
 	h.Write(pp.H.Marshal())
 	h.Write(s3cP.C.Marshal())
+	h.Write(s3cP.Quota.C.Marshal())
 	h.Write(PM1.Marshal())
 	h.Write(PM2.Marshal())
 	h.Write(PM3.Marshal())
+	h.Write(PM4.Marshal())
+	h.Write(PM5.Marshal())
 	cp := new(big.Int).SetBytes(h.Sum(nil))
 
 	if cp.Cmp(s3cP.cp) != 0 {
@@ -131,3 +216,45 @@ func VerifyPseudonym(s3cP *S3CProof, pp *PedersenParams, gp *Params, nonce *big.
 
 	return nil
 }
+
+// SignAsPseudonym Schnorr signature over G1 with base h
+// kp: the pseudonym key pair from GenPseudonym (pk = C1 = h^p)
+func (para *Params) SignAsPseudonym(kp *KeyPair, msg []byte) (*PsuSignature, error) {
+	mod := bn254.ID.ScalarField()
+	r, err := rand.Int(rand.Reader, mod)
+	if err != nil {
+		return nil, errors.New("SignAsPseudonym: failed to generate random r -- " + err.Error())
+	}
+	R := new(bn254.G1Affine).ScalarMultiplication(para.h, r)
+
+	c := psuChallenge(para.h, kp.pk, R, msg)
+	s := new(big.Int).Add(r, new(big.Int).Mul(c, kp.sk))
+	s.Mod(s, mod)
+
+	return &PsuSignature{
+		c: c,
+		s: s,
+	}, nil
+}
+
+// VerifyAsPseudonym verify the signature of msg under the pseudonym public key pk
+func (para *Params) VerifyAsPseudonym(pk *bn254.G1Affine, msg []byte, sig *PsuSignature) error {
+	// R = h^s - pk^c
+	R := new(bn254.G1Affine).ScalarMultiplication(para.h, sig.s)
+	R.Sub(R, new(bn254.G1Affine).ScalarMultiplication(pk, sig.c))
+
+	c := psuChallenge(para.h, pk, R, msg)
+	if c.Cmp(sig.c) != 0 {
+		return errors.New("PsuSignature: verification failed")
+	}
+	return nil
+}
+
+func psuChallenge(h, pk, R *bn254.G1Affine, msg []byte) *big.Int {
+	hs := sha256.New()
+	hs.Write(h.Marshal())
+	hs.Write(pk.Marshal())
+	hs.Write(R.Marshal())
+	hs.Write(msg)
+	return new(big.Int).SetBytes(hs.Sum(nil))
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/stretchr/testify/assert"
 	"math/big"
 	"os"
 	"testing"
@@ -57,7 +58,7 @@ func BenchmarkS3Cross_Issue(b *testing.B) {
 		y, _ := rand.Int(rand.Reader, mod)
 		Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 		Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-		user, err := bbsSE.UserKeyGen(Y0, Y)
+		user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 		if err != nil {
 			panic(err)
 		}
@@ -95,7 +96,7 @@ func BenchmarkS3Cross_GenPseudonym(b *testing.B) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), bits)
 	if err != nil {
 		panic(err)
 	}
@@ -115,7 +116,7 @@ func BenchmarkS3Cross_GenPseudonym(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _, err = s3c.GenPseudonym(M, nonce, v, bits)
+		_, _, err = s3c.GenPseudonym(M, nonce, "", v, bits)
 		if err != nil {
 			panic(err)
 		}
@@ -147,7 +148,7 @@ func BenchmarkS3Cross_VerifyPseudonym(b *testing.B) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), bits)
 	if err != nil {
 		panic(err)
 	}
@@ -165,14 +166,14 @@ func BenchmarkS3Cross_VerifyPseudonym(b *testing.B) {
 		UserKey:        user,
 		PedersenParams: pp,
 	}
-	_, s3cP, err := s3c.GenPseudonym(M, nonce, v, bits)
+	_, s3cP, err := s3c.GenPseudonym(M, nonce, "", v, bits)
 	if err != nil {
 		panic(err)
 	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		err = VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, bits)
+		err = VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, "", bits)
 		if err != nil {
 			panic(err)
 		}
@@ -202,7 +203,7 @@ func TestS3Cross(t *testing.T) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), bits)
 	if err != nil {
 		panic(err)
 	}
@@ -225,12 +226,12 @@ func TestS3Cross(t *testing.T) {
 		UserKey:        user,
 		PedersenParams: pp,
 	}
-	_, s3cP, err := s3c.GenPseudonym(M, nonce, v, bits)
+	_, s3cP, err := s3c.GenPseudonym(M, nonce, "", v, bits)
 	if err != nil {
 		panic(err)
 	}
 
-	err = VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, bits)
+	err = VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, "", bits)
 	if err != nil {
 		panic(err)
 	}
@@ -280,7 +281,7 @@ func TestS3CrossStaticParam(t *testing.T) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), bits)
 	if err != nil {
 		panic(err)
 	}
@@ -303,7 +304,7 @@ func TestS3CrossStaticParam(t *testing.T) {
 		UserKey:        user,
 		PedersenParams: pp2,
 	}
-	_, s3cP, err := s3c.GenPseudonym(M, nonce, v, bits)
+	_, s3cP, err := s3c.GenPseudonym(M, nonce, "", v, bits)
 	if err != nil {
 		panic(err)
 	}
@@ -321,17 +322,222 @@ func TestS3CrossStaticParam(t *testing.T) {
 		panic(err)
 	}
 
-	err = VerifyPseudonym(s3cP, pp2, gp2, nonce, bits)
+	err = VerifyPseudonym(s3cP, pp2, gp2, nonce, "", bits)
 	if err != nil {
 		panic(errors.New("original: " + err.Error()))
 	}
 
-	err = VerifyPseudonym(s3cP2, pp2, gp2, nonce, bits)
+	err = VerifyPseudonym(s3cP2, pp2, gp2, nonce, "", bits)
 	if err != nil {
 		panic(errors.New("marshal: " + err.Error()))
 	}
 }
 
+func TestSignAsPseudonym(t *testing.T) {
+	// setup borromean
+	bits := 4
+	v := big.NewInt(7)
+	pp := GenPedersenParams()
+
+	// setup group signature
+	mod := bn254.ID.ScalarField()
+	sk, err := rand.Int(rand.Reader, mod)
+	assert.Nil(t, err)
+	gamma, _ := rand.Int(rand.Reader, mod)
+
+	bbsSE, err := InitBbsSE(gamma, sk)
+	assert.Nil(t, err)
+
+	// user key y
+	y, _ := rand.Int(rand.Reader, mod)
+	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
+	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), bits)
+	assert.Nil(t, err)
+	user.y = y
+
+	nonce, _ := rand.Int(rand.Reader, mod)
+	M, err := getRandomG1Affine()
+	assert.Nil(t, err)
+
+	// setup s3cross
+	s3c := &S3Cross{
+		UserKey:        user,
+		PedersenParams: pp,
+	}
+	kp, s3cP, err := s3c.GenPseudonym(M, nonce, "", v, bits)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, "", bits))
+
+	msg := []byte("open the gate")
+	sig, err := s3c.SignAsPseudonym(kp, msg)
+	assert.Nil(t, err)
+	assert.Nil(t, bbsSE.VerifyAsPseudonym(s3cP.C1, msg, sig))
+
+	// marshal
+	sig2, err := base64StringToPsuSignature(psuSignatureToBase64String(sig))
+	assert.Nil(t, err)
+	assert.Nil(t, bbsSE.VerifyAsPseudonym(s3cP.C1, msg, sig2))
+
+	// wrong message or wrong pseudonym
+	assert.NotNil(t, bbsSE.VerifyAsPseudonym(s3cP.C1, []byte("close the gate"), sig))
+	assert.NotNil(t, bbsSE.VerifyAsPseudonym(s3cP.C2, msg, sig))
+}
+
+func TestProveSameOwner(t *testing.T) {
+	// setup borromean
+	bits := 4
+	pp := GenPedersenParams()
+
+	// setup group signature
+	mod := bn254.ID.ScalarField()
+	sk, err := rand.Int(rand.Reader, mod)
+	assert.Nil(t, err)
+	gamma, _ := rand.Int(rand.Reader, mod)
+
+	bbsSE, err := InitBbsSE(gamma, sk)
+	assert.Nil(t, err)
+
+	newUser := func() *S3Cross {
+		y, _ := rand.Int(rand.Reader, mod)
+		Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
+		Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
+		user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), bits)
+		assert.Nil(t, err)
+		user.y = y
+		return &S3Cross{
+			UserKey:        user,
+			PedersenParams: pp,
+		}
+	}
+	alice := newUser()
+	bob := newUser()
+
+	// two epochs
+	nonce1, _ := rand.Int(rand.Reader, mod)
+	nonce2, _ := rand.Int(rand.Reader, mod)
+	v1 := big.NewInt(3)
+	v2 := big.NewInt(11)
+	M, err := getRandomG1Affine()
+	assert.Nil(t, err)
+
+	kp1, psu1, err := alice.GenPseudonym(M, nonce1, "", v1, bits)
+	assert.Nil(t, err)
+	kp2, psu2, err := alice.GenPseudonym(M, nonce2, "", v2, bits)
+	assert.Nil(t, err)
+	kpB, psuB, err := bob.GenPseudonym(M, nonce2, "", v2, bits)
+	assert.Nil(t, err)
+
+	lp, err := alice.ProveSameOwner(kp1, kp2, alice.y, nonce1, nonce2)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifySameOwner(lp, psu1, psu2, pp, bbsSE.Params, nonce1, nonce2, bits))
+	assert.True(t, lp.c.Cmp(mod) < 0)
+
+	// (0x0102, 0x03) and (0x01, 0x0203) hash the same bytes without the fixed width nonces
+	G := pp.G
+	challenge := func(n1, n2 int64) *big.Int {
+		return linkChallenge(pp, G, G, G, big.NewInt(n1), big.NewInt(n2), G, G, G, G, G, G)
+	}
+	assert.NotEqual(t, challenge(0x0102, 0x03), challenge(0x01, 0x0203))
+
+	// wrong order of nonces
+	assert.NotNil(t, VerifySameOwner(lp, psu1, psu2, pp, bbsSE.Params, nonce2, nonce1, bits))
+	// the proof does not transfer to another pseudonym
+	assert.NotNil(t, VerifySameOwner(lp, psu1, psuB, pp, bbsSE.Params, nonce1, nonce2, bits))
+
+	// alice does not know bob's y
+	lp2, err := alice.ProveSameOwner(kp1, kpB, alice.y, nonce1, nonce2)
+	assert.Nil(t, err)
+	assert.NotNil(t, VerifySameOwner(lp2, psu1, psuB, pp, bbsSE.Params, nonce1, nonce2, bits))
+
+	// the indices are the ones of the S3CProofs, proven below the quota
+	kp3, psu3, err := alice.GenPseudonym(M, nonce2, "", big.NewInt(12), bits)
+	assert.Nil(t, err)
+	lp3, err := alice.ProveSameOwner(kp1, kp3, alice.y, nonce1, nonce2)
+	assert.Nil(t, err)
+	assert.NotNil(t, VerifySameOwner(lp3, psu1, psu2, pp, bbsSE.Params, nonce1, nonce2, bits))
+	assert.Nil(t, VerifySameOwner(lp3, psu1, psu3, pp, bbsSE.Params, nonce1, nonce2, bits))
+	// a pseudonym without a valid S3CProof
+	assert.NotNil(t, VerifySameOwner(lp, psu1, psu2, pp, bbsSE.Params, nonce1, nonce1, bits))
+	_, err = alice.ProveSameOwner(&KeyPair{sk: kp1.sk, pk: kp1.pk}, kp2, alice.y, nonce1, nonce2)
+	assert.NotNil(t, err)
+}
+
+func TestScopedNonce(t *testing.T) {
+	nonce, _ := rand.Int(rand.Reader, bn254.ID.ScalarField())
+	assert.Equal(t, nonce, ScopedNonce(nonce, ""))
+	assert.Equal(t, ScopedNonce(nonce, "service-A"), ScopedNonce(nonce, "service-A"))
+	assert.NotEqual(t, ScopedNonce(nonce, "service-A"), ScopedNonce(nonce, "service-B"))
+
+	// ("a", 0x62 || n) and ("ab", n) hash the same bytes without the scope length
+	n := big.NewInt(0x6364)
+	bn := new(big.Int).SetBytes(append([]byte("b"), n.Bytes()...))
+	assert.NotEqual(t, ScopedNonce(bn, "a"), ScopedNonce(n, "ab"))
+	assert.NotEqual(t, ScopedNonce(big.NewInt(0x63), "ab"), ScopedNonce(big.NewInt(0), "abc"))
+	// the nonce is hashed as a field element of 32 bytes
+	assert.Equal(t, ScopedNonce(n, "ab"), ScopedNonce(new(big.Int).Add(n, bn254.ID.ScalarField()), "ab"))
+}
+
+func TestPseudonymQuota(t *testing.T) {
+	// setup borromean
+	bits := 4
+	pp := GenPedersenParams()
+
+	// setup group signature
+	mod := bn254.ID.ScalarField()
+	sk, err := rand.Int(rand.Reader, mod)
+	assert.Nil(t, err)
+	gamma, _ := rand.Int(rand.Reader, mod)
+
+	bbsSE, err := InitBbsSE(gamma, sk)
+	assert.Nil(t, err)
+
+	// private user with quota 3, fleet user with quota 16
+	newS3C := func(quota int64) *S3Cross {
+		y, _ := rand.Int(rand.Reader, mod)
+		Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
+		Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
+		user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(quota), bits)
+		assert.Nil(t, err)
+		user.y = y
+		assert.Nil(t, user.UserKeyVerify())
+		return &S3Cross{
+			UserKey:        user,
+			PedersenParams: pp,
+		}
+	}
+	private := newS3C(3)
+	fleet := newS3C(16)
+
+	// a quota of no pseudonym or beyond the range proof
+	y, _ := rand.Int(rand.Reader, mod)
+	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
+	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
+	for _, quota := range []int64{0, -1, 17} {
+		_, err = bbsSE.UserKeyGen(Y0, Y, big.NewInt(quota), bits)
+		assert.NotNil(t, err)
+	}
+
+	nonce, _ := rand.Int(rand.Reader, mod)
+	M, err := getRandomG1Affine()
+	assert.Nil(t, err)
+
+	// v = q-1 is the last pseudonym
+	_, s3cP, err := private.GenPseudonym(M, nonce, "", big.NewInt(2), bits)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, "", bits))
+	_, _, err = private.GenPseudonym(M, nonce, "", big.NewInt(3), bits)
+	assert.NotNil(t, err)
+
+	_, s3cPF, err := fleet.GenPseudonym(M, nonce, "", big.NewInt(15), bits)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyPseudonym(s3cPF, pp, bbsSE.Params, nonce, "", bits))
+
+	// the quota range proof is bound to the certified quota
+	s3cP.Quota = s3cPF.Quota
+	assert.NotNil(t, VerifyPseudonym(s3cP, pp, bbsSE.Params, nonce, "", bits))
+}
+
 type StaticParams struct {
 	StaticPP
 	StaticGP
@@ -353,6 +559,7 @@ type StaticGP struct {
 	W  []byte `json:"w"`
 	H_ []byte `json:"h"`
 	H0 []byte `json:"h0"`
+	H1 []byte `json:"h1"`
 }
 
 func SaveTestParams(filename string, pp *PedersenParams, bbsSE *BbsSE) error {
@@ -365,6 +572,7 @@ func SaveTestParams(filename string, pp *PedersenParams, bbsSE *BbsSE) error {
 	indw := bbsSE.w.Bytes()
 	indh := bbsSE.h.Bytes()
 	indh0 := bbsSE.h0.Bytes()
+	indh1 := bbsSE.h1.Bytes()
 
 	params := &StaticParams{
 		StaticPP: StaticPP{
@@ -381,6 +589,7 @@ func SaveTestParams(filename string, pp *PedersenParams, bbsSE *BbsSE) error {
 			W:     indw[:],
 			H_:    indh[:],
 			H0:    indh0[:],
+			H1:    indh1[:],
 		},
 	}
 	data, err := json.MarshalIndent(params, "", "  ")
@@ -421,12 +630,14 @@ func LoadTestParams(filename string) (*PedersenParams, *BbsSE, error) {
 	gp.w = new(bn254.G2Affine)
 	gp.h = new(bn254.G1Affine)
 	gp.h0 = new(bn254.G1Affine)
+	gp.h1 = new(bn254.G1Affine)
 	_, _ = gp.g1.SetBytes(params.G1)
 	_, _ = gp.g2.SetBytes(params.G2)
 	_, _ = gp.pk.SetBytes(params.PK)
 	_, _ = gp.w.SetBytes(params.W)
 	_, _ = gp.h.SetBytes(params.H_)
 	_, _ = gp.h0.SetBytes(params.H0)
+	_, _ = gp.h1.SetBytes(params.H1)
 	bbsSE.Params = &gp
 
 	return &pp, &bbsSE, nil
@@ -459,7 +670,10 @@ func base64StringToPedersenParams(ppStr *string) (*PedersenParams, error) {
 		return nil, err
 	}
 	pp.H = new(bn254.G1Affine)
-	_, _ = pp.G.SetBytes(tpp.G)
+	_, err = pp.H.SetBytes(tpp.H)
+	if err != nil {
+		return nil, err
+	}
 	pp.Mod = new(big.Int).SetBytes(tpp.Mod)
 
 	return &pp, nil
@@ -472,6 +686,7 @@ func groupParamsToBase64String(gp *Params) *string {
 	indw := gp.w.Bytes()
 	indh := gp.h.Bytes()
 	indh0 := gp.h0.Bytes()
+	indh1 := gp.h1.Bytes()
 
 	sgp := StaticGP{
 		G1: indg1[:],
@@ -480,6 +695,7 @@ func groupParamsToBase64String(gp *Params) *string {
 		W:  indw[:],
 		H_: indh[:],
 		H0: indh0[:],
+		H1: indh1[:],
 	}
 	gpJson, _ := json.MarshalIndent(sgp, "", "  ")
 	gpStr := base64.StdEncoding.EncodeToString(gpJson)
@@ -499,6 +715,7 @@ func base64StringToGroupParams(gpStr *string) (*Params, error) {
 	gp.w = new(bn254.G2Affine)
 	gp.h = new(bn254.G1Affine)
 	gp.h0 = new(bn254.G1Affine)
+	gp.h1 = new(bn254.G1Affine)
 	_, err := gp.g1.SetBytes(tgp.G1)
 	if err != nil {
 		return nil, err
@@ -508,12 +725,17 @@ func base64StringToGroupParams(gpStr *string) (*Params, error) {
 	_, _ = gp.w.SetBytes(tgp.W)
 	_, _ = gp.h.SetBytes(tgp.H_)
 	_, _ = gp.h0.SetBytes(tgp.H0)
+	_, err = gp.h1.SetBytes(tgp.H1)
+	if err != nil {
+		return nil, err
+	}
 
 	return &gp, nil
 }
 
 type S3CProofJson struct {
 	BorromeanProofJson
+	Quota BorromeanProofJson `json:"quota"`
 	GroupSignatureJson
 	PsuProofJson
 }
@@ -539,31 +761,45 @@ type GroupSignatureJson struct {
 	SR2  []byte `json:"sR2"`
 	SR3  []byte `json:"sR3"`
 	SS   string `json:"sS"` // not loose of sign
+	Cq   []byte `json:"Cq"`
+	SQ   []byte `json:"sQ"`
+	SRQ  []byte `json:"sRQ"`
 }
 
 type PsuProofJson struct {
-	CP  []byte `json:"cp"`
-	SYP []byte `json:"sYP"`
-	SVP []byte `json:"sVP"`
-	SRP []byte `json:"sRP"`
-	SPP []byte `json:"sPP"`
+	CP   []byte `json:"cp"`
+	SYP  []byte `json:"sYP"`
+	SVP  []byte `json:"sVP"`
+	SRP  []byte `json:"sRP"`
+	SPP  []byte `json:"sPP"`
+	SQP  []byte `json:"sQP"`
+	SSP  []byte `json:"sSP"`
+	SRQP []byte `json:"sRQP"`
 }
 
-func s3crossProofToBase64String(s3p *S3CProof) *string {
-	// BorromeanProof.CInt
-	C_ := make([][]byte, len(s3p.C_))
-	for i, v := range s3p.C_ {
+func borromeanProofToJson(bp *BorromeanProof) BorromeanProofJson {
+	// BorromeanProof.C_
+	C_ := make([][]byte, len(bp.C_))
+	for i, v := range bp.C_ {
 		ind := v.Bytes()
 		C_[i] = ind[:]
 	}
 	// BorromeanProof.s
-	S := make([][]byte, len(s3p.s))
-	for i, v := range s3p.s {
+	S := make([][]byte, len(bp.s))
+	for i, v := range bp.s {
 		S[i] = v.Bytes()
 	}
 
-	// Borromean
-	indBo_C := s3p.C.Bytes()
+	indBo_C := bp.C.Bytes()
+	return BorromeanProofJson{
+		C:  indBo_C[:],
+		E0: bp.e0.Bytes(),
+		C_: C_,
+		S:  S,
+	}
+}
+
+func s3crossProofToBase64String(s3p *S3CProof) *string {
 	// GS
 	indGS_M := s3p.M.Bytes()
 	indGS_C1 := s3p.C1.Bytes()
@@ -571,14 +807,11 @@ func s3crossProofToBase64String(s3p *S3CProof) *string {
 	indGS_A1 := s3p.A1.Bytes()
 	indGS_A_ := s3p.A_.Bytes()
 	indGS_D := s3p.d.Bytes()
+	indGS_Cq := s3p.Cq.Bytes()
 	// Psu
 	s3pJ := S3CProofJson{
-		BorromeanProofJson: BorromeanProofJson{
-			C:  indBo_C[:],
-			E0: s3p.e0.Bytes(),
-			C_: C_,
-			S:  S,
-		},
+		BorromeanProofJson: borromeanProofToJson(s3p.BorromeanProof),
+		Quota:              borromeanProofToJson(s3p.Quota),
 		GroupSignatureJson: GroupSignatureJson{
 			M:    indGS_M[:],
 			C1:   indGS_C1[:],
@@ -593,13 +826,19 @@ func s3crossProofToBase64String(s3p *S3CProof) *string {
 			SR2:  s3p.sR2.Bytes(),
 			SR3:  s3p.sR3.Bytes(),
 			SS:   s3p.sS.String(),
+			Cq:   indGS_Cq[:],
+			SQ:   s3p.sQ.Bytes(),
+			SRQ:  s3p.sRQ.Bytes(),
 		},
 		PsuProofJson: PsuProofJson{
-			CP:  s3p.cp.Bytes(),
-			SYP: s3p.sYP.Bytes(),
-			SVP: s3p.sVP.Bytes(),
-			SRP: s3p.sRP.Bytes(),
-			SPP: s3p.sPP.Bytes(),
+			CP:   s3p.cp.Bytes(),
+			SYP:  s3p.sYP.Bytes(),
+			SVP:  s3p.sVP.Bytes(),
+			SRP:  s3p.sRP.Bytes(),
+			SPP:  s3p.sPP.Bytes(),
+			SQP:  s3p.sQP.Bytes(),
+			SSP:  s3p.sSP.Bytes(),
+			SRQP: s3p.sRQP.Bytes(),
 		},
 	}
 
@@ -616,28 +855,16 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	}
 	var s3p S3CProof
 	// BorromeanProof
-	var bp BorromeanProof
-	bp.C = new(bn254.G1Affine)
-	_, err := bp.C.SetBytes(s3pJ.C)
+	bp, err := jsonToBorromeanProof(&s3pJ.BorromeanProofJson)
 	if err != nil {
 		return nil, err
 	}
-
-	bp.e0 = new(big.Int).SetBytes(s3pJ.E0)
-
-	C_ := make([]*bn254.G1Affine, len(s3pJ.CInt))
-	for i, v := range s3pJ.C_ {
-		C_[i] = new(bn254.G1Affine)
-		_, _ = C_[i].SetBytes(v)
-	}
-	bp.C_ = C_
-
-	s := make([]*big.Int, len(s3pJ.S))
-	for i, v := range s3pJ.S {
-		s[i] = new(big.Int).SetBytes(v)
+	s3p.BorromeanProof = bp
+	qp, err := jsonToBorromeanProof(&s3pJ.Quota)
+	if err != nil {
+		return nil, err
 	}
-	bp.s = s
-	s3p.BorromeanProof = &bp
+	s3p.Quota = qp
 
 	// GroupSignature
 	var gs GroupSignature
@@ -647,12 +874,17 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	gs.A1 = new(bn254.G1Affine)
 	gs.A_ = new(bn254.G1Affine)
 	gs.d = new(bn254.G1Affine)
+	gs.Cq = new(bn254.G1Affine)
 	_, _ = gs.M.SetBytes(s3pJ.M)
 	_, _ = gs.C1.SetBytes(s3pJ.C1)
 	_, _ = gs.C2.SetBytes(s3pJ.C2)
 	_, _ = gs.A1.SetBytes(s3pJ.A1)
 	_, _ = gs.A_.SetBytes(s3pJ.A_)
 	_, _ = gs.d.SetBytes(s3pJ.D)
+	_, err = gs.Cq.SetBytes(s3pJ.Cq)
+	if err != nil {
+		return nil, err
+	}
 	gs.c = new(big.Int).SetBytes(s3pJ.CInt)
 	gs.sX = new(big.Int).SetBytes(s3pJ.SX)
 	gs.sY = new(big.Int).SetBytes(s3pJ.SY)
@@ -660,6 +892,8 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	gs.sR2 = new(big.Int).SetBytes(s3pJ.SR2)
 	gs.sR3 = new(big.Int).SetBytes(s3pJ.SR3)
 	gs.sS, _ = new(big.Int).SetString(s3pJ.SS, 10)
+	gs.sQ = new(big.Int).SetBytes(s3pJ.SQ)
+	gs.sRQ = new(big.Int).SetBytes(s3pJ.SRQ)
 	s3p.GroupSignature = &gs
 
 	// PsuProof
@@ -669,7 +903,66 @@ func base64StringToS3CrossProof(s3pStr *string) (*S3CProof, error) {
 	psuP.sVP = new(big.Int).SetBytes(s3pJ.SVP)
 	psuP.sRP = new(big.Int).SetBytes(s3pJ.SRP)
 	psuP.sPP = new(big.Int).SetBytes(s3pJ.SPP)
+	psuP.sQP = new(big.Int).SetBytes(s3pJ.SQP)
+	psuP.sSP = new(big.Int).SetBytes(s3pJ.SSP)
+	psuP.sRQP = new(big.Int).SetBytes(s3pJ.SRQP)
 	s3p.PsuProof = &psuP
 
 	return &s3p, nil
 }
+
+func jsonToBorromeanProof(bpJ *BorromeanProofJson) (*BorromeanProof, error) {
+	var bp BorromeanProof
+	bp.C = new(bn254.G1Affine)
+	_, err := bp.C.SetBytes(bpJ.C)
+	if err != nil {
+		return nil, err
+	}
+
+	bp.e0 = new(big.Int).SetBytes(bpJ.E0)
+
+	C_ := make([]*bn254.G1Affine, len(bpJ.C_))
+	for i, v := range bpJ.C_ {
+		C_[i] = new(bn254.G1Affine)
+		_, _ = C_[i].SetBytes(v)
+	}
+	bp.C_ = C_
+
+	s := make([]*big.Int, len(bpJ.S))
+	for i, v := range bpJ.S {
+		s[i] = new(big.Int).SetBytes(v)
+	}
+	bp.s = s
+
+	return &bp, nil
+}
+
+type PsuSignatureJson struct {
+	C []byte `json:"c"`
+	S []byte `json:"s"`
+}
+
+func psuSignatureToBase64String(sig *PsuSignature) *string {
+	sigJ := PsuSignatureJson{
+		C: sig.c.Bytes(),
+		S: sig.s.Bytes(),
+	}
+	sigJson, _ := json.Marshal(sigJ)
+	sigStr := base64.StdEncoding.EncodeToString(sigJson)
+	return &sigStr
+}
+
+func base64StringToPsuSignature(sigStr *string) (*PsuSignature, error) {
+	sigJson, err := base64.StdEncoding.DecodeString(*sigStr)
+	if err != nil {
+		return nil, err
+	}
+	var sigJ PsuSignatureJson
+	if err := json.Unmarshal(sigJson, &sigJ); err != nil {
+		return nil, errors.New("PsuSignature json.Unmarshal failed: " + err.Error())
+	}
+	return &PsuSignature{
+		c: new(big.Int).SetBytes(sigJ.C),
+		s: new(big.Int).SetBytes(sigJ.S),
+	}, nil
+}
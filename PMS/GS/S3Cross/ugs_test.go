@@ -25,7 +25,7 @@ func BenchmarkGroupSign(b *testing.B) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user0, err := bbsSE.UserKeyGen(Y0, Y)
+	user0, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -65,7 +65,7 @@ func BenchmarkGroupVerify(b *testing.B) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user0, err := bbsSE.UserKeyGen(Y0, Y)
+	user0, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -111,7 +111,7 @@ func BenchmarkOpen(b *testing.B) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -158,7 +158,7 @@ func BenchmarkRevokeGen(b *testing.B) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -188,7 +188,7 @@ func BenchmarkParamsUpdate(b *testing.B) {
 	y0, _ := rand.Int(rand.Reader, mod)
 	Y00 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y0))
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y0))
-	user0, err := bbsSE.UserKeyGen(Y00, Y0)
+	user0, err := bbsSE.UserKeyGen(Y00, Y0, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -198,7 +198,7 @@ func BenchmarkParamsUpdate(b *testing.B) {
 	y1, _ := rand.Int(rand.Reader, mod)
 	Y10 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y1))
 	Y1 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y1))
-	user1, err := bbsSE.UserKeyGen(Y10, Y1)
+	user1, err := bbsSE.UserKeyGen(Y10, Y1, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -231,7 +231,7 @@ func TestKeyGen(t *testing.T) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -259,7 +259,7 @@ func TestGroupSignature(t *testing.T) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -297,7 +297,7 @@ func TestOpen(t *testing.T) {
 	y, _ := rand.Int(rand.Reader, mod)
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y))
 	Y := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y))
-	user, err := bbsSE.UserKeyGen(Y0, Y)
+	user, err := bbsSE.UserKeyGen(Y0, Y, big.NewInt(16), 4)
 	assert.Nil(t, err)
 	user.y = y
 
@@ -331,7 +331,7 @@ func TestRevoke(t *testing.T) {
 	y0, _ := rand.Int(rand.Reader, mod)
 	Y00 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y0))
 	Y0 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y0))
-	user0, err := bbsSE.UserKeyGen(Y00, Y0)
+	user0, err := bbsSE.UserKeyGen(Y00, Y0, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -341,7 +341,7 @@ func TestRevoke(t *testing.T) {
 	y1, _ := rand.Int(rand.Reader, mod)
 	Y10 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h0, new(big.Int).Neg(y1))
 	Y1 := new(bn254.G1Affine).ScalarMultiplication(bbsSE.h, new(big.Int).Neg(y1))
-	user1, err := bbsSE.UserKeyGen(Y10, Y1)
+	user1, err := bbsSE.UserKeyGen(Y10, Y1, big.NewInt(16), 4)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,149 @@
+// Ceremony the Groth16 MPC setup of the pseudonym circuit, one command per step,
+// the participants hand the output file to the next one (see the usage below)
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/logger"
+	ceremony "zkMemMerkle/CeremonySetup"
+	s3cross "zkMemMerkle/S3CrossMiMC"
+)
+
+const usage = `usage: ceremony <command> [flags] [contribution files]
+
+phase 1 (a fresh powers of tau, skip it with the commons.bin of an earlier phase1-seal,
+importing an external SRS such as a .ptau transcript is not supported):
+  phase1-init       -n <domain size> -out phase1_0.bin
+  phase1-contribute -in phase1_<i>.bin -out phase1_<i+1>.bin
+  phase1-verify     -prev phase1_<i>.bin -next phase1_<i+1>.bin
+  phase1-seal       -n <domain size> -beacon <hex> -out commons.bin phase1_1.bin ... phase1_k.bin
+
+phase 2 (the circuit of -depth, -index-bits, -hash and -pseudonyms):
+  init              -commons commons.bin -out phase2_0.bin
+  contribute        -in phase2_<i>.bin -out phase2_<i+1>.bin
+  verify            -prev phase2_<i>.bin -next phase2_<i+1>.bin
+  seal              -commons commons.bin -beacon <hex> -pk PGK.text -vk PVK.text phase2_1.bin ... phase2_k.bin
+
+  domain-size       print the phase 1 domain size of the circuit
+
+each contributor publishes the printed hash so the others can check the transcript (it is not the challenge
+of the next contribution), its randomness only lives in memory during the command,
+the beacon is public randomness chosen after the last contribution`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+	logger.Disable()
+	cmd, args := os.Args[1], os.Args[2:]
+	fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+
+	n := fs.Uint64("n", 0, "phase 1 domain size (a power of 2)")
+	in := fs.String("in", "", "last contribution")
+	out := fs.String("out", "", "output file")
+	prev := fs.String("prev", "", "previous contribution")
+	next := fs.String("next", "", "contribution to verify")
+	commons := fs.String("commons", "", "phase 1 SRS commons")
+	beacon := fs.String("beacon", "", "hex of the random beacon")
+	pkFile := fs.String("pk", "PGK.text", "proving key output")
+	vkFile := fs.String("vk", "PVK.text", "verifying key output")
+	depth := fs.Int("depth", s3cross.DefaultConfig.Depth, "Merkle tree depth")
+	indexBits := fs.Int("index-bits", s3cross.DefaultConfig.IndexBits, "max psu number is 2^index-bits - 1")
+	hashID := fs.String("hash", string(s3cross.DefaultConfig.Hash), "mimc or poseidon2")
+	credential := fs.String("credential", "", "issuer credential scheme, eddsa or schnorr (legacy, the default)")
+	pseudonyms := fs.Int("pseudonyms", 0, "pseudonyms per proof, 0 is the single pseudonym circuit")
+	issuerDepth := fs.Int("issuer-depth", 0, "issuer set depth, 0 reveals the issuer key")
+	expiry := fs.Bool("expiry", false, "check the credential validity window against a public current time")
+	indexedTree := fs.Bool("indexed-tree", false, "revocation tree is an OrderedMerkleTree instead of sorted leaves")
+	if err := fs.Parse(args); err != nil {
+		log.Fatal(err)
+	}
+	cfg := s3cross.Config{
+		Depth:       *depth,
+		IndexBits:   *indexBits,
+		Hash:        s3cross.HashID(*hashID),
+		Credential:  s3cross.CredentialScheme(*credential),
+		Pseudonyms:  *pseudonyms,
+		IssuerDepth: *issuerDepth,
+		Expiry:      *expiry,
+		IndexedTree: *indexedTree,
+	}
+
+	var hash []byte
+	var err error
+	switch cmd {
+	case "phase1-init":
+		hash, err = ceremony.InitPhase1(*n, *out)
+	case "phase1-contribute":
+		hash, err = ceremony.ContributePhase1(*in, *out)
+	case "phase1-verify":
+		err = ceremony.VerifyPhase1(*prev, *next)
+	case "phase1-seal":
+		hash, err = ceremony.SealPhase1(*n, decodeBeacon(*beacon), *out, fs.Args()...)
+	case "init":
+		hash, err = ceremony.InitPhase2(compile(cfg), *commons, *out)
+	case "contribute":
+		hash, err = ceremony.ContributePhase2(*in, *out)
+	case "verify":
+		err = ceremony.VerifyPhase2(*prev, *next)
+	case "seal":
+		err = seal(compile(cfg), *commons, decodeBeacon(*beacon), *pkFile, *vkFile, fs.Args())
+	case "domain-size":
+		fmt.Println(ceremony.DomainSize(compile(cfg)))
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("%s: %v", cmd, err)
+	}
+	if hash != nil {
+		fmt.Println("contribution hash:", hex.EncodeToString(hash))
+	} else if strings.HasSuffix(cmd, "verify") {
+		fmt.Println("contribution ok")
+	}
+}
+
+func compile(cfg s3cross.Config) constraint.ConstraintSystem {
+	circuit, err := s3cross.NewCircuit(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ccs, err := s3cross.BackendGroth16.Compile(circuit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return ccs
+}
+
+// seal verify the whole phase 2 transcript and write the keys
+func seal(ccs constraint.ConstraintSystem, commons string, beacon []byte, pkFile, vkFile string, contributions []string) error {
+	for i, file := range contributions {
+		hash, err := ceremony.ContributionHash(file)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("contribution %d: %s %s\n", i+1, file, hex.EncodeToString(hash))
+	}
+	pk, vk, err := ceremony.SealPhase2(ccs, commons, beacon, contributions...)
+	if err != nil {
+		return err
+	}
+	return ceremony.SaveGroth16PKVK(pk, vk, pkFile, vkFile)
+}
+
+func decodeBeacon(beacon string) []byte {
+	b, err := hex.DecodeString(beacon)
+	if err != nil || len(b) == 0 {
+		log.Fatal("the beacon should be a non-empty hex string")
+	}
+	return b
+}
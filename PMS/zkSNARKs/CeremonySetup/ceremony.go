@@ -0,0 +1,213 @@
+// CeremonySetup the files of the Groth16 MPC setup run by the Ceremony command,
+// out of S3CrossMiMC so the chaincode does not vendor them
+package ceremony
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/groth16/bn254/mpcsetup"
+	"github.com/consensys/gnark/constraint"
+	cs "github.com/consensys/gnark/constraint/bn254"
+)
+
+// Groth16 MPC setup, every participant hands the last contribution file to the next one
+// phase 1 (powers of tau): InitPhase1 -> ContributePhase1 ... -> SealPhase1 (the SRS commons, any circuit of the domain size)
+// phase 2 (circuit):       InitPhase2 -> ContributePhase2 ... -> SealPhase2 (PGK/PVK)
+// the keys are secure as long as one contributor of each phase deletes its randomness,
+// the contribution hash (sha256 of the file) is only published so anyone can check the transcript,
+// mpcsetup derives the challenge of each contribution itself
+// phase 1 always starts a fresh powers of tau, importing an external SRS (e.g. a .ptau transcript) is not supported,
+// only the commons written by SealPhase1 can be reused for another circuit
+
+// DomainSize the domain size of the phase 1 SRS needed by ccs
+func DomainSize(ccs constraint.ConstraintSystem) uint64 {
+	return ecc.NextPowerOfTwo(uint64(ccs.GetNbConstraints()))
+}
+
+// InitPhase1 write the empty phase 1 of domain size N (a power of 2), the start of a fresh powers of tau
+func InitPhase1(N uint64, out string) ([]byte, error) {
+	if ecc.NextPowerOfTwo(N) != N {
+		return nil, errors.New("init phase 1: domain size should be a power of 2")
+	}
+	return writeContribution(out, mpcsetup.NewPhase1(N))
+}
+
+// ContributePhase1 add fresh randomness to the last phase 1 contribution, return the hash of out
+func ContributePhase1(in, out string) ([]byte, error) {
+	var p mpcsetup.Phase1
+	if err := readContribution(in, &p); err != nil {
+		return nil, err
+	}
+	p.Contribute()
+	return writeContribution(out, &p)
+}
+
+// VerifyPhase1 check that next is a valid contribution on top of prev
+func VerifyPhase1(prev, next string) error {
+	var p, n mpcsetup.Phase1
+	if err := readContribution(prev, &p); err != nil {
+		return err
+	}
+	if err := readContribution(next, &n); err != nil {
+		return err
+	}
+	return p.Verify(&n)
+}
+
+// SealPhase1 verify all the phase 1 contributions (in order) and write the SRS commons
+// beacon: public randomness published after the last contribution
+func SealPhase1(N uint64, beacon []byte, out string, contributions ...string) ([]byte, error) {
+	phases := make([]*mpcsetup.Phase1, len(contributions))
+	for i, file := range contributions {
+		phases[i] = new(mpcsetup.Phase1)
+		if err := readContribution(file, phases[i]); err != nil {
+			return nil, err
+		}
+	}
+	commons, err := mpcsetup.VerifyPhase1(N, beacon, phases...)
+	if err != nil {
+		return nil, errors.New("seal phase 1 -- " + err.Error())
+	}
+	return writeContribution(out, &commons)
+}
+
+// InitPhase2 write the empty phase 2 of the circuit from the phase 1 SRS commons
+func InitPhase2(ccs constraint.ConstraintSystem, commonsFile, out string) ([]byte, error) {
+	r1cs, commons, err := readPhase2Inputs(ccs, commonsFile)
+	if err != nil {
+		return nil, err
+	}
+	var p mpcsetup.Phase2
+	p.Initialize(r1cs, commons)
+	return writeContribution(out, &p)
+}
+
+// ContributePhase2 add fresh randomness to the last phase 2 contribution, return the hash of out
+func ContributePhase2(in, out string) ([]byte, error) {
+	var p mpcsetup.Phase2
+	if err := readContribution(in, &p); err != nil {
+		return nil, err
+	}
+	p.Contribute()
+	return writeContribution(out, &p)
+}
+
+// VerifyPhase2 check that next is a valid contribution on top of prev
+func VerifyPhase2(prev, next string) error {
+	var p, n mpcsetup.Phase2
+	if err := readContribution(prev, &p); err != nil {
+		return err
+	}
+	if err := readContribution(next, &n); err != nil {
+		return err
+	}
+	return p.Verify(&n)
+}
+
+// SealPhase2 verify all the phase 2 contributions (in order, without the InitPhase2 file) and extract the keys
+// beacon: public randomness published after the last contribution
+func SealPhase2(ccs constraint.ConstraintSystem, commonsFile string, beacon []byte, contributions ...string) (groth16.ProvingKey, groth16.VerifyingKey, error) {
+	r1cs, commons, err := readPhase2Inputs(ccs, commonsFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	phases := make([]*mpcsetup.Phase2, len(contributions))
+	for i, file := range contributions {
+		phases[i] = new(mpcsetup.Phase2)
+		if err = readContribution(file, phases[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	pk, vk, err := mpcsetup.VerifyPhase2(r1cs, commons, beacon, phases...)
+	if err != nil {
+		return nil, nil, errors.New("seal phase 2 -- " + err.Error())
+	}
+	return pk, vk, nil
+}
+
+// ContributionHash sha256 of a contribution file, published by its contributor
+func ContributionHash(file string) ([]byte, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+func readPhase2Inputs(ccs constraint.ConstraintSystem, commonsFile string) (*cs.R1CS, *mpcsetup.SrsCommons, error) {
+	r1cs, ok := ccs.(*cs.R1CS)
+	if !ok {
+		return nil, nil, errors.New("phase 2: not a bn254 r1cs")
+	}
+	var commons mpcsetup.SrsCommons
+	if err := readContribution(commonsFile, &commons); err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(commons.G1.AlphaTau)) < DomainSize(ccs) {
+		return nil, nil, errors.New("phase 2: the phase 1 domain is smaller than the circuit")
+	}
+	return r1cs, &commons, nil
+}
+
+func readContribution(file string, v io.ReaderFrom) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	_, err = v.ReadFrom(f)
+	return err
+}
+
+// writeContribution write v to file, return its sha256
+func writeContribution(file string, v io.WriterTo) ([]byte, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	if _, err = v.WriteTo(io.MultiWriter(f, h)); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// SaveGroth16PKVK write the keys, e.g. PGK.text and PVK.text extracted by SealPhase2
+func SaveGroth16PKVK(pk groth16.ProvingKey, vk groth16.VerifyingKey, pkFile, vkFile string) error {
+	if _, err := writeContribution(pkFile, pk); err != nil {
+		return err
+	}
+	_, err := writeContribution(vkFile, vk)
+	return err
+}
+
+// LoadGroth16PKVK read the keys written by SaveGroth16PKVK
+func LoadGroth16PKVK(pkFile, vkFile string) (groth16.ProvingKey, groth16.VerifyingKey, error) {
+	pk := groth16.NewProvingKey(ecc.BN254)
+	if err := readContribution(pkFile, pk); err != nil {
+		return nil, nil, err
+	}
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	if err := readContribution(vkFile, vk); err != nil {
+		return nil, nil, err
+	}
+	return pk, vk, nil
+}
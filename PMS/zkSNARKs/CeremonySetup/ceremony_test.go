@@ -0,0 +1,126 @@
+package ceremony
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/groth16/bn254/mpcsetup"
+	"github.com/consensys/gnark/frontend"
+	"github.com/stretchr/testify/assert"
+	s3cross "zkMemMerkle/S3CrossMiMC"
+)
+
+// hashCircuit the MiMC digest of A and B
+type hashCircuit struct {
+	A, B   frontend.Variable
+	Digest frontend.Variable `gnark:",public"`
+}
+
+func (circuit *hashCircuit) Define(api frontend.API) error {
+	h, err := s3cross.HashMiMC.NewCircuitHasher(api)
+	if err != nil {
+		return err
+	}
+	h.Write(circuit.A, circuit.B)
+	api.AssertIsEqual(h.Sum(), circuit.Digest)
+	return nil
+}
+
+func TestCeremony(t *testing.T) {
+	dir := t.TempDir()
+	file := func(name string, i int) string {
+		return filepath.Join(dir, fmt.Sprintf("%s_%d.bin", name, i))
+	}
+
+	ccs, err := s3cross.BackendGroth16.Compile(&hashCircuit{})
+	assert.NoError(t, err)
+	// a larger phase 1 can be reused by other circuits
+	N := 2 * DomainSize(ccs)
+
+	// phase 1
+	_, err = InitPhase1(N, file("phase1", 0))
+	assert.NoError(t, err)
+	phase1 := make([]string, 2)
+	for i := range phase1 {
+		phase1[i] = file("phase1", i+1)
+		_, err = ContributePhase1(file("phase1", i), phase1[i])
+		assert.NoError(t, err)
+		assert.NoError(t, VerifyPhase1(file("phase1", i), phase1[i]))
+	}
+	commons := filepath.Join(dir, "commons.bin")
+	_, err = SealPhase1(N, []byte("beacon 1"), commons, phase1...)
+	assert.NoError(t, err)
+
+	// phase 2
+	_, err = InitPhase2(ccs, commons, file("phase2", 0))
+	assert.NoError(t, err)
+	phase2 := make([]string, 3)
+	for i := range phase2 {
+		phase2[i] = file("phase2", i+1)
+		hash, err := ContributePhase2(file("phase2", i), phase2[i])
+		assert.NoError(t, err)
+		fileHash, err := ContributionHash(phase2[i])
+		assert.NoError(t, err)
+		assert.Equal(t, fileHash, hash)
+		assert.NoError(t, VerifyPhase2(file("phase2", i), phase2[i]))
+	}
+
+	// transcript: each contribution is bound to the hash of the previous one
+	var p2 mpcsetup.Phase2
+	assert.NoError(t, readContribution(phase2[2], &p2))
+	prevHash, err := ContributionHash(phase2[1])
+	assert.NoError(t, err)
+	assert.Equal(t, prevHash, p2.Challenge)
+	// skipped contribution
+	assert.Error(t, VerifyPhase2(phase2[0], phase2[2]))
+	_, _, err = SealPhase2(ccs, commons, []byte("beacon 2"), phase2[0], phase2[2])
+	assert.Error(t, err)
+
+	pk, vk, err := SealPhase2(ccs, commons, []byte("beacon 2"), phase2...)
+	assert.NoError(t, err)
+
+	// the extracted keys
+	assert.NoError(t, SaveGroth16PKVK(pk, vk, filepath.Join(dir, "PGK.text"), filepath.Join(dir, "PVK.text")))
+	pk, vk, err = LoadGroth16PKVK(filepath.Join(dir, "PGK.text"), filepath.Join(dir, "PVK.text"))
+	assert.NoError(t, err)
+
+	a, err := rand.Int(rand.Reader, fr.Modulus())
+	assert.NoError(t, err)
+	b, err := rand.Int(rand.Reader, fr.Modulus())
+	assert.NoError(t, err)
+	h, err := s3cross.HashMiMC.New()
+	assert.NoError(t, err)
+	_, err = h.Write(a.FillBytes(make([]byte, fr.Bytes)))
+	assert.NoError(t, err)
+	_, err = h.Write(b.FillBytes(make([]byte, fr.Bytes)))
+	assert.NoError(t, err)
+	digest := h.Sum(nil)
+	secretWitness, err := frontend.NewWitness(&hashCircuit{
+		A:      a,
+		B:      b,
+		Digest: new(big.Int).SetBytes(digest),
+	}, ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	publicWitness, err := secretWitness.Public()
+	assert.NoError(t, err)
+
+	proof, err := s3cross.BackendGroth16.Prove(ccs, pk, secretWitness)
+	assert.NoError(t, err)
+	assert.NoError(t, s3cross.BackendGroth16.Verify(proof, vk, publicWitness))
+
+	// phase 1 too small for the circuit
+	small := filepath.Join(dir, "small.bin")
+	_, err = InitPhase1(2, file("small", 0))
+	assert.NoError(t, err)
+	_, err = ContributePhase1(file("small", 0), file("small", 1))
+	assert.NoError(t, err)
+	_, err = SealPhase1(2, []byte("beacon 1"), small, file("small", 1))
+	assert.NoError(t, err)
+	_, err = InitPhase2(ccs, small, file("small", 2))
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+// Prover a local prover daemon: the circuit is compiled and the proving key loaded once,
+// POST /prove {"witness": base64} returns the proof and public witness strings of CreatePseudonym
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/consensys/gnark/logger"
+	prover "zkMemMerkle/ProverService"
+	s3cross "zkMemMerkle/S3CrossMiMC"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:7070", "listen address, keep it on localhost")
+	backend := flag.String("backend", string(s3cross.BackendGroth16), "groth16 or plonk")
+	pkFile := flag.String("pk", "PGK.text", "proving key")
+	version := flag.String("version", "", "circuit version of the proofs, empty for the default GVK")
+	workers := flag.Int("workers", 1, "concurrent proofs, each gnark prove already uses all cores")
+	queue := flag.Int("queue", 64, "requests waiting for a worker, more are rejected with 503")
+	depth := flag.Int("depth", s3cross.DefaultConfig.Depth, "Merkle tree depth")
+	indexBits := flag.Int("index-bits", s3cross.DefaultConfig.IndexBits, "max psu number is 2^index-bits - 1")
+	hashID := flag.String("hash", string(s3cross.DefaultConfig.Hash), "mimc or poseidon2")
+	credential := flag.String("credential", "", "issuer credential scheme, eddsa or schnorr (legacy, the default)")
+	pseudonyms := flag.Int("pseudonyms", 0, "pseudonyms per proof, 0 is the single pseudonym circuit")
+	issuerDepth := flag.Int("issuer-depth", 0, "issuer set depth, 0 reveals the issuer key")
+	expiry := flag.Bool("expiry", false, "check the credential validity window against a public current time")
+	indexedTree := flag.Bool("indexed-tree", false, "revocation tree is an OrderedMerkleTree instead of sorted leaves")
+	flag.Parse()
+	logger.Disable()
+
+	b := s3cross.Backend(*backend)
+	start := time.Now()
+	circuit, err := s3cross.NewCircuit(s3cross.Config{
+		Depth:       *depth,
+		IndexBits:   *indexBits,
+		Hash:        s3cross.HashID(*hashID),
+		Credential:  s3cross.CredentialScheme(*credential),
+		Pseudonyms:  *pseudonyms,
+		IssuerDepth: *issuerDepth,
+		Expiry:      *expiry,
+		IndexedTree: *indexedTree,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// compiled here, the solver hints are set up by the compile of this process
+	ccs, err := b.Compile(circuit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pk, err := prover.LoadProvingKey(b, *pkFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("loaded %d constraints and %s in %v", ccs.GetNbConstraints(), *pkFile, time.Since(start))
+
+	service, err := prover.NewService(b, ccs, pk, *version, *workers, *queue)
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           service,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+		<-stop
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	}()
+
+	log.Printf("proving on http://%s/prove with %d workers", *addr, *workers)
+	if err = srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	service.Close()
+}
@@ -0,0 +1,247 @@
+// ProverService the proof workers and the HTTP handler of the Prover daemon,
+// out of S3CrossMiMC so the chaincode does not vendor them
+package prover
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	s3cross "zkMemMerkle/S3CrossMiMC"
+)
+
+var (
+	ErrQueueFull       = errors.New("prover queue is full")
+	ErrProverClosed    = errors.New("prover is closed")
+	ErrWitnessRejected = errors.New("witness does not satisfy the circuit")
+)
+
+// Service proves with a constraint system and proving key loaded once,
+// the proofs run in a fixed pool of workers fed by a bounded queue
+type Service struct {
+	backend s3cross.Backend
+	ccs     constraint.ConstraintSystem
+	pk      s3cross.ProvingKey
+	version string // "" gives a bare base64 proof (default GVK), else a VersionedProof
+
+	jobs      chan *proveJob
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// ProveResult the strings of CreatePseudonym
+type ProveResult struct {
+	Proof         string `json:"proof"`
+	PublicWitness string `json:"publicWitness"` // base64
+}
+
+type proveJob struct {
+	ctx     context.Context
+	witness witness.Witness
+	result  chan proveOutcome // buffered, the worker never blocks on a gone caller
+}
+
+type proveOutcome struct {
+	res *ProveResult
+	err error
+}
+
+// NewService start workers provers sharing a queue of queueSize waiting requests
+// version: the circuit version of the proofs, "" for the default GVK
+func NewService(b s3cross.Backend, ccs constraint.ConstraintSystem, pk s3cross.ProvingKey, version string, workers, queueSize int) (*Service, error) {
+	if workers <= 0 || queueSize < 0 {
+		return nil, errors.New("new prover service: workers should be > 0 and queue size >= 0")
+	}
+	if _, err := b.NewProof(); err != nil {
+		return nil, err
+	}
+	p := &Service{
+		backend: b,
+		ccs:     ccs,
+		pk:      pk,
+		version: version,
+		jobs:    make(chan *proveJob, queueSize),
+		done:    make(chan struct{}),
+	}
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p, nil
+}
+
+// Prove queue the full witness and wait for its proof
+// a request cancelled while queued is dropped, a running gnark prove can't be interrupted so its result is discarded
+func (p *Service) Prove(ctx context.Context, fullWitness witness.Witness) (*ProveResult, error) {
+	job := &proveJob{
+		ctx:     ctx,
+		witness: fullWitness,
+		result:  make(chan proveOutcome, 1),
+	}
+	select {
+	case <-p.done:
+		return nil, ErrProverClosed
+	default:
+	}
+	select {
+	case p.jobs <- job:
+	default:
+		return nil, ErrQueueFull
+	}
+
+	select {
+	case out := <-job.result:
+		return out.res, out.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.done:
+		return nil, ErrProverClosed
+	}
+}
+
+// Close stop the workers after their current proof, queued requests get ErrProverClosed
+func (p *Service) Close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+	p.wg.Wait()
+}
+
+func (p *Service) work() {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.done:
+			return
+		case job := <-p.jobs:
+			if err := job.ctx.Err(); err != nil {
+				job.result <- proveOutcome{err: err}
+				continue
+			}
+			res, err := p.prove(job.witness)
+			job.result <- proveOutcome{res: res, err: err}
+		}
+	}
+}
+
+func (p *Service) prove(fullWitness witness.Witness) (*ProveResult, error) {
+	publicWitness, err := fullWitness.Public()
+	if err != nil {
+		return nil, errors.New("prove -- " + err.Error())
+	}
+	proof, err := p.backend.Prove(p.ccs, p.pk, fullWitness)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWitnessRejected, err)
+	}
+
+	var buf bytes.Buffer
+	if _, err = publicWitness.WriteTo(&buf); err != nil {
+		return nil, errors.New("prove -- " + err.Error())
+	}
+	res := &ProveResult{
+		PublicWitness: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}
+	if p.version != "" {
+		res.Proof, err = (&s3cross.VersionedProof{Version: p.version, Proof: proof}).Encode()
+		if err != nil {
+			return nil, errors.New("prove -- " + err.Error())
+		}
+		return res, nil
+	}
+	buf.Reset()
+	if _, err = proof.WriteTo(&buf); err != nil {
+		return nil, errors.New("prove -- " + err.Error())
+	}
+	res.Proof = base64.StdEncoding.EncodeToString(buf.Bytes())
+	return res, nil
+}
+
+// ProveRequest the body of POST /prove
+type ProveRequest struct {
+	Witness string `json:"witness"` // base64 of the full witness (witness.Witness.WriteTo), e.g. PseudonymWitness.Witness
+}
+
+const maxProveRequestSize = 1 << 20
+
+// ServeHTTP POST /prove: ProveRequest -> ProveResult
+// 400 bad request, 422 the witness does not satisfy the circuit, 503 queue full or closed
+// a client that disconnects cancels its request
+func (p *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/prove" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req ProveRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxProveRequestSize)).Decode(&req); err != nil {
+		http.Error(w, "decode request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, err := base64.StdEncoding.DecodeString(req.Witness)
+	if err != nil {
+		http.Error(w, "decode witness: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	fullWitness, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err = fullWitness.ReadFrom(bytes.NewReader(data)); err != nil {
+		http.Error(w, "decode witness: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := p.Prove(r.Context(), fullWitness)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrQueueFull), errors.Is(err, ErrProverClosed):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	case errors.Is(err, ErrWitnessRejected):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	case r.Context().Err() != nil:
+		// the client is gone
+		return
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// LoadProvingKey read a proving key written by ProvingKey.WriteTo (PGK.text for groth16)
+func LoadProvingKey(b s3cross.Backend, file string) (s3cross.ProvingKey, error) {
+	pk, err := b.NewProvingKey()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	if _, err = pk.ReadFrom(f); err != nil {
+		return nil, errors.New("load proving key -- " + err.Error())
+	}
+	return pk, nil
+}
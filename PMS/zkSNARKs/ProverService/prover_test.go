@@ -0,0 +1,149 @@
+package prover
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/stretchr/testify/assert"
+	s3cross "zkMemMerkle/S3CrossMiMC"
+)
+
+// squareCircuit X*X == Y, with a range check on X when Commit is set (BSB22 commitment in the proof)
+type squareCircuit struct {
+	X      frontend.Variable
+	Y      frontend.Variable `gnark:",public"`
+	Commit bool              `gnark:"-"`
+}
+
+func (circuit *squareCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(circuit.X, circuit.X), circuit.Y)
+	if circuit.Commit {
+		rangecheck.New(api).Check(circuit.X, 16)
+	}
+	return nil
+}
+
+func squareWitness(t *testing.T, x, y int) witness.Witness {
+	w, err := frontend.NewWitness(&squareCircuit{X: x, Y: y}, ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	return w
+}
+
+func TestService(t *testing.T) {
+	ccs, err := s3cross.BackendGroth16.Compile(&squareCircuit{Commit: true})
+	assert.NoError(t, err)
+	pk, vk, err := s3cross.BackendGroth16.Setup(ccs, nil, nil)
+	assert.NoError(t, err)
+
+	p, err := NewService(s3cross.BackendGroth16, ccs, pk, "", 2, 4)
+	assert.NoError(t, err)
+	srv := httptest.NewServer(p)
+	defer srv.Close()
+
+	post := func(body string) (*http.Response, []byte) {
+		resp, err := http.Post(srv.URL+"/prove", "application/json", strings.NewReader(body))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(resp.Body)
+		assert.NoError(t, err)
+		return resp, buf.Bytes()
+	}
+	encode := func(w witness.Witness) string {
+		var buf bytes.Buffer
+		_, err := w.WriteTo(&buf)
+		assert.NoError(t, err)
+		data, err := json.Marshal(ProveRequest{Witness: base64.StdEncoding.EncodeToString(buf.Bytes())})
+		assert.NoError(t, err)
+		return string(data)
+	}
+
+	// the strings of CreatePseudonym
+	resp, body := post(encode(squareWitness(t, 3, 9)))
+	assert.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var res ProveResult
+	assert.NoError(t, json.Unmarshal(body, &res))
+	proofBytes, err := base64.StdEncoding.DecodeString(res.Proof)
+	assert.NoError(t, err)
+	proof := groth16.NewProof(ecc.BN254)
+	_, err = proof.ReadFrom(bytes.NewReader(proofBytes))
+	assert.NoError(t, err)
+	wBytes, err := base64.StdEncoding.DecodeString(res.PublicWitness)
+	assert.NoError(t, err)
+	publicWitness, err := witness.New(ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	_, err = publicWitness.ReadFrom(bytes.NewReader(wBytes))
+	assert.NoError(t, err)
+	assert.NoError(t, groth16.Verify(proof, vk.(groth16.VerifyingKey), publicWitness))
+
+	resp, _ = post(encode(squareWitness(t, 3, 10)))
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp, _ = post(`{"witness": "not base64"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, err = http.Get(srv.URL + "/prove")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	p.Close()
+	_, err = p.Prove(context.Background(), squareWitness(t, 3, 9))
+	assert.ErrorIs(t, err, ErrProverClosed)
+
+	// versioned proofs
+	vp, err := NewService(s3cross.BackendGroth16, ccs, pk, "v2", 1, 1)
+	assert.NoError(t, err)
+	defer vp.Close()
+	vres, err := vp.Prove(context.Background(), squareWitness(t, 4, 16))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(vres.Proof, `{"version":"v2"`), vres.Proof)
+}
+
+// TestServiceQueue the queue is bounded and a queued request can be cancelled
+func TestServiceQueue(t *testing.T) {
+	ccs, err := s3cross.BackendGroth16.Compile(&squareCircuit{})
+	assert.NoError(t, err)
+	pk, _, err := s3cross.BackendGroth16.Setup(ccs, nil, nil)
+	assert.NoError(t, err)
+
+	// no worker yet, one queue slot
+	p := &Service{
+		backend: s3cross.BackendGroth16,
+		ccs:     ccs,
+		pk:      pk,
+		jobs:    make(chan *proveJob, 1),
+		done:    make(chan struct{}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	queued := make(chan error, 1)
+	go func() {
+		_, err := p.Prove(ctx, squareWitness(t, 2, 4))
+		queued <- err
+	}()
+	assert.Eventually(t, func() bool { return len(p.jobs) == 1 }, time.Second, time.Millisecond)
+
+	_, err = p.Prove(context.Background(), squareWitness(t, 2, 4))
+	assert.ErrorIs(t, err, ErrQueueFull)
+
+	cancel()
+	assert.ErrorIs(t, <-queued, context.Canceled)
+
+	// the worker drops the cancelled request and proves the next one
+	p.wg.Add(1)
+	go p.work()
+	assert.Eventually(t, func() bool { return len(p.jobs) == 0 }, time.Second, time.Millisecond)
+	res, err := p.Prove(context.Background(), squareWitness(t, 2, 4))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Proof)
+	p.Close()
+}
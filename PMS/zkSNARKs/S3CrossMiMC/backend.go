@@ -0,0 +1,164 @@
+package s3cross
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark-crypto/kzg"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
+)
+
+// Backend the proof system of the pseudonym circuit, stored with the VK on the ledger
+type Backend string
+
+const (
+	BackendGroth16 Backend = "groth16" // circuit-specific trusted setup
+	BackendPlonk   Backend = "plonk"   // universal KZG SRS
+)
+
+// ProvingKey, VerifyingKey and Proof are implemented by both groth16 and plonk
+type ProvingKey interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+type VerifyingKey interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+type Proof interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+var ErrUnknownBackend = errors.New("unknown zkSNARK backend")
+
+// Compile compile the circuit with the r1cs (groth16) or scs (plonk) builder
+func (b Backend) Compile(circuit frontend.Circuit) (constraint.ConstraintSystem, error) {
+	switch b {
+	case BackendGroth16:
+		return frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+	case BackendPlonk:
+		return frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, circuit)
+	}
+	return nil, ErrUnknownBackend
+}
+
+// Setup generate the keys
+// srs, srsLagrange: the universal KZG SRS in canonical and Lagrange form (plonk only, nil for groth16)
+func (b Backend) Setup(ccs constraint.ConstraintSystem, srs, srsLagrange kzg.SRS) (ProvingKey, VerifyingKey, error) {
+	switch b {
+	case BackendGroth16:
+		return groth16.Setup(ccs)
+	case BackendPlonk:
+		if srs == nil || srsLagrange == nil {
+			return nil, nil, errors.New("plonk setup: missing KZG SRS")
+		}
+		return plonk.Setup(ccs, srs, srsLagrange)
+	}
+	return nil, nil, ErrUnknownBackend
+}
+
+func (b Backend) Prove(ccs constraint.ConstraintSystem, pk ProvingKey, fullWitness witness.Witness) (Proof, error) {
+	switch b {
+	case BackendGroth16:
+		gpk, ok := pk.(groth16.ProvingKey)
+		if !ok {
+			return nil, errors.New("groth16 prove: wrong proving key type")
+		}
+		return groth16.Prove(ccs, gpk, fullWitness)
+	case BackendPlonk:
+		ppk, ok := pk.(plonk.ProvingKey)
+		if !ok {
+			return nil, errors.New("plonk prove: wrong proving key type")
+		}
+		return plonk.Prove(ccs, ppk, fullWitness)
+	}
+	return nil, ErrUnknownBackend
+}
+
+func (b Backend) Verify(proof Proof, vk VerifyingKey, publicWitness witness.Witness) error {
+	switch b {
+	case BackendGroth16:
+		gProof, ok1 := proof.(groth16.Proof)
+		gvk, ok2 := vk.(groth16.VerifyingKey)
+		if !ok1 || !ok2 {
+			return errors.New("groth16 verify: wrong proof or verifying key type")
+		}
+		return groth16.Verify(gProof, gvk, publicWitness)
+	case BackendPlonk:
+		pProof, ok1 := proof.(plonk.Proof)
+		pvk, ok2 := vk.(plonk.VerifyingKey)
+		if !ok1 || !ok2 {
+			return errors.New("plonk verify: wrong proof or verifying key type")
+		}
+		return plonk.Verify(pProof, pvk, publicWitness)
+	}
+	return ErrUnknownBackend
+}
+
+// NewProvingKey, NewVerifyingKey and NewProof return empty objects to ReadFrom
+func (b Backend) NewProvingKey() (ProvingKey, error) {
+	switch b {
+	case BackendGroth16:
+		return groth16.NewProvingKey(ecc.BN254), nil
+	case BackendPlonk:
+		return plonk.NewProvingKey(ecc.BN254), nil
+	}
+	return nil, ErrUnknownBackend
+}
+
+func (b Backend) NewVerifyingKey() (VerifyingKey, error) {
+	switch b {
+	case BackendGroth16:
+		return groth16.NewVerifyingKey(ecc.BN254), nil
+	case BackendPlonk:
+		return plonk.NewVerifyingKey(ecc.BN254), nil
+	}
+	return nil, ErrUnknownBackend
+}
+
+func (b Backend) NewProof() (Proof, error) {
+	switch b {
+	case BackendGroth16:
+		return groth16.NewProof(ecc.BN254), nil
+	case BackendPlonk:
+		return plonk.NewProof(ecc.BN254), nil
+	}
+	return nil, ErrUnknownBackend
+}
+
+// LoadKZGSRS read the canonical and Lagrange SRS (e.g. converted from a public powers-of-tau ceremony)
+func LoadKZGSRS(srsFile, srsLagrangeFile string) (kzg.SRS, kzg.SRS, error) {
+	srs := new(kzg_bn254.SRS)
+	srsLagrange := new(kzg_bn254.SRS)
+	if err := readSRS(srsFile, srs); err != nil {
+		return nil, nil, err
+	}
+	if err := readSRS(srsLagrangeFile, srsLagrange); err != nil {
+		return nil, nil, err
+	}
+	return srs, srsLagrange, nil
+}
+
+func readSRS(file string, srs *kzg_bn254.SRS) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	_, err = srs.ReadFrom(f)
+	return err
+}
@@ -0,0 +1,224 @@
+package s3cross
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/kzg"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test/unsafekzg"
+	"github.com/stretchr/testify/assert"
+)
+
+var backends = []Backend{BackendGroth16, BackendPlonk}
+
+var hashes = []HashID{HashMiMC, HashPoseidon2}
+
+// hashConfig DefaultConfig with another hash
+func hashConfig(id HashID) Config {
+	cfg := DefaultConfig
+	cfg.Hash = id
+	return cfg
+}
+
+// genS3CrossWitness the circuit shape and a valid assignment
+func genS3CrossWitness(tb testing.TB, cfg Config) (*S3CrossCircuit, *S3CrossCircuit) {
+	curve := twistededwards.GetEdwardsCurve()
+
+	// nonce
+	nonce, err := getRandomPointAffine()
+	assert.NoError(tb, err)
+
+	// generate leaves (with a "0")
+	leaves := make([]*big.Int, numLeaves+1)
+	leaves[0] = big.NewInt(0)
+	leaves[numLeaves] = maxValue()
+	for i := 1; i < numLeaves; i++ {
+		leaves[i], err = rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(tb, err)
+	}
+
+	// Issuer
+	isk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(tb, err)
+	issuer := KeyPair{
+		Sk: isk,
+		Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, isk),
+	}
+	// Supervisor
+	ssk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(tb, err)
+	supervisor := KeyPair{
+		Sk: ssk,
+		Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, ssk),
+	}
+	// User
+	usk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(tb, err)
+	user := KeyPair{
+		Sk: usk,
+		Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, usk),
+	}
+	var sig *Signature
+	if cfg.Credential == SchemeEdDSA {
+		sig, err = issuer.SignEdDSA(cfg.Hash, user.Pk)
+	} else {
+		sig, _, err = issuer.SignWith(cfg.Hash, user.Pk)
+	}
+	assert.NoError(tb, err)
+	s3cross := S3Cross{
+		&user,
+		sig,
+	}
+
+	pw, err := s3cross.NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(3), supervisor.Pk)
+	assert.NoError(tb, err)
+
+	circuit, err := NewS3CrossCircuit(cfg)
+	assert.NoError(tb, err)
+	return circuit, pw.Assignment
+}
+
+// setupBackend compile and setup, the plonk SRS is generated with unsafekzg (test only)
+func setupBackend(tb testing.TB, b Backend, circuit frontend.Circuit) (constraint.ConstraintSystem, ProvingKey, VerifyingKey) {
+	ccs, err := b.Compile(circuit)
+	assert.NoError(tb, err)
+
+	var srs, srsLagrange kzg.SRS
+	if b == BackendPlonk {
+		srs, srsLagrange, err = unsafekzg.NewSRS(ccs)
+		assert.NoError(tb, err)
+	}
+	pk, vk, err := b.Setup(ccs, srs, srsLagrange)
+	assert.NoError(tb, err)
+	return ccs, pk, vk
+}
+
+func TestBackends(t *testing.T) {
+	for _, id := range hashes {
+		circuit, circuitWit := genS3CrossWitness(t, hashConfig(id))
+		secretWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+		assert.NoError(t, err)
+		publicWitness, err := secretWitness.Public()
+		assert.NoError(t, err)
+
+		for _, b := range backends {
+			ccs, pk, vk := setupBackend(t, b, circuit)
+			fmt.Println(id, b, "number of constraints:", ccs.GetNbConstraints())
+
+			proof, err := b.Prove(ccs, pk, secretWitness)
+			assert.NoError(t, err)
+
+			// serialization round trip, as the chaincode reads it
+			var buf bytes.Buffer
+			_, err = vk.WriteTo(&buf)
+			assert.NoError(t, err)
+			vk2, err := b.NewVerifyingKey()
+			assert.NoError(t, err)
+			_, err = vk2.ReadFrom(&buf)
+			assert.NoError(t, err)
+
+			buf.Reset()
+			_, err = proof.WriteTo(&buf)
+			assert.NoError(t, err)
+			proof2, err := b.NewProof()
+			assert.NoError(t, err)
+			_, err = proof2.ReadFrom(&buf)
+			assert.NoError(t, err)
+
+			assert.NoError(t, b.Verify(proof2, vk2, publicWitness))
+
+			// another public input
+			wrongWitness, err := wrongPublicWitness(publicWitness)
+			assert.NoError(t, err)
+			assert.Error(t, b.Verify(proof2, vk2, wrongWitness))
+		}
+	}
+}
+
+func wrongPublicWitness(publicWitness witness.Witness) (witness.Witness, error) {
+	data, err := publicWitness.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	if err = w.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	values := w.Vector().(fr.Vector)
+	values[len(values)-1].SetUint64(1)
+	return w, nil
+}
+
+func BenchmarkBackendSetup(b *testing.B) {
+	for _, id := range hashes {
+		circuit, _ := genS3CrossWitness(b, hashConfig(id))
+		for _, bk := range backends {
+			b.Run(string(id)+"/"+string(bk), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_, _, _ = setupBackend(b, bk, circuit)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkBackendProve also reports the number of constraints of each hash and backend
+func BenchmarkBackendProve(b *testing.B) {
+	for _, id := range hashes {
+		circuit, circuitWit := genS3CrossWitness(b, hashConfig(id))
+		secretWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+		assert.NoError(b, err)
+
+		for _, bk := range backends {
+			ccs, pk, _ := setupBackend(b, bk, circuit)
+			b.Run(string(id)+"/"+string(bk), func(b *testing.B) {
+				b.ReportAllocs()
+				b.ReportMetric(float64(ccs.GetNbConstraints()), "constraints")
+				for i := 0; i < b.N; i++ {
+					_, err = bk.Prove(ccs, pk, secretWitness)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkBackendVerify(b *testing.B) {
+	for _, id := range hashes {
+		circuit, circuitWit := genS3CrossWitness(b, hashConfig(id))
+		secretWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+		assert.NoError(b, err)
+		publicWitness, err := secretWitness.Public()
+		assert.NoError(b, err)
+
+		for _, bk := range backends {
+			ccs, pk, vk := setupBackend(b, bk, circuit)
+			proof, err := bk.Prove(ccs, pk, secretWitness)
+			assert.NoError(b, err)
+			b.Run(string(id)+"/"+string(bk), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					err = bk.Verify(proof, vk, publicWitness)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
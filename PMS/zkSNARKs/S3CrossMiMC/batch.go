@@ -0,0 +1,207 @@
+package s3cross
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/hash_to_field"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+)
+
+// BatchVerifyGroth16 verify k groth16 proofs of the same verifying key with one multi-pairing
+// (k+4 pairings plus one per BSB22 commitment instead of 3k+2k)
+// the pairing equations and the commitment proofs of knowledge are combined with random coefficients,
+// if the combined check fails every proof is verified alone to find the invalid ones
+// return one error per proof, nil if valid
+func BatchVerifyGroth16(vk groth16.VerifyingKey, proofs []groth16.Proof, publicWitnesses []witness.Witness) []error {
+	errs := make([]error, len(proofs))
+	if len(publicWitnesses) != len(proofs) {
+		for i := range errs {
+			errs[i] = errors.New("batch verify: proof and witness count mismatch")
+		}
+		return errs
+	}
+	bvk, ok := vk.(*groth16_bn254.VerifyingKey)
+	if !ok {
+		for i := range errs {
+			errs[i] = errors.New("batch verify: not a bn254 verifying key")
+		}
+		return errs
+	}
+
+	// the well-formed proofs
+	terms := make([]*batchTerm, 0, len(proofs))
+	for i := range proofs {
+		var t *batchTerm
+		t, errs[i] = newBatchTerm(bvk, proofs[i], publicWitnesses[i])
+		if errs[i] == nil {
+			t.index = i
+			terms = append(terms, t)
+		}
+	}
+	if len(terms) == 0 {
+		return errs
+	}
+
+	valid, err := batchPairingCheck(bvk, terms)
+	if err == nil && valid {
+		return errs
+	}
+	for _, t := range terms {
+		errs[t.index] = groth16.Verify(proofs[t.index], vk, publicWitnesses[t.index])
+	}
+	return errs
+}
+
+type batchTerm struct {
+	index     int
+	proof     *groth16_bn254.Proof
+	kSum      curve.G1Affine // Σx.[Kvk(t)]1 + commitments
+	challenge fr.Element     // folding coefficient of the commitments
+}
+
+// newBatchTerm the checks of groth16.Verify before the pairing
+func newBatchTerm(vk *groth16_bn254.VerifyingKey, proof groth16.Proof, publicWitness witness.Witness) (*batchTerm, error) {
+	p, ok := proof.(*groth16_bn254.Proof)
+	if !ok {
+		return nil, errors.New("batch verify: not a bn254 proof")
+	}
+	w, ok := publicWitness.Vector().(fr.Vector)
+	if !ok {
+		return nil, witness.ErrInvalidWitness
+	}
+	nbPublicVars := len(vk.G1.K) - len(vk.PublicAndCommitmentCommitted)
+	if len(w) != nbPublicVars-1 {
+		return nil, fmt.Errorf("invalid witness size, got %d, expected %d (public - ONE_WIRE)", len(w), nbPublicVars-1)
+	}
+	if len(p.Commitments) != len(vk.PublicAndCommitmentCommitted) {
+		return nil, errors.New("batch verify: wrong number of commitments")
+	}
+	if !p.Ar.IsInSubGroup() || !p.Krs.IsInSubGroup() || !p.Bs.IsInSubGroup() || !p.CommitmentPok.IsInSubGroup() {
+		return nil, errors.New("points in the proof are not in the correct subgroup")
+	}
+	for i := range p.Commitments {
+		if !p.Commitments[i].IsInSubGroup() {
+			return nil, errors.New("commitment subgroup check failed")
+		}
+	}
+
+	// commitment wires: hash of the commitment and its committed public inputs
+	w = slices.Clone(w)
+	htf := hash_to_field.New([]byte(constraint.CommitmentDst))
+	nbBuf := min(fr.Bytes, htf.Size())
+	commitmentsSerialized := make([]byte, 0, len(vk.PublicAndCommitmentCommitted)*fr.Bytes)
+	for i := range vk.PublicAndCommitmentCommitted {
+		htf.Reset()
+		htf.Write(p.Commitments[i].Marshal())
+		for _, j := range vk.PublicAndCommitmentCommitted[i] {
+			htf.Write(w[j-1].Marshal())
+		}
+		var res fr.Element
+		res.SetBytes(htf.Sum(nil)[:nbBuf])
+		w = append(w, res)
+		commitmentsSerialized = append(commitmentsSerialized, res.Marshal()...)
+	}
+
+	t := &batchTerm{proof: p}
+	if len(vk.CommitmentKeys) > 0 {
+		challenge, err := fr.Hash(commitmentsSerialized, []byte("G16-BSB22"), 1)
+		if err != nil {
+			return nil, err
+		}
+		t.challenge = challenge[0]
+	}
+
+	var kSum curve.G1Jac
+	if _, err := kSum.MultiExp(vk.G1.K[1:], w, ecc.MultiExpConfig{}); err != nil {
+		return nil, err
+	}
+	kSum.AddMixed(&vk.G1.K[0])
+	for i := range p.Commitments {
+		kSum.AddMixed(&p.Commitments[i])
+	}
+	t.kSum.FromJacobian(&kSum)
+	return t, nil
+}
+
+// batchPairingCheck with random r_i, s_i:
+// Π e(r_i·A_i, B_i) · e(Σ r_i·Krs_i, -δ) · e(Σ r_i·kSum_i, -γ) · e(-(Σ r_i)·α, β) = 1
+// Π_j e(Σ s_i·c_i^j·C_ij, -σ_j·G) · e(Σ s_i·Pok_i, G) = 1
+func batchPairingCheck(vk *groth16_bn254.VerifyingKey, terms []*batchTerm) (bool, error) {
+	for j := range vk.CommitmentKeys {
+		if vk.CommitmentKeys[j].G != vk.CommitmentKeys[0].G {
+			return false, errors.New("parameter mismatch: G2 element")
+		}
+	}
+
+	g1 := make([]curve.G1Affine, 0, len(terms)+4+len(vk.CommitmentKeys))
+	g2 := make([]curve.G2Affine, 0, len(terms)+4+len(vk.CommitmentKeys))
+	var krs, kSum, pok, tmp curve.G1Jac
+	commitments := make([]curve.G1Jac, len(vk.CommitmentKeys))
+	var rSum fr.Element
+	var rBig, coeffBig big.Int
+	for _, t := range terms {
+		var r, s fr.Element
+		if _, err := r.SetRandom(); err != nil {
+			return false, err
+		}
+		if _, err := s.SetRandom(); err != nil {
+			return false, err
+		}
+		r.BigInt(&rBig)
+		rSum.Add(&rSum, &r)
+
+		var ar curve.G1Affine
+		ar.ScalarMultiplication(&t.proof.Ar, &rBig)
+		g1 = append(g1, ar)
+		g2 = append(g2, t.proof.Bs)
+
+		tmp.FromAffine(&t.proof.Krs)
+		tmp.ScalarMultiplication(&tmp, &rBig)
+		krs.AddAssign(&tmp)
+		tmp.FromAffine(&t.kSum)
+		tmp.ScalarMultiplication(&tmp, &rBig)
+		kSum.AddAssign(&tmp)
+
+		// commitments, as pedersen.BatchVerifyMultiVk
+		coeff := s
+		for j := range t.proof.Commitments {
+			coeff.BigInt(&coeffBig)
+			tmp.FromAffine(&t.proof.Commitments[j])
+			tmp.ScalarMultiplication(&tmp, &coeffBig)
+			commitments[j].AddAssign(&tmp)
+			coeff.Mul(&coeff, &t.challenge)
+		}
+		s.BigInt(&coeffBig)
+		tmp.FromAffine(&t.proof.CommitmentPok)
+		tmp.ScalarMultiplication(&tmp, &coeffBig)
+		pok.AddAssign(&tmp)
+	}
+
+	var p curve.G1Affine
+	var q curve.G2Affine
+	g1 = append(g1, *p.FromJacobian(&krs))
+	g2 = append(g2, *q.Neg(&vk.G2.Delta))
+	g1 = append(g1, *p.FromJacobian(&kSum))
+	g2 = append(g2, *q.Neg(&vk.G2.Gamma))
+	p.ScalarMultiplication(&vk.G1.Alpha, rSum.BigInt(&rBig))
+	g1 = append(g1, *p.Neg(&p))
+	g2 = append(g2, vk.G2.Beta)
+	for j := range vk.CommitmentKeys {
+		g1 = append(g1, *p.FromJacobian(&commitments[j]))
+		g2 = append(g2, vk.CommitmentKeys[j].GSigmaNeg)
+	}
+	if len(vk.CommitmentKeys) > 0 {
+		g1 = append(g1, *p.FromJacobian(&pok))
+		g2 = append(g2, vk.CommitmentKeys[0].G)
+	}
+	return curve.PairingCheck(g1, g2)
+}
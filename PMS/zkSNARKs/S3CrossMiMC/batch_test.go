@@ -0,0 +1,104 @@
+package s3cross
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/stretchr/testify/assert"
+)
+
+// batchCircuit X*X == Y, with a range check on X when Commit is set (BSB22 commitment in the proof)
+type batchCircuit struct {
+	X      frontend.Variable
+	Y      frontend.Variable `gnark:",public"`
+	Commit bool              `gnark:"-"`
+}
+
+func (circuit *batchCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(circuit.X, circuit.X), circuit.Y)
+	if circuit.Commit {
+		rangecheck.New(api).Check(circuit.X, 16)
+	}
+	return nil
+}
+
+func genBatch(tb testing.TB, commit bool, k int) (constraint.ConstraintSystem, groth16.VerifyingKey, []groth16.Proof, []witness.Witness) {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &batchCircuit{Commit: commit})
+	assert.NoError(tb, err)
+	pk, vk, err := groth16.Setup(ccs)
+	assert.NoError(tb, err)
+
+	proofs := make([]groth16.Proof, k)
+	publicWitnesses := make([]witness.Witness, k)
+	for i := range k {
+		secretWitness, err := frontend.NewWitness(&batchCircuit{X: i + 2, Y: (i + 2) * (i + 2)}, ecc.BN254.ScalarField())
+		assert.NoError(tb, err)
+		publicWitnesses[i], err = secretWitness.Public()
+		assert.NoError(tb, err)
+		proofs[i], err = groth16.Prove(ccs, pk, secretWitness)
+		assert.NoError(tb, err)
+	}
+	return ccs, vk, proofs, publicWitnesses
+}
+
+func TestBatchVerifyGroth16(t *testing.T) {
+	for _, commit := range []bool{false, true} {
+		t.Run(fmt.Sprintf("commit=%v", commit), func(t *testing.T) {
+			_, vk, proofs, publicWitnesses := genBatch(t, commit, 5)
+
+			for i, err := range BatchVerifyGroth16(vk, proofs, publicWitnesses) {
+				assert.NoError(t, err, "proof %d", i)
+			}
+
+			// proof 1 with the public input of proof 3, proof 4 with the proof of proof 0
+			wits := append([]witness.Witness{}, publicWitnesses...)
+			wits[1] = publicWitnesses[3]
+			prfs := append([]groth16.Proof{}, proofs...)
+			prfs[4] = proofs[0]
+			errs := BatchVerifyGroth16(vk, prfs, wits)
+			for i, err := range errs {
+				if i == 1 || i == 4 {
+					assert.Error(t, err, "proof %d", i)
+				} else {
+					assert.NoError(t, err, "proof %d", i)
+				}
+			}
+
+			errs = BatchVerifyGroth16(vk, proofs, publicWitnesses[:4])
+			for _, err := range errs {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	for _, k := range []int{1, 8, 32} {
+		_, vk, proofs, publicWitnesses := genBatch(b, true, k)
+		b.Run(fmt.Sprintf("individual/k=%d", k), func(b *testing.B) {
+			for range b.N {
+				for i := range proofs {
+					if err := groth16.Verify(proofs[i], vk, publicWitnesses[i]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch/k=%d", k), func(b *testing.B) {
+			for range b.N {
+				for _, err := range BatchVerifyGroth16(vk, proofs, publicWitnesses) {
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
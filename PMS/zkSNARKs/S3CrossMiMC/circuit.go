@@ -1,27 +1,141 @@
 package s3cross
 
 import (
+	"errors"
+	"fmt"
+
 	twistededwards2 "github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark/frontend"
 	twistededwards1 "github.com/consensys/gnark/std/algebra/native/twistededwards"
-	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/hash"
 	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/signature/eddsa"
 	//"github.com/consensys/gnark/std/rangecheck"
 )
 
+// Config the shape of the pseudonym circuit, each config needs its own setup
+type Config struct {
+	Depth     int    `json:"depth"`     // depth of the ordered Merkle tree
+	IndexBits int    `json:"indexBits"` // max psu number is 2^IndexBits - 1
+	Hash      HashID `json:"hash"`
+	// signature scheme of the issuer credential, "" is the legacy schnorr
+	Credential CredentialScheme `json:"credential,omitempty"`
+	// pseudonyms per proof, 0 is the single pseudonym S3CrossCircuit, k > 0 the S3CrossMultiCircuit
+	Pseudonyms int `json:"pseudonyms,omitempty"`
+	// depth of the issuer set tree, 0 reveals the issuer key (IPk public),
+	// d > 0 is the S3CrossHiddenIssuerCircuit where IPk is a private leaf of the public IssuerRoot
+	IssuerDepth int `json:"issuerDepth,omitempty"`
+	// the credential signs a validity window (SignWithValidity), checked against the public CurrentTime
+	Expiry bool `json:"expiry,omitempty"`
+	// the revocation tree is an OrderedMerkleTree (one low leaf path) instead of the sorted leaves of GenNonMemProof
+	IndexedTree bool `json:"indexedTree,omitempty"`
+}
+
+// DefaultConfig the circuit of the testdata/PVK.text and the Caliper fixtures
+var DefaultConfig = Config{
+	Depth:     TreeDepth,
+	IndexBits: defaultIndexBits,
+	Hash:      HashMiMC,
+}
+
+const defaultIndexBits = 4
+
+var ErrInvalidConfig = errors.New("invalid circuit config")
+
+func (cfg Config) Validate() error {
+	if cfg.Depth <= 0 || cfg.Depth > 64 {
+		return fmt.Errorf("%w: depth should be in [1, 64]", ErrInvalidConfig)
+	}
+	if cfg.IndexBits <= 0 || cfg.IndexBits > 64 {
+		return fmt.Errorf("%w: index bits should be in [1, 64]", ErrInvalidConfig)
+	}
+	if _, err := cfg.Hash.New(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	if err := cfg.Credential.check(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	// k distinct indices in [1, 2^IndexBits - 1]
+	if cfg.Pseudonyms < 0 || (cfg.IndexBits < 63 && cfg.Pseudonyms > 1<<cfg.IndexBits-1) {
+		return fmt.Errorf("%w: pseudonyms should be in [0, 2^index bits - 1]", ErrInvalidConfig)
+	}
+	if cfg.IssuerDepth < 0 || cfg.IssuerDepth > 64 {
+		return fmt.Errorf("%w: issuer depth should be in [0, 64]", ErrInvalidConfig)
+	}
+	if cfg.IssuerDepth > 0 && cfg.Pseudonyms > 0 {
+		return fmt.Errorf("%w: the hidden issuer circuit has a single pseudonym", ErrInvalidConfig)
+	}
+	return nil
+}
+
+// NewCircuit the circuit shape of the config, S3CrossCircuit, S3CrossMultiCircuit or S3CrossHiddenIssuerCircuit
+func NewCircuit(cfg Config) (frontend.Circuit, error) {
+	if cfg.IssuerDepth > 0 {
+		return NewS3CrossHiddenIssuerCircuit(cfg)
+	}
+	if cfg.Pseudonyms > 0 {
+		return NewS3CrossMultiCircuit(cfg)
+	}
+	return NewS3CrossCircuit(cfg)
+}
+
+// PublicSchemaOf the public witness schema of the circuit of the config
+func PublicSchemaOf(cfg Config) (*PublicSchema, error) {
+	circuit, err := NewCircuit(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewPublicSchema(circuit)
+}
+
+// NewS3CrossCircuit the circuit shape (to compile) of the config,
+// the witness assignment only needs the same slice lengths
+func NewS3CrossCircuit(cfg Config) (*S3CrossCircuit, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Pseudonyms > 0 {
+		return nil, fmt.Errorf("%w: %d pseudonyms is a S3CrossMultiCircuit", ErrInvalidConfig, cfg.Pseudonyms)
+	}
+	if cfg.IssuerDepth > 0 {
+		return nil, fmt.Errorf("%w: a hidden issuer is a S3CrossHiddenIssuerCircuit", ErrInvalidConfig)
+	}
+	path1, path2 := nonMembershipPaths(cfg)
+	return &S3CrossCircuit{
+		ProofElements1: path1,
+		ProofElements2: path2,
+		Validity:       validityWindows(cfg),
+		IndexBits:      cfg.IndexBits,
+		Hash:           cfg.Hash,
+		Credential:     cfg.Credential,
+		IndexedTree:    cfg.IndexedTree,
+	}, nil
+}
+
+// nonMembershipPaths the Merkle path fields of a circuit shape, none right of the low leaf for an indexed tree
+func nonMembershipPaths(cfg Config) ([]frontend.Variable, []frontend.Variable) {
+	if cfg.IndexedTree {
+		return make([]frontend.Variable, cfg.Depth), []frontend.Variable{}
+	}
+	return make([]frontend.Variable, cfg.Depth), make([]frontend.Variable, cfg.Depth)
+}
+
+// S3CrossCircuit the public inputs are read by name (PublicSchema), the positions below are informative
 type S3CrossCircuit struct {
 	// ordered Merkle tree proof
 	Root frontend.Variable `gnark:",public"` // 0
 	// // left path
 	ProofElements1 []frontend.Variable // private
 	ProofIndex1    frontend.Variable   // private
-	Leaf1          frontend.Variable   `gnark:",public"` // 1 // mimc hash of the public key
-	// // right node
-	Leaf2 frontend.Variable `gnark:",public"` // 2 // mimc hash of the public key
+	Leaf1          frontend.Variable   // private // hash of the public key
+	// // right path, at ProofIndex1+1
+	ProofElements2 []frontend.Variable // private
+	Leaf2          frontend.Variable   // private // hash of the public key
+	// // (IndexedTree: the leaf at ProofIndex1 is H(Leaf1, Leaf2), no right path)
 
-	// schnorr proof
-	IPkX frontend.Variable `gnark:",public"` // 3
-	IPkY frontend.Variable `gnark:",public"` // 4
+	// credential signature
+	IPkX frontend.Variable `gnark:",public"` // 1
+	IPkY frontend.Variable `gnark:",public"` // 2
 
 	Sig frontend.Variable // private
 	RX  frontend.Variable // private
@@ -31,25 +145,37 @@ type S3CrossCircuit struct {
 	MessageY frontend.Variable // private
 
 	// psu proof
-	PPkX  frontend.Variable `gnark:",public"` // 5
-	PPkY  frontend.Variable `gnark:",public"` // 6
-	Nonce frontend.Variable `gnark:",public"` // 7 // current nonce for SR, should mod curve.Order
+	PPkX  frontend.Variable `gnark:",public"` // 3
+	PPkY  frontend.Variable `gnark:",public"` // 4
+	Nonce frontend.Variable `gnark:",public"` // 5 // current nonce for SR, should mod curve.Order
 	//MaxI  frontend.Variable `gnark:",public"` // max psu number
 	USk frontend.Variable // private
 	I   frontend.Variable // private
 
 	// elgamal proof
-	SPkX frontend.Variable `gnark:",public"` // 8
-	SPkY frontend.Variable `gnark:",public"` // 9
-	C1X  frontend.Variable `gnark:",public"` // 10
-	C1Y  frontend.Variable `gnark:",public"` // 11
-	C2X  frontend.Variable `gnark:",public"` // 12
-	C2Y  frontend.Variable `gnark:",public"` // 13
+	SPkX frontend.Variable `gnark:",public"` // 6
+	SPkY frontend.Variable `gnark:",public"` // 7
+	C1X  frontend.Variable `gnark:",public"` // 8
+	C1Y  frontend.Variable `gnark:",public"` // 9
+	C2X  frontend.Variable `gnark:",public"` // 10
+	C2Y  frontend.Variable `gnark:",public"` // 11
 	R    frontend.Variable // private
+
+	// credential validity window, one with Config.Expiry (public Validity_0_CurrentTime), none otherwise
+	Validity []ValidityWindow
+
+	// circuit config, not part of the witness
+	IndexBits   int              `gnark:"-"` // 0 is defaultIndexBits
+	Hash        HashID           `gnark:"-"` // hash of the tree, the credential challenge and the pseudonym
+	Credential  CredentialScheme `gnark:"-"` // "" is the legacy schnorr
+	IndexedTree bool             `gnark:"-"`
 }
 
 func (circuit *S3CrossCircuit) Define(api frontend.API) error {
-	const numBits = 4 // max psu number is 2^numBits - 1
+	numBits := circuit.IndexBits // max psu number is 2^numBits - 1
+	if numBits == 0 {
+		numBits = defaultIndexBits
+	}
 
 	curve, err := twistededwards1.NewEdCurve(api, twistededwards2.BN254)
 	if err != nil {
@@ -60,88 +186,205 @@ func (circuit *S3CrossCircuit) Define(api frontend.API) error {
 		Y: curve.Params().Base[1],
 	}
 
-	h, err := mimc.NewMiMC(api)
+	h, err := circuit.Hash.NewCircuitHasher(api)
 	if err != nil {
 		return err
 	}
 
 	// check Merkle proof
-	// // path 1
-	hashed := circuit.Leaf1
-	depth := len(circuit.ProofElements1)
-	proofIndices := api.ToBinary(circuit.ProofIndex1, depth)
-	for i := 0; i < depth; i++ {
-		sibling := circuit.ProofElements1[i]
-		index := proofIndices[i]
-
-		left := api.Select(index, sibling, hashed)
-		right := api.Select(index, hashed, sibling)
-
-		h.Reset()
-		h.Write(left, right)
-		hashed = h.Sum()
-	}
-	api.AssertIsEqual(hashed, circuit.Root)
-
 	upk := curve.ScalarMul(base, circuit.USk)
 	h.Reset()
 	h.Write(upk.X, upk.Y)
 	hUpk := h.Sum()
-	// // // ProofIndex1 < upk < ProofIndex2
-	api.AssertIsLessOrEqual(api.Add(circuit.Leaf1, 1), hUpk)
-	api.AssertIsLessOrEqual(api.Add(hUpk, 1), circuit.Leaf2)
+	assertNonMembership(api, h, circuit.IndexedTree, circuit.Root, hUpk, circuit.Leaf1, circuit.Leaf2, circuit.ProofIndex1, circuit.ProofElements1, circuit.ProofElements2)
+
+	// check credential signature and validity
+	window, err := credentialWindow(circuit.Validity)
+	if err != nil {
+		return err
+	}
+	if err = assertCredential(api, circuit.Credential, curve, h, circuit.IPkX, circuit.IPkY, circuit.RX, circuit.RY, circuit.Sig, circuit.MessageX, circuit.MessageY, upk, window); err != nil {
+		return err
+	}
+	assertValidity(api, circuit.Validity)
+
+	// check pseudonym
+	// // I > 0
+	api.AssertIsDifferent(circuit.I, 0)
+
+	rc := rangecheck.New(api)
+	rc.Check(circuit.I, numBits)
+
+	AssertPseudonym(api, curve, h, circuit.USk, circuit.Nonce, circuit.I, circuit.PPkX, circuit.PPkY)
+
+	// check elgamal
+	spk := twistededwards1.Point{
+		X: circuit.SPkX,
+		Y: circuit.SPkY,
+	}
+	AssertElGamal(api, curve, spk, upk, circuit.R, circuit.C1X, circuit.C1Y, circuit.C2X, circuit.C2Y)
+
+	return nil
+}
+
+// AssertCredential check the legacy schnorr signature of the issuer IPk on the user public key upk
+// g^{Sig} = R·IPk^c, c = H(IPk, R, Message), Message = upk
+func AssertCredential(api frontend.API, curve twistededwards1.Curve, h hash.FieldHasher, ipkX, ipkY, rX, rY, sig, messageX, messageY frontend.Variable, upk twistededwards1.Point) {
+	assertSchnorrCredential(api, curve, h, ipkX, ipkY, rX, rY, sig, messageX, messageY, upk, nil)
+}
 
-	// check schnorr signature
+// assertSchnorrCredential AssertCredential of a credential that also signs window, c = H(IPk, R, Message, window)
+func assertSchnorrCredential(api frontend.API, curve twistededwards1.Curve, h hash.FieldHasher, ipkX, ipkY, rX, rY, sig, messageX, messageY frontend.Variable, upk twistededwards1.Point, window []frontend.Variable) {
+	base := twistededwards1.Point{
+		X: curve.Params().Base[0],
+		Y: curve.Params().Base[1],
+	}
 	IPk := twistededwards1.Point{
-		X: circuit.IPkX,
-		Y: circuit.IPkY,
+		X: ipkX,
+		Y: ipkY,
 	}
 	R := twistededwards1.Point{
-		X: circuit.RX,
-		Y: circuit.RY,
+		X: rX,
+		Y: rY,
 	}
 	h.Reset()
-	h.Write(IPk.X, IPk.Y, R.X, R.Y, circuit.MessageX, circuit.MessageY)
+	h.Write(IPk.X, IPk.Y, R.X, R.Y, messageX, messageY)
+	h.Write(window...)
 	c := h.Sum()
 	// // g^{Sig} = R·X^c
-	S := curve.ScalarMul(base, circuit.Sig)
+	S := curve.ScalarMul(base, sig)
 	Xc := curve.ScalarMul(IPk, c)
 	RXc := curve.Add(R, Xc)
 	api.AssertIsEqual(S.X, RXc.X)
 	api.AssertIsEqual(S.Y, RXc.Y)
 	// // Message = upk
-	api.AssertIsEqual(upk.X, circuit.MessageX)
-	api.AssertIsEqual(upk.Y, circuit.MessageY)
+	api.AssertIsEqual(upk.X, messageX)
+	api.AssertIsEqual(upk.Y, messageY)
+}
 
-	// check pseudonym
-	// // I > 0
-	api.AssertIsDifferent(circuit.I, 0)
+// AssertEdDSACredential check the standard EdDSA signature (Sig, R) of the issuer IPk on H(Message), Message = upk
+// the native side is KeyPair.SignEdDSA or NewEdDSASignature
+func AssertEdDSACredential(api frontend.API, curve twistededwards1.Curve, h hash.FieldHasher, ipkX, ipkY, rX, rY, sig, messageX, messageY frontend.Variable, upk twistededwards1.Point) error {
+	return assertEdDSACredential(api, curve, h, ipkX, ipkY, rX, rY, sig, messageX, messageY, upk, nil)
+}
 
-	rc := rangecheck.New(api)
-	rc.Check(circuit.I, numBits)
+// assertEdDSACredential AssertEdDSACredential of a credential that also signs window, on H(Message, window)
+func assertEdDSACredential(api frontend.API, curve twistededwards1.Curve, h hash.FieldHasher, ipkX, ipkY, rX, rY, sig, messageX, messageY frontend.Variable, upk twistededwards1.Point, window []frontend.Variable) error {
+	h.Reset()
+	h.Write(messageX, messageY)
+	h.Write(window...)
+	msg := h.Sum()
+	h.Reset()
+	err := eddsa.Verify(curve, eddsa.Signature{
+		R: twistededwards1.Point{X: rX, Y: rY},
+		S: sig,
+	}, msg, eddsa.PublicKey{
+		A: twistededwards1.Point{X: ipkX, Y: ipkY},
+	}, h)
+	if err != nil {
+		return err
+	}
+	// // Message = upk
+	api.AssertIsEqual(upk.X, messageX)
+	api.AssertIsEqual(upk.Y, messageY)
+	return nil
+}
 
+// assertCredential the credential check of the scheme, window: the signed validity window words, if any
+func assertCredential(api frontend.API, scheme CredentialScheme, curve twistededwards1.Curve, h hash.FieldHasher, ipkX, ipkY, rX, rY, sig, messageX, messageY frontend.Variable, upk twistededwards1.Point, window []frontend.Variable) error {
+	switch scheme {
+	case "", SchemeSchnorr:
+		assertSchnorrCredential(api, curve, h, ipkX, ipkY, rX, rY, sig, messageX, messageY, upk, window)
+		return nil
+	case SchemeEdDSA:
+		return assertEdDSACredential(api, curve, h, ipkX, ipkY, rX, rY, sig, messageX, messageY, upk, window)
+	}
+	return ErrUnknownScheme
+}
+
+// AssertPseudonym check ppk = g^{1/(usk + H(nonce, i))}, the range of i is checked by the caller
+func AssertPseudonym(api frontend.API, curve twistededwards1.Curve, h hash.FieldHasher, usk, nonce, i, ppkX, ppkY frontend.Variable) {
+	base := twistededwards1.Point{
+		X: curve.Params().Base[0],
+		Y: curve.Params().Base[1],
+	}
 	h.Reset()
-	h.Write(circuit.Nonce, circuit.I)
+	h.Write(nonce, i)
 	hOut := h.Sum()
-	psk := api.Inverse(api.Add(circuit.USk, hOut))
+	psk := api.Inverse(api.Add(usk, hOut))
 	ppk := curve.ScalarMul(base, psk)
 
-	api.AssertIsEqual(ppk.X, circuit.PPkX)
-	api.AssertIsEqual(ppk.Y, circuit.PPkY)
+	api.AssertIsEqual(ppk.X, ppkX)
+	api.AssertIsEqual(ppk.Y, ppkY)
+}
 
-	// check elgamal
-	spk := twistededwards1.Point{
-		X: circuit.SPkX,
-		Y: circuit.SPkY,
+// AssertElGamal check (C1, C2) = (g^r, spk^r·upk), the encryption of upk for the supervisor
+func AssertElGamal(api frontend.API, curve twistededwards1.Curve, spk, upk twistededwards1.Point, r, c1X, c1Y, c2X, c2Y frontend.Variable) {
+	base := twistededwards1.Point{
+		X: curve.Params().Base[0],
+		Y: curve.Params().Base[1],
 	}
-	C1_ := curve.ScalarMul(base, circuit.R)
-	C2_ := curve.ScalarMul(spk, circuit.R)
+	C1_ := curve.ScalarMul(base, r)
+	C2_ := curve.ScalarMul(spk, r)
 	C2_ = curve.Add(C2_, upk)
 
-	api.AssertIsEqual(circuit.C1X, C1_.X)
-	api.AssertIsEqual(circuit.C1Y, C1_.Y)
-	api.AssertIsEqual(circuit.C2X, C2_.X)
-	api.AssertIsEqual(circuit.C2Y, C2_.Y)
+	api.AssertIsEqual(c1X, C1_.X)
+	api.AssertIsEqual(c1Y, C1_.Y)
+	api.AssertIsEqual(c2X, C2_.X)
+	api.AssertIsEqual(c2Y, C2_.Y)
+}
 
-	return nil
+// AssertNonMembership check that value is not a leaf of the ordered Merkle tree
+// leaf1 and leaf2 are adjacent (at index1 and index1+1) with leaf1 < value < leaf2,
+// both leaves and paths stay private so only the root is revealed
+func AssertNonMembership(api frontend.API, h hash.FieldHasher, root, value, leaf1, leaf2, index1 frontend.Variable, path1, path2 []frontend.Variable) {
+	depth := len(path1)
+
+	// // path 1
+	indices1 := api.ToBinary(index1, depth)
+	api.AssertIsEqual(MerkleRoot(api, h, leaf1, path1, indices1), root)
+	// // path 2, ToBinary also rejects index1+1 = 2^depth
+	indices2 := api.ToBinary(api.Add(index1, 1), depth)
+	api.AssertIsEqual(MerkleRoot(api, h, leaf2, path2, indices2), root)
+
+	// // leaf1 < value < leaf2
+	api.AssertIsLessOrEqual(api.Add(leaf1, 1), value)
+	api.AssertIsLessOrEqual(api.Add(value, 1), leaf2)
+}
+
+// AssertIndexedNonMembership check value is not in the OrderedMerkleTree of root:
+// the leaf at index1 is H(low, next) and low < value < next
+func AssertIndexedNonMembership(api frontend.API, h hash.FieldHasher, root, value, low, next, index1 frontend.Variable, path []frontend.Variable) {
+	h.Reset()
+	h.Write(low, next)
+	leaf := h.Sum()
+	indices := api.ToBinary(index1, len(path))
+	api.AssertIsEqual(MerkleRoot(api, h, leaf, path, indices), root)
+
+	api.AssertIsLessOrEqual(api.Add(low, 1), value)
+	api.AssertIsLessOrEqual(api.Add(value, 1), next)
+}
+
+// assertNonMembership AssertIndexedNonMembership or AssertNonMembership of the revocation tree of the circuit
+func assertNonMembership(api frontend.API, h hash.FieldHasher, indexed bool, root, value, leaf1, leaf2, index1 frontend.Variable, path1, path2 []frontend.Variable) {
+	if indexed {
+		AssertIndexedNonMembership(api, h, root, value, leaf1, leaf2, index1, path1)
+		return
+	}
+	AssertNonMembership(api, h, root, value, leaf1, leaf2, index1, path1, path2)
+}
+
+// MerkleRoot recompute the root from a leaf and its siblings
+// indices: the leaf index in little-endian bits
+func MerkleRoot(api frontend.API, h hash.FieldHasher, leaf frontend.Variable, path, indices []frontend.Variable) frontend.Variable {
+	hashed := leaf
+	for i := 0; i < len(path); i++ {
+		left := api.Select(indices[i], path[i], hashed)
+		right := api.Select(indices[i], hashed, path[i])
+
+		h.Reset()
+		h.Write(left, right)
+		hashed = h.Sum()
+	}
+	return hashed
 }
@@ -0,0 +1,109 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+)
+
+// DecryptionProof Chaum-Pedersen (DLEQ) proof that the supervisor key opened (C1, C2) to M:
+// log_G(SPk) = log_C1(C2 - M), i.e. C2 - M = sk·C1 for the sk of the published SPk,
+// or for a threshold key that the partial decryption D_j = sk_j·C1 matches the verification key of supervisor j
+type DecryptionProof struct {
+	C *big.Int `json:"c"`
+	S *big.Int `json:"s"`
+}
+
+// ProveDecryption the proof of sk for spk = sk·G that c2 - m = sk·c1
+func ProveDecryption(sk *big.Int, spk, c1, c2, m *twistededwards.PointAffine) (*DecryptionProof, error) {
+	return ProveDLEQ(sk, spk, c1, decryptionShare(c2, m))
+}
+
+// VerifyDecryption check that m is the decryption of (c1, c2) under the supervisor key spk
+func VerifyDecryption(spk, c1, c2, m *twistededwards.PointAffine, proof *DecryptionProof) error {
+	for _, p := range []*twistededwards.PointAffine{c1, c2, m} {
+		if !inSubgroup(p) {
+			return errors.New("verify decryption: not a point of the subgroup")
+		}
+	}
+	return VerifyDLEQ(spk, c1, decryptionShare(c2, m), proof)
+}
+
+// inSubgroup p is a curve point of the prime order subgroup, [order]·p = 0: the curve has cofactor 8 and
+// SetBytes only checks the curve equation, the small order part T of a point would vanish in c·T for
+// the challenges c it divides, so a forged M + T or D + T verifies for half of the tries
+func inSubgroup(p *twistededwards.PointAffine) bool {
+	if p == nil || !p.IsOnCurve() {
+		return false
+	}
+	curve := twistededwards.GetEdwardsCurve()
+	return new(twistededwards.PointAffine).ScalarMultiplication(p, &curve.Order).IsZero()
+}
+
+// decryptionShare D = C2 - M
+func decryptionShare(c2, m *twistededwards.PointAffine) *twistededwards.PointAffine {
+	D := new(twistededwards.PointAffine).Neg(m)
+	return D.Add(c2, D)
+}
+
+// ProveDLEQ Chaum-Pedersen proof of log_G(pk) = log_base(d) = sk
+func ProveDLEQ(sk *big.Int, pk, base, d *twistededwards.PointAffine) (*DecryptionProof, error) {
+	curve := twistededwards.GetEdwardsCurve()
+
+	// random mask
+	k, err := rand.Int(rand.Reader, &curve.Order)
+	if err != nil {
+		return nil, errors.New("prove dleq: failed to generate random mask -- " + err.Error())
+	}
+	T1 := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, k)
+	T2 := new(twistededwards.PointAffine).ScalarMultiplication(base, k)
+
+	c := dleqChallenge(pk, base, d, T1, T2)
+	// s = k + c·sk
+	s := new(big.Int).Add(k, new(big.Int).Mul(c, sk))
+	s.Mod(s, &curve.Order)
+	return &DecryptionProof{
+		C: c,
+		S: s,
+	}, nil
+}
+
+// VerifyDLEQ check the proof of log_G(pk) = log_base(d)
+func VerifyDLEQ(pk, base, d *twistededwards.PointAffine, proof *DecryptionProof) error {
+	curve := twistededwards.GetEdwardsCurve()
+	if proof == nil || proof.C == nil || proof.S == nil {
+		return errors.New("verify dleq: missing proof")
+	}
+	for _, p := range []*twistededwards.PointAffine{pk, base, d} {
+		if !inSubgroup(p) {
+			return errors.New("verify dleq: not a point of the subgroup")
+		}
+	}
+
+	// T1 = s·G - c·pk, T2 = s·base - c·d
+	T1 := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, proof.S)
+	T1.Add(T1, new(twistededwards.PointAffine).Neg(new(twistededwards.PointAffine).ScalarMultiplication(pk, proof.C)))
+	T2 := new(twistededwards.PointAffine).ScalarMultiplication(base, proof.S)
+	T2.Add(T2, new(twistededwards.PointAffine).Neg(new(twistededwards.PointAffine).ScalarMultiplication(d, proof.C)))
+
+	c := dleqChallenge(pk, base, d, T1, T2)
+	if c.Cmp(proof.C) != 0 {
+		return errors.New("verify dleq: invalid proof")
+	}
+	return nil
+}
+
+// dleqChallenge c = H(G, pk, base, d, T1, T2) mod l
+func dleqChallenge(pk, base, d, T1, T2 *twistededwards.PointAffine) *big.Int {
+	curve := twistededwards.GetEdwardsCurve()
+	hs := sha256.New()
+	hs.Write([]byte("s3cross/dleq"))
+	for _, p := range []*twistededwards.PointAffine{&curve.Base, pk, base, d, T1, T2} {
+		hs.Write(p.Marshal())
+	}
+	c := new(big.Int).SetBytes(hs.Sum(nil))
+	return c.Mod(c, &curve.Order)
+}
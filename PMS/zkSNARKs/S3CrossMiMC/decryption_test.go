@@ -0,0 +1,97 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecryptWithProof(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	supervisor, other, user := newKeyPair(), newKeyPair(), newKeyPair()
+
+	ct, _, err := EncryptElGamal(user.Pk, supervisor.Pk)
+	assert.NoError(t, err)
+	upk, proof, err := supervisor.DecryptWithProof(ct)
+	assert.NoError(t, err)
+	assert.True(t, upk.Equal(user.Pk))
+	assert.NoError(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, upk, proof))
+
+	// the json of the chaincode
+	data, err := json.Marshal(proof)
+	assert.NoError(t, err)
+	var decoded DecryptionProof
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.NoError(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, upk, &decoded))
+
+	// another user, another supervisor key, another ciphertext
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, other.Pk, proof))
+	assert.Error(t, VerifyDecryption(other.Pk, ct.C1, ct.C2, upk, proof))
+	ct2, _, err := EncryptElGamal(user.Pk, supervisor.Pk)
+	assert.NoError(t, err)
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct2.C1, ct2.C2, upk, proof))
+
+	// a wrong key gives a wrong upk, its proof does not verify against the published key
+	wrong, wrongProof, err := other.DecryptWithProof(ct)
+	assert.NoError(t, err)
+	assert.False(t, wrong.Equal(user.Pk))
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, wrong, wrongProof))
+
+	tampered := &DecryptionProof{C: proof.C, S: new(big.Int).Add(proof.S, big.NewInt(1))}
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, upk, tampered))
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, upk, nil))
+	var offCurve twistededwards.PointAffine
+	offCurve.X.SetOne()
+	offCurve.Y.SetOne()
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, &offCurve, proof))
+
+	// upk plus a point of order 2, with a proof of an even challenge
+	D := decryptionShare(ct.C2, upk)
+	forgedD, forged := forgeTorsion(t, supervisor.Sk, supervisor.Pk, ct.C1, D)
+	forgedUpk := new(twistededwards.PointAffine).Add(upk, torsion())
+	assert.True(t, decryptionShare(ct.C2, forgedUpk).Equal(forgedD))
+	assert.False(t, inSubgroup(forgedUpk))
+	assert.Error(t, VerifyDecryption(supervisor.Pk, ct.C1, ct.C2, forgedUpk, forged))
+	assert.Error(t, VerifyDLEQ(supervisor.Pk, ct.C1, forgedD, forged))
+	assert.Error(t, VerifyDecryption(supervisor.Pk, new(twistededwards.PointAffine).Add(ct.C1, torsion()), ct.C2, upk, proof))
+}
+
+// torsion the point (0, -1) of order 2
+func torsion() *twistededwards.PointAffine {
+	var T twistededwards.PointAffine
+	T.Y.SetOne()
+	T.Y.Neg(&T.Y)
+	return &T
+}
+
+// forgeTorsion a DLEQ proof of sk for d + T (T of order 2), retried until its challenge c is even,
+// c·T vanishes and the proof passes the equations of VerifyDLEQ, only the subgroup check rejects it
+func forgeTorsion(t *testing.T, sk *big.Int, pk, base, d *twistededwards.PointAffine) (*twistededwards.PointAffine, *DecryptionProof) {
+	curve := twistededwards.GetEdwardsCurve()
+	dT := new(twistededwards.PointAffine).Add(d, torsion())
+	for {
+		proof, err := ProveDLEQ(sk, pk, base, dT)
+		assert.NoError(t, err)
+		if proof.C.Bit(0) != 0 {
+			continue
+		}
+		T1 := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, proof.S)
+		T1.Add(T1, new(twistededwards.PointAffine).Neg(new(twistededwards.PointAffine).ScalarMultiplication(pk, proof.C)))
+		T2 := new(twistededwards.PointAffine).ScalarMultiplication(base, proof.S)
+		T2.Add(T2, new(twistededwards.PointAffine).Neg(new(twistededwards.PointAffine).ScalarMultiplication(dT, proof.C)))
+		assert.Equal(t, proof.C, dleqChallenge(pk, base, dT, T1, T2))
+		return dT, proof
+	}
+}
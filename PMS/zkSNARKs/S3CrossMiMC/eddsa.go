@@ -0,0 +1,149 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
+)
+
+// SignEdDSA sign the credential of message with the standard EdDSA,
+// the hash id is both the EdDSA hash and the hash of the message (see CredentialMessage)
+func (kp *KeyPair) SignEdDSA(id HashID, message *twistededwards.PointAffine) (*Signature, error) {
+	return kp.signEdDSA(id, message, nil)
+}
+
+// SignEdDSAWithValidity SignEdDSA a credential valid in the window v only
+func (kp *KeyPair) SignEdDSAWithValidity(id HashID, message *twistededwards.PointAffine, v Validity) (*Signature, error) {
+	if err := v.check(); err != nil {
+		return nil, err
+	}
+	return kp.signEdDSA(id, message, &v)
+}
+
+func (kp *KeyPair) signEdDSA(id HashID, message *twistededwards.PointAffine, v *Validity) (*Signature, error) {
+	priv, err := kp.eddsaPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := CredentialMessageWithValidity(id, message, v)
+	if err != nil {
+		return nil, err
+	}
+	hFunc, err := id.New()
+	if err != nil {
+		return nil, err
+	}
+	sigBin, err := priv.Sign(msg, hFunc)
+	if err != nil {
+		return nil, errors.New("sign eddsa -- " + err.Error())
+	}
+	return newEdDSASignature(id, kp.Pk, message, v, sigBin)
+}
+
+// eddsaPrivateKey the gnark-crypto key of Sk, Pk = Sk·G, the nonce source is random
+func (kp *KeyPair) eddsaPrivateKey() (*eddsa.PrivateKey, error) {
+	curve := twistededwards.GetEdwardsCurve()
+	if kp.Sk == nil || kp.Pk == nil || kp.Sk.Sign() <= 0 || kp.Sk.Cmp(&curve.Order) >= 0 {
+		return nil, errors.New("sign eddsa: invalid key pair")
+	}
+	// pk || scalar (big endian) || random source
+	buf := make([]byte, 3*fr.Bytes)
+	pk := kp.Pk.Bytes()
+	copy(buf, pk[:])
+	kp.Sk.FillBytes(buf[fr.Bytes : 2*fr.Bytes])
+	if _, err := rand.Read(buf[2*fr.Bytes:]); err != nil {
+		return nil, err
+	}
+	priv := new(eddsa.PrivateKey)
+	if _, err := priv.SetBytes(buf); err != nil {
+		return nil, errors.New("sign eddsa -- " + err.Error())
+	}
+	return priv, nil
+}
+
+// CredentialMessage the message an EdDSA issuer signs for the user public key upk,
+// H(upk.X || upk.Y) is one field element as gnark-crypto eddsa expects, and the leaf hash of upk in the tree
+func CredentialMessage(id HashID, upk *twistededwards.PointAffine) ([]byte, error) {
+	return CredentialMessageWithValidity(id, upk, nil)
+}
+
+// CredentialMessageWithValidity H(upk.X || upk.Y || notBefore || notAfter), CredentialMessage without a window
+func CredentialMessageWithValidity(id HashID, upk *twistededwards.PointAffine, v *Validity) ([]byte, error) {
+	if upk == nil {
+		return nil, errors.New("credential message: missing user public key")
+	}
+	return id.hashBytes(append([][]byte{upk.X.Marshal(), upk.Y.Marshal()}, v.message()...)...)
+}
+
+// NewEdDSASignature the credential of an EdDSA signature made by other tooling (an HSM, ...)
+// sigBin: eddsa.Signature.Bytes of CredentialMessage(id, upk) under the issuer key ipk, hashed with id
+func NewEdDSASignature(id HashID, ipk, upk *twistededwards.PointAffine, sigBin []byte) (*Signature, error) {
+	return newEdDSASignature(id, ipk, upk, nil, sigBin)
+}
+
+// NewEdDSASignatureWithValidity NewEdDSASignature of CredentialMessageWithValidity(id, upk, &v)
+func NewEdDSASignatureWithValidity(id HashID, ipk, upk *twistededwards.PointAffine, v Validity, sigBin []byte) (*Signature, error) {
+	return newEdDSASignature(id, ipk, upk, &v, sigBin)
+}
+
+func newEdDSASignature(id HashID, ipk, upk *twistededwards.PointAffine, v *Validity, sigBin []byte) (*Signature, error) {
+	var sig eddsa.Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return nil, errors.New("new eddsa signature -- " + err.Error())
+	}
+	s := &Signature{
+		Sig:      new(big.Int).SetBytes(sig.S[:]),
+		R:        new(twistededwards.PointAffine).Set(&sig.R),
+		M:        upk,
+		SPk:      ipk,
+		Hash:     id,
+		Scheme:   SchemeEdDSA,
+		Validity: v,
+	}
+	if err := s.Verify(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// verifyEdDSA cofactor·S·G = cofactor·(R + H(R || A || H(m))·A)
+func (s *Signature) verifyEdDSA() error {
+	curve := twistededwards.GetEdwardsCurve()
+	if s.Sig == nil || s.R == nil || s.SPk == nil {
+		return errors.New("invalid signature")
+	}
+	// S < l, the circuit works mod r
+	if s.Sig.Sign() < 0 || s.Sig.Cmp(&curve.Order) >= 0 {
+		return errors.New("invalid signature")
+	}
+	if s.Validity != nil {
+		if err := s.Validity.check(); err != nil {
+			return err
+		}
+	}
+	msg, err := CredentialMessageWithValidity(s.Hash, s.M, s.Validity)
+	if err != nil {
+		return err
+	}
+	hFunc, err := s.Hash.New()
+	if err != nil {
+		return err
+	}
+
+	var sig eddsa.Signature
+	sig.R.Set(s.R)
+	s.Sig.FillBytes(sig.S[:])
+	pub := eddsa.PublicKey{A: *s.SPk}
+	ok, err := pub.Verify(sig.Bytes(), msg, hFunc)
+	if err != nil {
+		return errors.New("verify eddsa -- " + err.Error())
+	}
+	if !ok {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSignEdDSA the credential of KeyPair.SignEdDSA and of an external EdDSA key verify as standard EdDSA signatures
+func TestSignEdDSA(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	issuer, user := newKeyPair(), newKeyPair()
+
+	for _, id := range hashes {
+		sig, err := issuer.SignEdDSA(id, user.Pk)
+		assert.NoError(t, err)
+		assert.Equal(t, SchemeEdDSA, sig.Scheme)
+		assert.NoError(t, sig.Verify())
+
+		// the gnark-crypto verifier accepts it
+		msg, err := CredentialMessage(id, user.Pk)
+		assert.NoError(t, err)
+		var std eddsa.Signature
+		std.R.Set(sig.R)
+		sig.Sig.FillBytes(std.S[:])
+		hFunc, err := id.New()
+		assert.NoError(t, err)
+		pub := eddsa.PublicKey{A: *issuer.Pk}
+		ok, err := pub.Verify(std.Bytes(), msg, hFunc)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		// an issuer key of the eddsa package, e.g. from an HSM
+		priv, err := eddsa.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		hFunc, err = id.New()
+		assert.NoError(t, err)
+		sigBin, err := priv.Sign(msg, hFunc)
+		assert.NoError(t, err)
+		ext, err := NewEdDSASignature(id, &priv.PublicKey.A, user.Pk, sigBin)
+		assert.NoError(t, err)
+		assert.NoError(t, ext.Verify())
+		_, err = NewEdDSASignature(id, issuer.Pk, user.Pk, sigBin)
+		assert.Error(t, err, "signed by another key")
+	}
+
+	sig, err := issuer.SignEdDSA(HashPoseidon2, user.Pk)
+	assert.NoError(t, err)
+	// verified as a schnorr signature, with another hash, on another message
+	legacy := *sig
+	legacy.Scheme = SchemeSchnorr
+	assert.Error(t, legacy.Verify())
+	other := *sig
+	other.Hash = HashMiMC
+	assert.Error(t, other.Verify())
+	other = *sig
+	other.M = issuer.Pk
+	assert.Error(t, other.Verify())
+	other = *sig
+	other.Sig = new(big.Int).Add(sig.Sig, &curve.Order)
+	assert.Error(t, other.Verify(), "S >= l")
+	other = *sig
+	other.Scheme = "ecdsa"
+	assert.ErrorIs(t, other.Verify(), ErrUnknownScheme)
+}
+
+// TestEdDSACredentialCircuit the circuits check the credential of their scheme
+func TestEdDSACredentialCircuit(t *testing.T) {
+	for _, id := range hashes {
+		cfg := Config{Depth: 10, IndexBits: 4, Hash: id, Credential: SchemeEdDSA}
+		circuit, circuitWit := genS3CrossWitness(t, cfg)
+		assert.NoError(t, test.IsSolved(circuit, circuitWit, ecc.BN254.ScalarField()))
+
+		// an EdDSA credential in the legacy circuit
+		legacy, err := NewS3CrossCircuit(Config{Depth: 10, IndexBits: 4, Hash: id})
+		assert.NoError(t, err)
+		legacyWit := *circuitWit
+		legacyWit.Credential = ""
+		assert.Error(t, test.IsSolved(legacy, &legacyWit, ecc.BN254.ScalarField()))
+
+		// a schnorr credential in the EdDSA circuit
+		_, schnorrWit := genS3CrossWitness(t, Config{Depth: 10, IndexBits: 4, Hash: id})
+		schnorrWit.Credential = SchemeEdDSA
+		assert.Error(t, test.IsSolved(circuit, schnorrWit, ecc.BN254.ScalarField()))
+
+		multiCfg := cfg
+		multiCfg.Pseudonyms = 2
+		multi, multiWit := genS3CrossMultiWitness(t, multiCfg, []int64{2, 9})
+		assert.NoError(t, test.IsSolved(multi, multiWit, ecc.BN254.ScalarField()))
+
+		for _, scheme := range []CredentialScheme{SchemeSchnorr, SchemeEdDSA} {
+			circuit, err := NewS3CrossCircuit(Config{Depth: TreeDepth, IndexBits: defaultIndexBits, Hash: id, Credential: scheme})
+			assert.NoError(t, err)
+			ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+			assert.NoError(t, err)
+			fmt.Println(id, scheme, "number of constraints:", ccs.GetNbConstraints())
+		}
+	}
+
+	_, err := NewCircuit(Config{Depth: 10, IndexBits: 4, Hash: HashMiMC, Credential: "ecdsa"})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
@@ -2,6 +2,7 @@ package s3cross
 
 import (
 	"crypto/rand"
+	"errors"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
 	"math/big"
 )
@@ -34,3 +35,19 @@ func (kp *KeyPair) Decrypt(eg *ElGamal) (*twistededwards.PointAffine, error) {
 	M := new(twistededwards.PointAffine).Add(eg.C2, new(twistededwards.PointAffine).Neg(ind))
 	return M, nil
 }
+
+// DecryptWithProof decrypt upk and prove it with the supervisor key (VerifyDecryption against the published SPK)
+func (kp *KeyPair) DecryptWithProof(eg *ElGamal) (*twistededwards.PointAffine, *DecryptionProof, error) {
+	if eg == nil || eg.C1 == nil || eg.C2 == nil {
+		return nil, nil, errors.New("decrypt with proof: missing ciphertext")
+	}
+	M, err := kp.Decrypt(eg)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := ProveDecryption(kp.Sk, kp.Pk, eg.C1, eg.C2, M)
+	if err != nil {
+		return nil, nil, err
+	}
+	return M, proof, nil
+}
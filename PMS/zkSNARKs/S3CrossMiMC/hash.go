@@ -0,0 +1,90 @@
+package s3cross
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	stdhash "github.com/consensys/gnark/std/hash"
+	stdmimc "github.com/consensys/gnark/std/hash/mimc"
+	stdposeidon2 "github.com/consensys/gnark/std/permutation/poseidon2"
+)
+
+// HashID the hash of the Merkle tree, the credential challenge, the pseudonym and the circuit,
+// stored with the tree and the VK on the ledger. "" is MiMC (the default of older proofs)
+type HashID string
+
+const (
+	HashMiMC      HashID = "mimc"
+	HashPoseidon2 HashID = "poseidon2" // Merkle-Damgard over the Poseidon2 permutation
+)
+
+var ErrUnknownHash = errors.New("unknown hash function")
+
+// New the native hash, consistent with NewCircuitHasher
+func (id HashID) New() (hash.Hash, error) {
+	switch id {
+	case "", HashMiMC:
+		return mimc.NewMiMC(), nil
+	case HashPoseidon2:
+		return poseidon2.NewMerkleDamgardHasher(), nil
+	}
+	return nil, ErrUnknownHash
+}
+
+// NewCircuitHasher the in-circuit hash
+func (id HashID) NewCircuitHasher(api frontend.API) (stdhash.FieldHasher, error) {
+	switch id {
+	case "", HashMiMC:
+		h, err := stdmimc.NewMiMC(api)
+		if err != nil {
+			return nil, err
+		}
+		return &h, nil
+	case HashPoseidon2:
+		// std/hash/poseidon2 has no BN254 defaults yet, use the gnark-crypto ones (width 2, IV 0)
+		params := poseidon2.GetDefaultParameters()
+		f, err := stdposeidon2.NewPoseidon2FromParameters(api, 2, params.NbFullRounds, params.NbPartialRounds)
+		if err != nil {
+			return nil, err
+		}
+		return stdhash.NewMerkleDamgardHasher(api, f, 0), nil
+	}
+	return nil, ErrUnknownHash
+}
+
+// String "" is reported as mimc
+func (id HashID) String() string {
+	if id == "" {
+		return string(HashMiMC)
+	}
+	return string(id)
+}
+
+// NonceHash H(nonce.X, nonce.Y), the public Nonce of the pseudonyms of the nonce point
+// (compared by the chaincode with the nonce of the accepted epochs)
+func (id HashID) NonceHash(nonce *twistededwards.PointAffine) (*big.Int, error) {
+	ncBytes, err := id.hashBytes(nonce.X.Marshal(), nonce.Y.Marshal())
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(ncBytes), nil
+}
+
+// hashBytes H(data[0] || data[1] || ...)
+func (id HashID) hashBytes(data ...[]byte) ([]byte, error) {
+	h, err := id.New()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range data {
+		if _, err = h.Write(d); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
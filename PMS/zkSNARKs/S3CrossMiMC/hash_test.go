@@ -0,0 +1,78 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type hashCircuit struct {
+	A, B   frontend.Variable
+	Digest frontend.Variable `gnark:",public"`
+	Hash   HashID            `gnark:"-"`
+}
+
+func (circuit *hashCircuit) Define(api frontend.API) error {
+	h, err := circuit.Hash.NewCircuitHasher(api)
+	if err != nil {
+		return err
+	}
+	h.Write(circuit.A, circuit.B)
+	api.AssertIsEqual(h.Sum(), circuit.Digest)
+	return nil
+}
+
+// TestHashConsistency the native hash and the circuit hash give the same digest
+func TestHashConsistency(t *testing.T) {
+	ast := test.NewAssert(t)
+	for _, id := range hashes {
+		a, err := rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(t, err)
+		b, err := rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(t, err)
+
+		digest, err := id.hashBytes(a.FillBytes(make([]byte, fr.Bytes)), b.FillBytes(make([]byte, fr.Bytes)))
+		assert.NoError(t, err)
+
+		ast.ProverSucceeded(&hashCircuit{Hash: id}, &hashCircuit{
+			A:      a,
+			B:      b,
+			Digest: new(big.Int).SetBytes(digest),
+			Hash:   id,
+		}, test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16))
+	}
+
+	_, err := HashID("sha256").New()
+	assert.ErrorIs(t, err, ErrUnknownHash)
+}
+
+// TestSignWith the challenge hash is recorded in the signature and checked by Verify
+func TestSignWith(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	sk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(t, err)
+	kp := KeyPair{
+		Sk: sk,
+		Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+	}
+	for _, id := range hashes {
+		sig, _, err := kp.SignWith(id, kp.Pk)
+		assert.NoError(t, err)
+		assert.Equal(t, id, sig.Hash)
+		assert.NoError(t, sig.Verify())
+	}
+
+	// verified with another hash
+	sig, _, err := kp.SignWith(HashPoseidon2, kp.Pk)
+	assert.NoError(t, err)
+	sig.Hash = HashMiMC
+	assert.Error(t, sig.Verify())
+}
@@ -0,0 +1,148 @@
+package s3cross
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+)
+
+// NewS3CrossHiddenIssuerCircuit the circuit shape of a config with IssuerDepth > 0
+func NewS3CrossHiddenIssuerCircuit(cfg Config) (*S3CrossHiddenIssuerCircuit, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.IssuerDepth <= 0 {
+		return nil, fmt.Errorf("%w: a S3CrossHiddenIssuerCircuit needs an issuer depth", ErrInvalidConfig)
+	}
+	path1, path2 := nonMembershipPaths(cfg)
+	return &S3CrossHiddenIssuerCircuit{
+		ProofElements1: path1,
+		ProofElements2: path2,
+		IssuerPath:     make([]frontend.Variable, cfg.IssuerDepth),
+		Validity:       validityWindows(cfg),
+		IndexBits:      cfg.IndexBits,
+		Hash:           cfg.Hash,
+		Credential:     cfg.Credential,
+		IndexedTree:    cfg.IndexedTree,
+	}, nil
+}
+
+// S3CrossHiddenIssuerCircuit the S3CrossCircuit with a private issuer key, proven to be a leaf of the issuer set
+// (IssuerSet), so the users of all the issuers of the set share one anonymity set
+// public witness: Root 0, IssuerRoot 1, PPk 2-3, Nonce 4, SPk 5-6, C1 7-8, C2 9-10, then the CurrentTime of Config.Expiry
+type S3CrossHiddenIssuerCircuit struct {
+	// ordered Merkle tree proof
+	Root frontend.Variable `gnark:",public"`
+	// // left path
+	ProofElements1 []frontend.Variable // private
+	ProofIndex1    frontend.Variable   // private
+	Leaf1          frontend.Variable   // private // hash of the public key
+	// // right path, at ProofIndex1+1
+	ProofElements2 []frontend.Variable // private
+	Leaf2          frontend.Variable   // private // hash of the public key
+
+	// issuer set proof, the leaf is H(IPk)
+	IssuerRoot  frontend.Variable   `gnark:",public"`
+	IssuerPath  []frontend.Variable // private
+	IssuerIndex frontend.Variable   // private
+
+	// credential signature, of any issuer of the set
+	IPkX frontend.Variable // private
+	IPkY frontend.Variable // private
+
+	Sig frontend.Variable // private
+	RX  frontend.Variable // private
+	RY  frontend.Variable // private
+
+	MessageX frontend.Variable // private
+	MessageY frontend.Variable // private
+
+	// psu proof
+	PPkX  frontend.Variable `gnark:",public"`
+	PPkY  frontend.Variable `gnark:",public"`
+	Nonce frontend.Variable `gnark:",public"`
+	USk   frontend.Variable // private
+	I     frontend.Variable // private
+
+	// elgamal proof
+	SPkX frontend.Variable `gnark:",public"`
+	SPkY frontend.Variable `gnark:",public"`
+	C1X  frontend.Variable `gnark:",public"`
+	C1Y  frontend.Variable `gnark:",public"`
+	C2X  frontend.Variable `gnark:",public"`
+	C2Y  frontend.Variable `gnark:",public"`
+	R    frontend.Variable // private
+
+	// credential validity window, one with Config.Expiry
+	Validity []ValidityWindow
+
+	// circuit config, not part of the witness
+	IndexBits   int              `gnark:"-"` // 0 is defaultIndexBits
+	Hash        HashID           `gnark:"-"` // hash of both trees, the credential challenge and the pseudonym
+	Credential  CredentialScheme `gnark:"-"` // "" is the legacy schnorr
+	IndexedTree bool             `gnark:"-"` // ProofElements2 is empty, see S3CrossCircuit
+}
+
+func (circuit *S3CrossHiddenIssuerCircuit) Define(api frontend.API) error {
+	h, err := circuit.Hash.NewCircuitHasher(api)
+	if err != nil {
+		return err
+	}
+
+	// check the issuer is in the set
+	AssertIssuerMembership(api, h, circuit.IssuerRoot, circuit.IPkX, circuit.IPkY, circuit.IssuerIndex, circuit.IssuerPath)
+
+	// the checks of the S3CrossCircuit, with the private issuer key
+	return circuit.s3cross().Define(api)
+}
+
+// s3cross the S3CrossCircuit of the same variables
+func (circuit *S3CrossHiddenIssuerCircuit) s3cross() *S3CrossCircuit {
+	return &S3CrossCircuit{
+		Root:           circuit.Root,
+		ProofElements1: circuit.ProofElements1,
+		ProofIndex1:    circuit.ProofIndex1,
+		Leaf1:          circuit.Leaf1,
+		ProofElements2: circuit.ProofElements2,
+		Leaf2:          circuit.Leaf2,
+
+		IPkX:     circuit.IPkX,
+		IPkY:     circuit.IPkY,
+		Sig:      circuit.Sig,
+		RX:       circuit.RX,
+		RY:       circuit.RY,
+		MessageX: circuit.MessageX,
+		MessageY: circuit.MessageY,
+
+		PPkX:  circuit.PPkX,
+		PPkY:  circuit.PPkY,
+		Nonce: circuit.Nonce,
+		USk:   circuit.USk,
+		I:     circuit.I,
+
+		SPkX: circuit.SPkX,
+		SPkY: circuit.SPkY,
+		C1X:  circuit.C1X,
+		C1Y:  circuit.C1Y,
+		C2X:  circuit.C2X,
+		C2Y:  circuit.C2Y,
+		R:    circuit.R,
+
+		Validity: circuit.Validity,
+
+		IndexBits:   circuit.IndexBits,
+		Hash:        circuit.Hash,
+		Credential:  circuit.Credential,
+		IndexedTree: circuit.IndexedTree,
+	}
+}
+
+// AssertIssuerMembership check that H(ipk) is the leaf at index of the issuer set tree of root
+func AssertIssuerMembership(api frontend.API, h hash.FieldHasher, root, ipkX, ipkY, index frontend.Variable, path []frontend.Variable) {
+	h.Reset()
+	h.Write(ipkX, ipkY)
+	leaf := h.Sum()
+	indices := api.ToBinary(index, len(path))
+	api.AssertIsEqual(MerkleRoot(api, h, leaf, path, indices), root)
+}
@@ -0,0 +1,99 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHiddenIssuerCircuit(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	cfg := Config{Depth: 10, IndexBits: 4, Hash: HashMiMC, IssuerDepth: 3}
+
+	nonce, err := getRandomPointAffine()
+	assert.NoError(t, err)
+	leaves := []*big.Int{maxValue(), big.NewInt(0)}
+	for i := 0; i < 30; i++ {
+		leaf, err := rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	issuers := []*KeyPair{newKeyPair(), newKeyPair(), newKeyPair()}
+	outsider, supervisor := newKeyPair(), newKeyPair()
+	set, err := NewIssuerSet(cfg.Hash, cfg.IssuerDepth, []*twistededwards.PointAffine{issuers[0].Pk, issuers[1].Pk, issuers[2].Pk})
+	assert.NoError(t, err)
+
+	circuit, err := NewCircuit(cfg)
+	assert.NoError(t, err)
+	schema, err := PublicSchemaOf(cfg)
+	assert.NoError(t, err)
+	assert.True(t, schema.HiddenIssuer())
+
+	// users of two issuers of the set prove against the same public inputs
+	var wit *S3CrossHiddenIssuerCircuit
+	for _, issuer := range []*KeyPair{issuers[0], issuers[2]} {
+		user := newKeyPair()
+		sig, _, err := issuer.SignWith(cfg.Hash, user.Pk)
+		assert.NoError(t, err)
+		s3cross := &S3Cross{user, sig}
+		pw, err := s3cross.NewHiddenIssuerWitness(cfg, set, leaves, nonce, big.NewInt(2), supervisor.Pk)
+		assert.NoError(t, err)
+		assert.NoError(t, test.IsSolved(circuit, pw.HiddenIssuer, ecc.BN254.ScalarField()))
+
+		in, err := schema.Read(pw.PublicWitness)
+		assert.NoError(t, err)
+		root := in.IssuerRoot()
+		assert.Equal(t, set.Root(), root.Marshal())
+		assert.True(t, in.HiddenIssuer())
+		assert.Equal(t, twistededwards.PointAffine{}, in.IssuerPK())
+		wit = pw.HiddenIssuer
+	}
+
+	// another issuer set root
+	wrongRoot := *wit
+	wrongRoot.IssuerRoot = 1
+	assert.Error(t, test.IsSolved(circuit, &wrongRoot, ecc.BN254.ScalarField()))
+
+	// an issuer outside of the set
+	user := newKeyPair()
+	sig, _, err := outsider.SignWith(cfg.Hash, user.Pk)
+	assert.NoError(t, err)
+	_, err = (&S3Cross{user, sig}).NewHiddenIssuerWitness(cfg, set, leaves, nonce, big.NewInt(2), supervisor.Pk)
+	assert.ErrorIs(t, err, ErrInvalidWitnessInput)
+	smaller, err := NewIssuerSet(cfg.Hash, 2, set.Keys)
+	assert.NoError(t, err)
+	_, err = (&S3Cross{user, sig}).NewHiddenIssuerWitness(cfg, smaller, leaves, nonce, big.NewInt(2), supervisor.Pk)
+	assert.ErrorIs(t, err, ErrInvalidWitnessInput)
+
+	// the configs of the other circuits
+	_, err = (&S3Cross{user, sig}).NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(2), supervisor.Pk)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = NewS3CrossCircuit(cfg)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	multi := cfg
+	multi.Pseudonyms = 2
+	_, err = NewCircuit(multi)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+
+	// the issuer set
+	_, err = NewIssuerSet(cfg.Hash, 1, set.Keys)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = NewIssuerSet(cfg.Hash, 3, []*twistededwards.PointAffine{issuers[0].Pk, issuers[0].Pk})
+	assert.Error(t, err)
+	_, err = set.Proof(outsider.Pk)
+	assert.ErrorIs(t, err, ErrUnknownIssuer)
+}
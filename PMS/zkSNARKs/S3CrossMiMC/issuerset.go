@@ -0,0 +1,109 @@
+package s3cross
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+)
+
+var ErrUnknownIssuer = errors.New("issuer is not in the issuer set")
+
+// IssuerSet the Merkle tree of the accepted issuer public keys, its root is the ISSUER_ROOT of the ledger
+// a leaf is H(IPk.X, IPk.Y), the empty leaves are 0 (no known key hashes to it)
+type IssuerSet struct {
+	Hash  HashID
+	Depth int
+	Keys  []*twistededwards.PointAffine
+
+	leaves [][]byte
+	dl     [][]byte
+	nodes  map[int]map[int][]byte
+	root   []byte
+}
+
+// NewIssuerSet the tree of keys (in this order) for a Config.IssuerDepth of depth
+func NewIssuerSet(id HashID, depth int, keys []*twistededwards.PointAffine) (*IssuerSet, error) {
+	if depth <= 0 || depth > 64 {
+		return nil, fmt.Errorf("%w: issuer depth should be in [1, 64]", ErrInvalidConfig)
+	}
+	if len(keys) == 0 || (depth < 63 && len(keys) > 1<<depth) {
+		return nil, fmt.Errorf("%w: %d issuers for a tree of depth %d", ErrInvalidConfig, len(keys), depth)
+	}
+	set := &IssuerSet{
+		Hash:   id,
+		Depth:  depth,
+		Keys:   keys,
+		leaves: make([][]byte, len(keys)),
+	}
+	for i, ipk := range keys {
+		if ipk == nil || !ipk.IsOnCurve() {
+			return nil, fmt.Errorf("issuer %d is not a curve point", i)
+		}
+		for _, prev := range keys[:i] {
+			if prev.Equal(ipk) {
+				return nil, fmt.Errorf("issuer %d is repeated", i)
+			}
+		}
+		leaf, err := issuerLeaf(id, ipk)
+		if err != nil {
+			return nil, err
+		}
+		set.leaves[i] = leaf
+	}
+	dl, err := issuerDefaultLevels(id, depth)
+	if err != nil {
+		return nil, err
+	}
+	set.dl = dl
+	if set.nodes, err = BuildPartialTree(id, set.leaves, set.dl); err != nil {
+		return nil, err
+	}
+	if set.root, err = CalcRoot(id, set.leaves, set.dl); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// issuerDefaultLevels the empty subtree of each level, from the leaf 0
+func issuerDefaultLevels(id HashID, depth int) ([][]byte, error) {
+	dl := make([][]byte, depth)
+	dl[0] = make([]byte, fr.Bytes)
+	for i := 1; i < depth; i++ {
+		node, err := id.hashBytes(dl[i-1], dl[i-1])
+		if err != nil {
+			return nil, err
+		}
+		dl[i] = node
+	}
+	return dl, nil
+}
+
+// issuerLeaf H(IPk.X, IPk.Y), as hashed by AssertIssuerMembership
+func issuerLeaf(id HashID, ipk *twistededwards.PointAffine) ([]byte, error) {
+	return id.hashBytes(ipk.X.Marshal(), ipk.Y.Marshal())
+}
+
+// Root the issuer set root
+func (set *IssuerSet) Root() []byte {
+	return set.root
+}
+
+// Proof the Merkle path of the issuer key ipk
+func (set *IssuerSet) Proof(ipk *twistededwards.PointAffine) (*MerkleProof, error) {
+	for i, key := range set.Keys {
+		if !key.Equal(ipk) {
+			continue
+		}
+		return &MerkleProof{
+			Root:  set.Root(),
+			Proof: MerkleProofSiblings(set.nodes, set.dl, i),
+			Index: i,
+			Leaf:  new(big.Int).SetBytes(set.leaves[i]),
+			Hash:  set.Hash,
+		}, nil
+	}
+	return nil, ErrUnknownIssuer
+}
@@ -0,0 +1,146 @@
+package s3cross
+
+import (
+	"fmt"
+
+	twistededwards2 "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	twistededwards1 "github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// NewS3CrossMultiCircuit the circuit shape of a config with Pseudonyms = k > 0
+func NewS3CrossMultiCircuit(cfg Config) (*S3CrossMultiCircuit, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Pseudonyms <= 0 {
+		return nil, fmt.Errorf("%w: a S3CrossMultiCircuit needs at least 1 pseudonym", ErrInvalidConfig)
+	}
+	path1, path2 := nonMembershipPaths(cfg)
+	return &S3CrossMultiCircuit{
+		ProofElements1: path1,
+		ProofElements2: path2,
+		Psus:           make([]PseudonymOutput, cfg.Pseudonyms),
+		Validity:       validityWindows(cfg),
+		IndexBits:      cfg.IndexBits,
+		Hash:           cfg.Hash,
+		Credential:     cfg.Credential,
+		IndexedTree:    cfg.IndexedTree,
+	}, nil
+}
+
+// S3CrossMultiCircuit issue k pseudonyms of the same nonce with one proof,
+// the Merkle paths and the credential signature are checked once for all of them
+// public witness: Root 0, IPk 1-2, Nonce 3, SPk 4-5, then 6 values per pseudonym j at 6+6j: PPk, C1, C2,
+// then the CurrentTime of Config.Expiry
+type S3CrossMultiCircuit struct {
+	// ordered Merkle tree proof
+	Root frontend.Variable `gnark:",public"` // 0
+	// // left path
+	ProofElements1 []frontend.Variable // private
+	ProofIndex1    frontend.Variable   // private
+	Leaf1          frontend.Variable   // private // hash of the public key
+	// // right path, at ProofIndex1+1
+	ProofElements2 []frontend.Variable // private
+	Leaf2          frontend.Variable   // private // hash of the public key
+
+	// credential signature
+	IPkX frontend.Variable `gnark:",public"` // 1
+	IPkY frontend.Variable `gnark:",public"` // 2
+
+	Sig frontend.Variable // private
+	RX  frontend.Variable // private
+	RY  frontend.Variable // private
+
+	MessageX frontend.Variable // private
+	MessageY frontend.Variable // private
+
+	// psu proof
+	Nonce frontend.Variable `gnark:",public"` // 3
+	USk   frontend.Variable // private
+
+	// elgamal proof
+	SPkX frontend.Variable `gnark:",public"` // 4
+	SPkY frontend.Variable `gnark:",public"` // 5
+
+	Psus []PseudonymOutput
+
+	// credential validity window, one with Config.Expiry
+	Validity []ValidityWindow
+
+	// circuit config, not part of the witness
+	IndexBits   int              `gnark:"-"` // 0 is defaultIndexBits
+	Hash        HashID           `gnark:"-"`
+	Credential  CredentialScheme `gnark:"-"` // "" is the legacy schnorr
+	IndexedTree bool             `gnark:"-"` // ProofElements2 is empty, see S3CrossCircuit
+}
+
+// PseudonymOutput one pseudonym of the S3CrossMultiCircuit with its own ElGamal ciphertext of upk
+type PseudonymOutput struct {
+	PPkX frontend.Variable `gnark:",public"`
+	PPkY frontend.Variable `gnark:",public"`
+	C1X  frontend.Variable `gnark:",public"`
+	C1Y  frontend.Variable `gnark:",public"`
+	C2X  frontend.Variable `gnark:",public"`
+	C2Y  frontend.Variable `gnark:",public"`
+	I    frontend.Variable // private
+	R    frontend.Variable // private, fresh for each ciphertext
+}
+
+func (circuit *S3CrossMultiCircuit) Define(api frontend.API) error {
+	numBits := circuit.IndexBits // max psu number is 2^numBits - 1
+	if numBits == 0 {
+		numBits = defaultIndexBits
+	}
+
+	curve, err := twistededwards1.NewEdCurve(api, twistededwards2.BN254)
+	if err != nil {
+		return err
+	}
+	base := twistededwards1.Point{
+		X: curve.Params().Base[0],
+		Y: curve.Params().Base[1],
+	}
+
+	h, err := circuit.Hash.NewCircuitHasher(api)
+	if err != nil {
+		return err
+	}
+
+	// check Merkle proof
+	upk := curve.ScalarMul(base, circuit.USk)
+	h.Reset()
+	h.Write(upk.X, upk.Y)
+	hUpk := h.Sum()
+	assertNonMembership(api, h, circuit.IndexedTree, circuit.Root, hUpk, circuit.Leaf1, circuit.Leaf2, circuit.ProofIndex1, circuit.ProofElements1, circuit.ProofElements2)
+
+	// check credential signature and validity
+	window, err := credentialWindow(circuit.Validity)
+	if err != nil {
+		return err
+	}
+	if err = assertCredential(api, circuit.Credential, curve, h, circuit.IPkX, circuit.IPkY, circuit.RX, circuit.RY, circuit.Sig, circuit.MessageX, circuit.MessageY, upk, window); err != nil {
+		return err
+	}
+	assertValidity(api, circuit.Validity)
+
+	// check pseudonyms
+	// // 0 < I_0 < I_1 < ... < 2^numBits, so the indices are distinct and in range
+	rc := rangecheck.New(api)
+	spk := twistededwards1.Point{
+		X: circuit.SPkX,
+		Y: circuit.SPkY,
+	}
+	var prev frontend.Variable = 0
+	for _, psu := range circuit.Psus {
+		rc.Check(psu.I, numBits)
+		rc.Check(api.Sub(psu.I, prev, 1), numBits)
+		prev = psu.I
+
+		AssertPseudonym(api, curve, h, circuit.USk, circuit.Nonce, psu.I, psu.PPkX, psu.PPkY)
+		AssertElGamal(api, curve, spk, upk, psu.R, psu.C1X, psu.C1Y, psu.C2X, psu.C2Y)
+	}
+
+	return nil
+}
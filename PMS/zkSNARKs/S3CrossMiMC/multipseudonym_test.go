@@ -0,0 +1,154 @@
+package s3cross
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// genS3CrossMultiWitness the circuit shape and an assignment of the indices is,
+// the credential and the Merkle paths are those of genS3CrossWitness
+func genS3CrossMultiWitness(tb testing.TB, cfg Config, is []int64) (*S3CrossMultiCircuit, *S3CrossMultiCircuit) {
+	curve := twistededwards.GetEdwardsCurve()
+	single := cfg
+	single.Pseudonyms = 0
+	_, sw := genS3CrossWitness(tb, single)
+
+	usk := sw.USk.(*big.Int)
+	nc := sw.Nonce.(*big.Int)
+	upk := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, usk)
+	spk := twistededwards.PointAffine{
+		X: sw.SPkX.(fr.Element),
+		Y: sw.SPkY.(fr.Element),
+	}
+
+	circuit, err := NewS3CrossMultiCircuit(cfg)
+	assert.NoError(tb, err)
+	circuitWit := &S3CrossMultiCircuit{
+		Root:           sw.Root,
+		ProofElements1: sw.ProofElements1,
+		ProofIndex1:    sw.ProofIndex1,
+		Leaf1:          sw.Leaf1,
+		ProofElements2: sw.ProofElements2,
+		Leaf2:          sw.Leaf2,
+
+		IPkX:     sw.IPkX,
+		IPkY:     sw.IPkY,
+		Sig:      sw.Sig,
+		RX:       sw.RX,
+		RY:       sw.RY,
+		MessageX: sw.MessageX,
+		MessageY: sw.MessageY,
+
+		Nonce: nc,
+		USk:   usk,
+		SPkX:  sw.SPkX,
+		SPkY:  sw.SPkY,
+
+		Psus:       make([]PseudonymOutput, len(is)),
+		IndexBits:  cfg.IndexBits,
+		Hash:       cfg.Hash,
+		Credential: cfg.Credential,
+	}
+	for j, i := range is {
+		// out of order or repeated indices are assigned as is, NewPseudonyms would reject them
+		psu, err := GenPsuWith(cfg.Hash, usk, big.NewInt(i), nc)
+		assert.NoError(tb, err)
+		ct, r, err := EncryptElGamal(upk, &spk)
+		assert.NoError(tb, err)
+		circuitWit.Psus[j] = PseudonymOutput{
+			PPkX: psu.Pk.X,
+			PPkY: psu.Pk.Y,
+			C1X:  ct.C1.X,
+			C1Y:  ct.C1.Y,
+			C2X:  ct.C2.X,
+			C2Y:  ct.C2.Y,
+			I:    i,
+			R:    r,
+		}
+	}
+	return circuit, circuitWit
+}
+
+func TestS3CrossMultiCircuit(t *testing.T) {
+	cfg := Config{Depth: 10, IndexBits: 4, Hash: HashMiMC, Pseudonyms: 3}
+
+	circuit, circuitWit := genS3CrossMultiWitness(t, cfg, []int64{1, 7, 15})
+	assert.NoError(t, test.IsSolved(circuit, circuitWit, ecc.BN254.ScalarField()))
+
+	// public witness layout read by the chaincode
+	secretWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	publicWitness, err := secretWitness.Public()
+	assert.NoError(t, err)
+	values := publicWitness.Vector().(fr.Vector)
+	assert.Equal(t, 6+6*cfg.Pseudonyms, len(values))
+	nonce := new(big.Int).SetBytes(values[3].Marshal())
+	assert.Equal(t, circuitWit.Nonce.(*big.Int), nonce)
+	for j, psu := range circuitWit.Psus {
+		assert.Equal(t, psu.PPkX, values[6+6*j])
+		assert.Equal(t, psu.C2Y, values[6+6*j+5])
+	}
+
+	// the indices should be distinct, increasing and in [1, 2^IndexBits - 1]
+	for _, is := range [][]int64{{1, 7, 7}, {7, 1, 15}, {0, 7, 15}, {1, 7, 16}} {
+		circuit, circuitWit := genS3CrossMultiWitness(t, cfg, is)
+		assert.Error(t, test.IsSolved(circuit, circuitWit, ecc.BN254.ScalarField()), "indices %v", is)
+	}
+
+	// the native pseudonyms match the circuit
+	s3cross := S3Cross{KeyPair: &KeyPair{Sk: circuitWit.USk.(*big.Int)}, Signature: &Signature{Hash: cfg.Hash}}
+	nonceP, err := getRandomPointAffine()
+	assert.NoError(t, err)
+	_, psus, err := s3cross.NewPseudonyms([]*big.Int{big.NewInt(1), big.NewInt(7)}, nonceP)
+	assert.NoError(t, err)
+	assert.Len(t, psus, 2)
+	_, _, err = s3cross.NewPseudonyms([]*big.Int{big.NewInt(7), big.NewInt(7)}, nonceP)
+	assert.Error(t, err)
+
+	_, err = NewS3CrossCircuit(cfg)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = NewCircuit(Config{Depth: 10, IndexBits: 4, Hash: HashMiMC, Pseudonyms: 16})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+// BenchmarkMultiPseudonym k pseudonyms from one proof vs k proofs of the S3CrossCircuit
+func BenchmarkMultiPseudonym(b *testing.B) {
+	single, err := BackendGroth16.Compile(&S3CrossCircuit{
+		ProofElements1: make([]frontend.Variable, TreeDepth),
+		ProofElements2: make([]frontend.Variable, TreeDepth),
+	})
+	assert.NoError(b, err)
+	for _, k := range []int{1, 5, 15} {
+		cfg := DefaultConfig
+		cfg.Pseudonyms = k
+		circuit, circuitWit := genS3CrossMultiWitness(b, cfg, firstIndices(k))
+		ccs, pk, _ := setupBackend(b, BackendGroth16, circuit)
+		secretWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+		assert.NoError(b, err)
+		b.Run(fmt.Sprintf("k=%d", k), func(b *testing.B) {
+			for range b.N {
+				if _, err := BackendGroth16.Prove(ccs, pk, secretWitness); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(ccs.GetNbConstraints()), "constraints")
+			b.ReportMetric(float64(k*single.GetNbConstraints()), "single-constraints")
+		})
+	}
+}
+
+func firstIndices(k int) []int64 {
+	is := make([]int64, k)
+	for j := range is {
+		is[j] = int64(j + 1)
+	}
+	return is
+}
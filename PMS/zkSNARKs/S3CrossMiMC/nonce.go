@@ -0,0 +1,26 @@
+package s3cross
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+)
+
+// EpochNonce the nonce point of an epoch, k·G with k = H(epoch, seed) mod l
+// the chaincode (AdvanceEpoch) derives it with the id of the transaction that opens the epoch as seed,
+// so the nonce of an epoch is not known before the epoch starts
+func EpochNonce(epoch uint64, seed []byte) *twistededwards.PointAffine {
+	curve := twistededwards.GetEdwardsCurve()
+	hs := sha256.New()
+	hs.Write([]byte("s3cross/epoch-nonce"))
+	hs.Write(binary.BigEndian.AppendUint64(nil, epoch))
+	hs.Write(seed)
+	k := new(big.Int).SetBytes(hs.Sum(nil))
+	k.Mod(k, &curve.Order)
+	if k.Sign() == 0 {
+		k.SetInt64(1)
+	}
+	return new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, k)
+}
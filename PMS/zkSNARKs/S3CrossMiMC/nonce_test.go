@@ -0,0 +1,40 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochNonce(t *testing.T) {
+	seed := []byte("4f6a2b1c")
+	nonce := EpochNonce(1, seed)
+	assert.True(t, nonce.IsOnCurve())
+	assert.True(t, nonce.Equal(EpochNonce(1, seed)))
+	assert.False(t, nonce.Equal(EpochNonce(2, seed)))
+	assert.False(t, nonce.Equal(EpochNonce(1, []byte("4f6a2b1d"))))
+
+	// the public Nonce of the pseudonyms of the epoch, one per hash
+	curve := twistededwards.GetEdwardsCurve()
+	sk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(t, err)
+	for _, id := range []HashID{HashMiMC, HashPoseidon2} {
+		s := &S3Cross{
+			KeyPair:   &KeyPair{Sk: sk, Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk)},
+			Signature: &Signature{Hash: id},
+		}
+		nc, _, err := s.NewPseudonym(big.NewInt(1), nonce)
+		assert.NoError(t, err)
+		expected, err := id.NonceHash(nonce)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, nc)
+	}
+	mimcNonce, err := HashMiMC.NonceHash(nonce)
+	assert.NoError(t, err)
+	poseidon2Nonce, err := HashPoseidon2.NonceHash(nonce)
+	assert.NoError(t, err)
+	assert.NotEqual(t, mimcNonce, poseidon2Nonce)
+}
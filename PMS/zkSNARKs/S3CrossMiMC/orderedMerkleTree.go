@@ -2,9 +2,14 @@ package s3cross
 
 import (
 	"bytes"
+	"container/heap"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
-	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	"math/big"
+	"strconv"
+	"sync"
 )
 
 type MerkleProof struct {
@@ -12,9 +17,10 @@ type MerkleProof struct {
 	Proof [][]byte `json:"proof"`
 	Index int      `json:"index"`
 	Leaf  *big.Int `json:"leaf"`
+	Hash  HashID   `json:"hash"` // hash of the tree nodes
 }
 
-func CalcRoot(leaves [][]byte, defaultLevels [][]byte) []byte {
+func CalcRoot(id HashID, leaves [][]byte, defaultLevels [][]byte) ([]byte, error) {
 	nodes := make(map[int]map[int][]byte) // level -> index -> hash
 	nodes[0] = make(map[int][]byte)
 	for i, leaf := range leaves {
@@ -36,7 +42,10 @@ func CalcRoot(leaves [][]byte, defaultLevels [][]byte) []byte {
 			if left == nil {
 				left = defaultLevels[level]
 			}
-			hashV := hashMiMCLR(left, right) // Your hash function
+			hashV, err := hashLR(id, left, right)
+			if err != nil {
+				return nil, err
+			}
 			nodes[level+1][i] = hashV
 		}
 		levelSize = (levelSize + 1) / 2
@@ -46,38 +55,35 @@ func CalcRoot(leaves [][]byte, defaultLevels [][]byte) []byte {
 	if root == nil {
 		root = defaultLevels[len(defaultLevels)]
 	}
-	return root
+	return root, nil
 }
 
-func hashMiMCLR(left, right []byte) []byte {
-	h := mimc.NewMiMC()
-	_, err := h.Write(left)
-	if err != nil {
-		return nil
-	}
-	_, err = h.Write(right)
-	return h.Sum(nil)
+func hashLR(id HashID, left, right []byte) ([]byte, error) {
+	return id.hashBytes(left, right)
 }
 
 // computeMaxDefaultLevels
 // treeHeight: the maximum depth of the ordered Merkle tree
-func computeMaxDefaultLevels(treeHeight int) [][]byte {
+func computeMaxDefaultLevels(id HashID, treeHeight int) ([][]byte, error) {
 	defaultLevels := make([][]byte, treeHeight)
 
-	// use 0xFF as the default leaf value
-	//defaultLeaf := bytes.Repeat([]byte{0xFF}, 32)
-	defaultLeaf := fr.Modulus().Bytes()
-	defaultLevels[0] = defaultLeaf
+	// the default leaf is 0, the modulus is not a canonical field element and the native hash rejects it,
+	// an empty slot after the sentinel p - 1 is never around a value (leaf1 < value < leaf2)
+	defaultLevels[0] = make([]byte, fr.Bytes)
 
 	for i := 1; i < treeHeight; i++ {
 		prev := defaultLevels[i-1]
-		defaultLevels[i] = hashMiMCLR(prev, prev)
+		node, err := hashLR(id, prev, prev)
+		if err != nil {
+			return nil, err
+		}
+		defaultLevels[i] = node
 	}
 
-	return defaultLevels
+	return defaultLevels, nil
 }
 
-func BuildPartialTree(leaves [][]byte, defaultLevels [][]byte) map[int]map[int][]byte {
+func BuildPartialTree(id HashID, leaves [][]byte, defaultLevels [][]byte) (map[int]map[int][]byte, error) {
 	nodes := make(map[int]map[int][]byte)
 	nodes[0] = make(map[int][]byte)
 	for i, leaf := range leaves {
@@ -96,12 +102,15 @@ func BuildPartialTree(leaves [][]byte, defaultLevels [][]byte) map[int]map[int][
 			if right == nil {
 				right = defaultLevels[level]
 			}
-			parentHash := hashMiMCLR(left, right)
+			parentHash, err := hashLR(id, left, right)
+			if err != nil {
+				return nil, err
+			}
 			nodes[level+1][i] = parentHash
 		}
 		levelSize = (levelSize + 1) / 2
 	}
-	return nodes
+	return nodes, nil
 }
 
 func MerkleProofSiblings(nodes map[int]map[int][]byte, defaultLevels [][]byte, leafIndex int) [][]byte {
@@ -120,14 +129,378 @@ func MerkleProofSiblings(nodes map[int]map[int][]byte, defaultLevels [][]byte, l
 }
 
 func VerifyProof(mp *MerkleProof) bool {
-	hashV := mp.Leaf.Bytes()
+	if !isFieldElement(mp.Leaf) {
+		return false
+	}
+	hashV := fieldBytes(mp.Leaf)
+	index := mp.Index
 	for _, sibling := range mp.Proof {
-		if mp.Index%2 == 0 {
-			hashV = hashMiMCLR(hashV, sibling)
+		var err error
+		if index%2 == 0 {
+			hashV, err = hashLR(mp.Hash, hashV, sibling)
 		} else {
-			hashV = hashMiMCLR(sibling, hashV)
+			hashV, err = hashLR(mp.Hash, sibling, hashV)
 		}
-		mp.Index = mp.Index / 2
+		if err != nil {
+			return false
+		}
+		index /= 2
 	}
 	return bytes.Equal(hashV, mp.Root)
 }
+
+// OrderedMerkleTree the revocation tree of a Config with IndexedTree, an indexed Merkle tree:
+// the leaf of a value v is H(v, next) with next the successor of v in the tree (p - 1 for the max),
+// so the leaf of the predecessor (low) of a value x shows x is not in the tree, low < x < next
+// the leaves are in insertion order: Insert updates the low leaf and fills a slot, Delete updates the low leaf
+// and empties the slot of the value, each in O(depth) hashes; the leaf 0 is the sentinel value 0
+// an emptied slot is filled again by a later Insert (the lowest one first), so the tree holds 2^depth values at once
+// the leaves and the non-default nodes are persisted in the TreeStore, the sorted values are indexed in memory
+type OrderedMerkleTree struct {
+	Hash  HashID
+	Depth int
+
+	mu    sync.RWMutex
+	store TreeStore
+	dl    [][]byte    // empty subtree of each level 0..Depth, from the leaf 0
+	size  int         // the slots below size are filled or in free
+	free  freeSlots   // the emptied slots below size
+	index *valueIndex // sorted values -> leaf slot
+}
+
+// NonMembershipProof the path of the low leaf H(Low, Next) of a value, Low < value < Next
+type NonMembershipProof struct {
+	Root  []byte   `json:"root"`
+	Proof [][]byte `json:"proof"`
+	Index int      `json:"index"`
+	Low   *big.Int `json:"low"`
+	Next  *big.Int `json:"next"`
+	Hash  HashID   `json:"hash"`
+}
+
+var (
+	ErrValueInTree    = errors.New("value is in the tree")
+	ErrValueNotInTree = errors.New("value is not in the tree")
+	ErrTreeFull       = errors.New("tree is full")
+)
+
+// tree store keys
+const (
+	treeMetaKey = "meta"
+)
+
+func treeLeafKey(index int) string {
+	return "leaf/" + strconv.Itoa(index)
+}
+
+func treeNodeKey(level, index int) string {
+	return "node/" + strconv.Itoa(level) + "/" + strconv.Itoa(index)
+}
+
+// treeMeta the tree parameters in the store
+type treeMeta struct {
+	Hash  HashID `json:"hash"`
+	Depth int    `json:"depth"`
+	Size  int    `json:"size"`
+}
+
+// NewOrderedMerkleTree open the tree of the store, or create it with the sentinel leaf in an empty store,
+// an existing tree should have the same hash and depth
+func NewOrderedMerkleTree(id HashID, depth int, store TreeStore) (*OrderedMerkleTree, error) {
+	if depth <= 0 || depth > 62 {
+		return nil, fmt.Errorf("%w: tree depth should be in [1, 62]", ErrInvalidConfig)
+	}
+	if _, err := id.New(); err != nil {
+		return nil, err
+	}
+	dl, err := issuerDefaultLevels(id, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	t := &OrderedMerkleTree{
+		Hash:  id,
+		Depth: depth,
+		store: store,
+		dl:    dl,
+		index: newValueIndex(),
+	}
+
+	metaJson, err := store.Get(treeMetaKey)
+	if err != nil {
+		return nil, err
+	}
+	if metaJson == nil {
+		// sentinel, the low leaf of the values below the first one
+		sentinel := new(big.Int)
+		batch := make(map[string][]byte)
+		batch[treeLeafKey(0)] = fieldBytes(sentinel)
+		if err = t.updateLeaf(batch, 0, sentinel, maxValue()); err != nil {
+			return nil, err
+		}
+		if err = t.commit(batch, 1); err != nil {
+			return nil, err
+		}
+		t.index.insert(sentinel, 0)
+		t.size = 1
+		return t, nil
+	}
+
+	var meta treeMeta
+	if err = json.Unmarshal(metaJson, &meta); err != nil {
+		return nil, errors.New("open ordered merkle tree -- " + err.Error())
+	}
+	if meta.Hash.String() != id.String() || meta.Depth != depth {
+		return nil, fmt.Errorf("%w: the stored tree is %s of depth %d", ErrInvalidConfig, meta.Hash, meta.Depth)
+	}
+	t.size = meta.Size
+	for i := 0; i < t.size; i++ {
+		v, err := store.Get(treeLeafKey(i))
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			t.free = append(t.free, i) // deleted, in increasing order, a heap
+			continue
+		}
+		t.index.insert(new(big.Int).SetBytes(v), i)
+	}
+	if first := t.index.first(); first == nil || first.value.Sign() != 0 {
+		return nil, errors.New("open ordered merkle tree -- missing sentinel leaf")
+	}
+	return t, nil
+}
+
+// fieldBytes the fixed width encoding of a value, as hashed
+func fieldBytes(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, fr.Bytes))
+}
+
+// isFieldElement v in [0, p - 1], so fieldBytes is the value of the circuit
+func isFieldElement(v *big.Int) bool {
+	return v != nil && v.Sign() >= 0 && v.Cmp(fr.Modulus()) < 0
+}
+
+// maxValue p - 1, the next of the max value
+func maxValue() *big.Int {
+	return new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+}
+
+// Size the number of values, without the sentinel
+func (t *OrderedMerkleTree) Size() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.index.Len() - 1
+}
+
+// Contains whether v is in the tree
+func (t *OrderedMerkleTree) Contains(v *big.Int) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if v == nil || v.Sign() <= 0 {
+		return false
+	}
+	_, found := t.index.low(v)
+	return found
+}
+
+// Root the tree root
+func (t *OrderedMerkleTree) Root() ([]byte, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.node(nil, t.Depth, 0)
+}
+
+// Insert add the value v, 0 < v < p - 1
+func (t *OrderedMerkleTree) Insert(v *big.Int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if v == nil || v.Sign() <= 0 || v.Cmp(maxValue()) >= 0 {
+		return fmt.Errorf("%w: value should be in [1, p - 2]", ErrInvalidWitnessInput)
+	}
+	low, found := t.index.low(v)
+	if found {
+		return ErrValueInTree
+	}
+	index, size := t.size, t.size+1
+	if len(t.free) > 0 {
+		index, size = t.free[0], t.size
+	} else if t.size >= 1<<t.Depth {
+		return ErrTreeFull
+	}
+
+	// the low leaf now points to v, v to the old next of low
+	batch := make(map[string][]byte)
+	if err := t.updateLeaf(batch, low.slot, low.value, v); err != nil {
+		return err
+	}
+	batch[treeLeafKey(index)] = fieldBytes(v)
+	if err := t.updateLeaf(batch, index, v, low.nextValue()); err != nil {
+		return err
+	}
+	if err := t.commit(batch, size); err != nil {
+		return err
+	}
+	if size == t.size {
+		heap.Pop(&t.free)
+	}
+	t.index.insert(v, index)
+	t.size = size
+	return nil
+}
+
+// Delete remove the value v, its slot is emptied for a later Insert
+func (t *OrderedMerkleTree) Delete(v *big.Int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if v == nil || v.Sign() <= 0 {
+		return ErrValueNotInTree
+	}
+	low, found := t.index.low(v)
+	if !found {
+		return ErrValueNotInTree
+	}
+	node := low.next[0]
+
+	// the low leaf now points to the next of v
+	batch := make(map[string][]byte)
+	if err := t.updateLeaf(batch, low.slot, low.value, node.nextValue()); err != nil {
+		return err
+	}
+	batch[treeLeafKey(node.slot)] = nil
+	if err := t.setNode(batch, 0, node.slot, t.dl[0]); err != nil {
+		return err
+	}
+	if err := t.commit(batch, t.size); err != nil {
+		return err
+	}
+	t.index.delete(v)
+	heap.Push(&t.free, node.slot)
+	return nil
+}
+
+// NonMembershipProof the path of the low leaf of v, an error if v is in the tree
+func (t *OrderedMerkleTree) NonMembershipProof(v *big.Int) (*NonMembershipProof, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if v == nil || v.Sign() <= 0 || v.Cmp(maxValue()) >= 0 {
+		return nil, fmt.Errorf("%w: value should be in [1, p - 2]", ErrInvalidWitnessInput)
+	}
+	low, found := t.index.low(v)
+	if found {
+		return nil, ErrValueInTree
+	}
+	index := low.slot
+	proof := make([][]byte, t.Depth)
+	for level := 0; level < t.Depth; level++ {
+		sibling, err := t.node(nil, level, (index>>level)^1)
+		if err != nil {
+			return nil, err
+		}
+		proof[level] = sibling
+	}
+	root, err := t.node(nil, t.Depth, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &NonMembershipProof{
+		Root:  root,
+		Proof: proof,
+		Index: index,
+		Low:   new(big.Int).Set(low.value),
+		Next:  low.nextValue(),
+		Hash:  t.Hash,
+	}, nil
+}
+
+// Verify check the path of the low leaf and Low < v < Next
+func (p *NonMembershipProof) Verify(v *big.Int) bool {
+	if p.Low == nil || p.Next == nil || v == nil || p.Low.Cmp(v) >= 0 || v.Cmp(p.Next) >= 0 {
+		return false
+	}
+	node, err := p.Hash.hashBytes(fieldBytes(p.Low), fieldBytes(p.Next))
+	if err != nil {
+		return false
+	}
+	index := p.Index
+	for _, sibling := range p.Proof {
+		if index%2 == 0 {
+			node, err = hashLR(p.Hash, node, sibling)
+		} else {
+			node, err = hashLR(p.Hash, sibling, node)
+		}
+		if err != nil {
+			return false
+		}
+		index /= 2
+	}
+	return bytes.Equal(node, p.Root)
+}
+
+// updateLeaf set the leaf index to H(value, next) and update its path
+func (t *OrderedMerkleTree) updateLeaf(batch map[string][]byte, index int, value, next *big.Int) error {
+	leaf, err := t.Hash.hashBytes(fieldBytes(value), fieldBytes(next))
+	if err != nil {
+		return err
+	}
+	return t.setNode(batch, 0, index, leaf)
+}
+
+// setNode set the node and recompute its ancestors
+func (t *OrderedMerkleTree) setNode(batch map[string][]byte, level, index int, node []byte) error {
+	for ; level < t.Depth; level++ {
+		t.putNode(batch, level, index, node)
+		sibling, err := t.node(batch, level, index^1)
+		if err != nil {
+			return err
+		}
+		if index%2 == 0 {
+			node, err = t.Hash.hashBytes(node, sibling)
+		} else {
+			node, err = t.Hash.hashBytes(sibling, node)
+		}
+		if err != nil {
+			return err
+		}
+		index /= 2
+	}
+	t.putNode(batch, t.Depth, 0, node)
+	return nil
+}
+
+// putNode a default node is deleted, the store only has the non-empty subtrees
+func (t *OrderedMerkleTree) putNode(batch map[string][]byte, level, index int, node []byte) {
+	if bytes.Equal(node, t.dl[level]) {
+		batch[treeNodeKey(level, index)] = nil
+		return
+	}
+	batch[treeNodeKey(level, index)] = node
+}
+
+// node read a node, from the batch of the pending update if there
+func (t *OrderedMerkleTree) node(batch map[string][]byte, level, index int) ([]byte, error) {
+	key := treeNodeKey(level, index)
+	if node, ok := batch[key]; ok {
+		if node == nil {
+			return t.dl[level], nil
+		}
+		return node, nil
+	}
+	node, err := t.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return t.dl[level], nil
+	}
+	return node, nil
+}
+
+// commit write the batch with the size of the updated tree
+func (t *OrderedMerkleTree) commit(batch map[string][]byte, size int) error {
+	metaJson, err := json.Marshal(treeMeta{Hash: t.Hash, Depth: t.Depth, Size: size})
+	if err != nil {
+		return err
+	}
+	batch[treeMetaKey] = metaJson
+	return t.store.Write(batch)
+}
@@ -0,0 +1,319 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+	treestore "zkMemMerkle/TreeStore"
+)
+
+// naiveRoot the root of the slots (nil for an empty one) rebuilt from scratch
+func naiveRoot(t *testing.T, id HashID, depth int, slots []*big.Int) []byte {
+	var values []*big.Int
+	for _, v := range slots {
+		if v != nil {
+			values = append(values, v)
+		}
+	}
+	next := func(v *big.Int) *big.Int {
+		n := maxValue()
+		for _, w := range values {
+			if w.Cmp(v) > 0 && w.Cmp(n) < 0 {
+				n = w
+			}
+		}
+		return n
+	}
+	dl, err := issuerDefaultLevels(id, depth+1)
+	assert.NoError(t, err)
+	leaves := make([][]byte, len(slots))
+	for i, v := range slots {
+		if v == nil {
+			leaves[i] = dl[0]
+			continue
+		}
+		leaves[i], err = id.hashBytes(fieldBytes(v), fieldBytes(next(v)))
+		assert.NoError(t, err)
+	}
+	root, err := CalcRoot(id, leaves, dl[:depth])
+	assert.NoError(t, err)
+	return root
+}
+
+// randomValue a value in [1, p - 2]
+func randomValue(t *testing.T) *big.Int {
+	v, err := rand.Int(rand.Reader, new(big.Int).Sub(maxValue(), big.NewInt(1)))
+	assert.NoError(t, err)
+	return v.Add(v, big.NewInt(1))
+}
+
+func TestOrderedMerkleTree(t *testing.T) {
+	const depth = 6
+	for _, id := range []HashID{HashMiMC, HashPoseidon2} {
+		tree, err := NewOrderedMerkleTree(id, depth, NewMemoryStore())
+		assert.NoError(t, err)
+		slots := []*big.Int{new(big.Int)} // the sentinel
+		root, err := tree.Root()
+		assert.NoError(t, err)
+		assert.Equal(t, naiveRoot(t, id, depth, slots), root)
+
+		// a value fills the lowest empty slot
+		fill := func(v *big.Int) {
+			for j := range slots {
+				if slots[j] == nil {
+					slots[j] = v
+					return
+				}
+			}
+			slots = append(slots, v)
+		}
+		empty := func(v *big.Int) {
+			for j := range slots {
+				if slots[j] != nil && slots[j].Cmp(v) == 0 {
+					slots[j] = nil
+				}
+			}
+		}
+
+		var values []*big.Int
+		for i := 0; i < 40; i++ {
+			v := randomValue(t)
+			assert.NoError(t, tree.Insert(v))
+			fill(v)
+			values = append(values, v)
+
+			// delete one value of three
+			if i%3 == 2 {
+				assert.NoError(t, tree.Delete(values[i-1]))
+				empty(values[i-1])
+			}
+			root, err = tree.Root()
+			assert.NoError(t, err)
+			assert.Equal(t, naiveRoot(t, id, depth, slots), root, "%s after %d updates", id, i)
+		}
+		assert.Equal(t, 27, tree.Size())
+
+		for j, v := range values {
+			deleted := j%3 == 1
+			assert.Equal(t, !deleted, tree.Contains(v))
+			proof, err := tree.NonMembershipProof(v)
+			if !deleted {
+				assert.ErrorIs(t, err, ErrValueInTree)
+				assert.ErrorIs(t, tree.Insert(v), ErrValueInTree)
+				continue
+			}
+			assert.NoError(t, err)
+			assert.True(t, proof.Verify(v))
+			assert.ErrorIs(t, tree.Delete(v), ErrValueNotInTree)
+		}
+		for i := 0; i < 10; i++ {
+			v := randomValue(t)
+			proof, err := tree.NonMembershipProof(v)
+			assert.NoError(t, err)
+			assert.True(t, proof.Verify(v))
+			assert.Equal(t, root, proof.Root)
+			assert.False(t, proof.Verify(proof.Low))
+			assert.False(t, proof.Verify(proof.Next))
+		}
+
+		assert.Error(t, tree.Insert(new(big.Int)))
+		assert.Error(t, tree.Insert(maxValue()))
+		assert.ErrorIs(t, tree.Delete(new(big.Int)), ErrValueNotInTree)
+	}
+
+	// 2^2 slots, with the sentinel
+	small, err := NewOrderedMerkleTree(HashMiMC, 2, NewMemoryStore())
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, small.Insert(randomValue(t)))
+	}
+	assert.ErrorIs(t, small.Insert(randomValue(t)), ErrTreeFull)
+
+	// the slots of the deleted values are filled again, the inserts of its lifetime are not bounded by 2^depth
+	for i := 0; i < 20; i++ {
+		v := randomValue(t)
+		assert.NoError(t, small.Delete(mustLow(t, small, v)))
+		assert.NoError(t, small.Insert(v))
+		assert.Equal(t, 3, small.Size())
+		assert.ErrorIs(t, small.Insert(randomValue(t)), ErrTreeFull)
+	}
+}
+
+// mustLow a value of the tree, the low leaf of v or the first value above it
+func mustLow(t *testing.T, tree *OrderedMerkleTree, v *big.Int) *big.Int {
+	proof, err := tree.NonMembershipProof(v)
+	assert.NoError(t, err)
+	if proof.Low.Sign() > 0 {
+		return proof.Low
+	}
+	return proof.Next
+}
+
+// the hash errors of a node are returned, not hashed on as an empty node
+func TestMerkleHashError(t *testing.T) {
+	const depth = 3
+	for _, id := range []HashID{HashMiMC, HashPoseidon2} {
+		dl, err := computeMaxDefaultLevels(id, depth)
+		assert.NoError(t, err)
+		// the modulus is not a canonical field element
+		leaves := [][]byte{fieldBytes(big.NewInt(1)), fr.Modulus().Bytes()}
+		_, err = CalcRoot(id, leaves, dl)
+		assert.Error(t, err)
+		_, err = BuildPartialTree(id, leaves, dl)
+		assert.Error(t, err)
+
+		leaves[1] = fieldBytes(big.NewInt(2))
+		root, err := CalcRoot(id, leaves, dl)
+		assert.NoError(t, err)
+		nodes, err := BuildPartialTree(id, leaves, dl)
+		assert.NoError(t, err)
+		mp := &MerkleProof{Root: root, Proof: MerkleProofSiblings(nodes, dl, 0), Index: 0, Leaf: big.NewInt(1), Hash: id}
+		assert.True(t, VerifyProof(mp))
+		mp.Proof[1] = fr.Modulus().Bytes()
+		assert.False(t, VerifyProof(mp))
+	}
+}
+
+func TestOrderedMerkleTreeFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revocation.tree")
+	store, err := treestore.OpenFileStore(path)
+	assert.NoError(t, err)
+	tree, err := NewOrderedMerkleTree(HashMiMC, 10, store)
+	assert.NoError(t, err)
+	reference, err := NewOrderedMerkleTree(HashMiMC, 10, NewMemoryStore())
+	assert.NoError(t, err)
+	values := make([]*big.Int, 20)
+	for i := range values {
+		values[i] = randomValue(t)
+		assert.NoError(t, tree.Insert(values[i]))
+		assert.NoError(t, reference.Insert(values[i]))
+	}
+	assert.NoError(t, tree.Delete(values[3]))
+	assert.NoError(t, reference.Delete(values[3]))
+	root, err := reference.Root()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Close())
+
+	// a torn record at the end is dropped
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 1, 0, 1, 2, 3})
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	reopen := func() (*treestore.FileStore, *OrderedMerkleTree) {
+		store, err := treestore.OpenFileStore(path)
+		assert.NoError(t, err)
+		tree, err := NewOrderedMerkleTree(HashMiMC, 10, store)
+		assert.NoError(t, err)
+		stored, err := tree.Root()
+		assert.NoError(t, err)
+		assert.Equal(t, root, stored)
+		return store, tree
+	}
+	store, tree = reopen()
+	assert.Equal(t, 19, tree.Size())
+	assert.False(t, tree.Contains(values[3]))
+
+	// the updates go on from the stored state
+	v := randomValue(t)
+	assert.NoError(t, tree.Insert(v))
+	assert.NoError(t, reference.Insert(v))
+	root, err = reference.Root()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Compact())
+	assert.NoError(t, store.Close())
+	store, _ = reopen()
+
+	// the store is of a tree of this hash and depth
+	_, err = NewOrderedMerkleTree(HashMiMC, 11, store)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = NewOrderedMerkleTree(HashPoseidon2, 10, store)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	assert.NoError(t, store.Close())
+}
+
+func TestIndexedTreeCircuit(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	nonce, err := getRandomPointAffine()
+	assert.NoError(t, err)
+	issuer, supervisor, user := newKeyPair(), newKeyPair(), newKeyPair()
+
+	for _, id := range []HashID{HashMiMC, HashPoseidon2} {
+		cfg := Config{Depth: 10, IndexBits: 4, Hash: id, IndexedTree: true}
+		tree, err := NewOrderedMerkleTree(id, cfg.Depth, NewMemoryStore())
+		assert.NoError(t, err)
+		for i := 0; i < 20; i++ {
+			assert.NoError(t, tree.Insert(randomValue(t)))
+		}
+		sig, _, err := issuer.SignWith(id, user.Pk)
+		assert.NoError(t, err)
+		s := &S3Cross{user, sig}
+
+		pw, err := s.NewPseudonymWitnessFromTree(cfg, tree, nonce, big.NewInt(1), supervisor.Pk)
+		assert.NoError(t, err)
+		assert.Len(t, pw.Assignment.ProofElements2, 0)
+		circuit, err := NewCircuit(cfg)
+		assert.NoError(t, err)
+		assert.NoError(t, test.IsSolved(circuit, pw.Assignment, ecc.BN254.ScalarField()))
+
+		// a low leaf that is not in the tree
+		wrong := *pw.Assignment
+		wrong.Leaf2 = new(big.Int).Add(pw.Assignment.Leaf2.(*big.Int), big.NewInt(1))
+		assert.Error(t, test.IsSolved(circuit, &wrong, ecc.BN254.ScalarField()))
+
+		// the same public witness layout as the sorted tree circuit
+		schema, err := PublicSchemaOf(cfg)
+		assert.NoError(t, err)
+		sorted := cfg
+		sorted.IndexedTree = false
+		sortedSchema, err := PublicSchemaOf(sorted)
+		assert.NoError(t, err)
+		assert.Equal(t, sortedSchema.Names, schema.Names)
+
+		// revoked, then reinstated
+		c, err := id.hashBytes(user.Pk.X.Marshal(), user.Pk.Y.Marshal())
+		assert.NoError(t, err)
+		assert.NoError(t, tree.Insert(new(big.Int).SetBytes(c)))
+		_, err = s.NewPseudonymWitnessFromTree(cfg, tree, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrInvalidWitnessInput)
+		assert.NoError(t, tree.Delete(new(big.Int).SetBytes(c)))
+
+		// hidden issuer
+		issuers, err := NewIssuerSet(id, 4, []*twistededwards.PointAffine{newKeyPair().Pk, issuer.Pk})
+		assert.NoError(t, err)
+		hidden := cfg
+		hidden.IssuerDepth = 4
+		hpw, err := s.NewHiddenIssuerWitnessFromTree(hidden, issuers, tree, nonce, big.NewInt(2), supervisor.Pk)
+		assert.NoError(t, err)
+		hcircuit, err := NewCircuit(hidden)
+		assert.NoError(t, err)
+		assert.NoError(t, test.IsSolved(hcircuit, hpw.HiddenIssuer, ecc.BN254.ScalarField()))
+
+		// the witness builders of the sorted leaves
+		_, err = s.NewPseudonymWitness(cfg, nil, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrInvalidConfig)
+		_, err = s.NewPseudonymWitnessFromTree(sorted, tree, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrInvalidConfig)
+		other, err := NewOrderedMerkleTree(id, cfg.Depth+1, NewMemoryStore())
+		assert.NoError(t, err)
+		_, err = s.NewPseudonymWitnessFromTree(cfg, other, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrInvalidWitnessInput)
+	}
+}
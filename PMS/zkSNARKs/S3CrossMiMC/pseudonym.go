@@ -2,13 +2,17 @@ package s3cross
 
 import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
-	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
 	"math/big"
 )
 
 // GenPsu use fr.Element to process the interior calculation
 func GenPsu(sk, i, nonce *big.Int) (*KeyPair, error) {
+	return GenPsuWith(HashMiMC, sk, i, nonce)
+}
+
+// GenPsuWith psk = 1/(sk + H(nonce || i)), the hash id should match the circuit
+func GenPsuWith(id HashID, sk, i, nonce *big.Int) (*KeyPair, error) {
 	curve := twistededwards.GetEdwardsCurve()
 
 	// convert to fr.Element format
@@ -17,14 +21,11 @@ func GenPsu(sk, i, nonce *big.Int) (*KeyPair, error) {
 	iFr.SetBigInt(i)
 	nonceFr.SetBigInt(nonce)
 
-	// ensure the mimc function is correct
-	h := mimc.NewMiMC()
-	_, err := h.Write(nonceFr.Marshal())
+	// ensure the hash function is correct
+	hOut, err := id.hashBytes(nonceFr.Marshal(), iFr.Marshal())
 	if err != nil {
 		return &KeyPair{}, err
 	}
-	_, err = h.Write(iFr.Marshal())
-	hOut := h.Sum(nil)
 
 	// hOut转fr.Element
 	var hFr fr.Element
@@ -0,0 +1,231 @@
+package s3cross
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/schema"
+)
+
+var ErrPublicSchema = errors.New("public witness schema mismatch")
+
+// public inputs of the pseudonym circuits, a pseudonym of the S3CrossMultiCircuit is prefixed by Psus_j_,
+// the issuer is its key IPk or, for the S3CrossHiddenIssuerCircuit, the IssuerRoot of the set,
+// a circuit with Expiry has the CurrentTime of its validity window
+var (
+	publicCommon       = []string{"Root", "Nonce", "SPkX", "SPkY"}
+	publicIssuer       = []string{"IPkX", "IPkY"}
+	publicHiddenIssuer = []string{"IssuerRoot"}
+	publicPseudonym    = []string{"PPkX", "PPkY", "C1X", "C1Y", "C2X", "C2Y"}
+	publicExpiry       = []string{"Validity_0_CurrentTime"}
+)
+
+// PublicSchema the names of the public inputs in public witness order,
+// read from the gnark:",public" tags of the circuit struct (gnark full names, Psus_0_PPkX in a slice of structs)
+type PublicSchema struct {
+	Names  []string
+	index  map[string]int
+	psus   []string // name prefix of each pseudonym, "" for the S3CrossCircuit
+	hidden bool     // IssuerRoot instead of IPk
+	expiry bool     // Validity_0_CurrentTime
+}
+
+// NewPublicSchema the schema of a circuit shape (S3CrossCircuit, S3CrossMultiCircuit)
+func NewPublicSchema(circuit any) (*PublicSchema, error) {
+	var names []string
+	tVariable := reflect.TypeOf((*frontend.Variable)(nil)).Elem()
+	_, err := schema.Walk(ecc.BN254.ScalarField(), circuit, tVariable, func(leaf schema.LeafInfo, _ reflect.Value) error {
+		if leaf.Visibility == schema.Public {
+			names = append(names, leaf.FullName())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New("new public schema -- " + err.Error())
+	}
+	return PublicSchemaFromNames(names)
+}
+
+// PublicSchemaFromNames the schema of public input names in witness order,
+// every public input should be known (read by PublicInputs) and present once
+func PublicSchemaFromNames(names []string) (*PublicSchema, error) {
+	ps := &PublicSchema{
+		Names: names,
+		index: make(map[string]int, len(names)),
+	}
+	for i, name := range names {
+		if _, ok := ps.index[name]; ok {
+			return nil, fmt.Errorf("%w: %s is repeated", ErrPublicSchema, name)
+		}
+		ps.index[name] = i
+	}
+
+	// pseudonyms: the fields of the S3CrossCircuit or Psus_0_, Psus_1_, ...
+	if _, ok := ps.index[publicPseudonym[0]]; ok {
+		ps.psus = []string{""}
+	} else {
+		for j := 0; ; j++ {
+			prefix := "Psus_" + strconv.Itoa(j) + "_"
+			if _, ok := ps.index[prefix+publicPseudonym[0]]; !ok {
+				break
+			}
+			ps.psus = append(ps.psus, prefix)
+		}
+	}
+	if len(ps.psus) == 0 {
+		return nil, fmt.Errorf("%w: no pseudonym", ErrPublicSchema)
+	}
+
+	issuer := publicIssuer
+	if _, ok := ps.index[publicHiddenIssuer[0]]; ok {
+		issuer = publicHiddenIssuer
+		ps.hidden = true
+	}
+	required := append(slices.Clone(publicCommon), issuer...)
+	if _, ok := ps.index[publicExpiry[0]]; ok {
+		required = append(required, publicExpiry...)
+		ps.expiry = true
+	}
+	known := len(required) + len(ps.psus)*len(publicPseudonym)
+	for _, name := range required {
+		if _, ok := ps.index[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %s", ErrPublicSchema, name)
+		}
+	}
+	for _, prefix := range ps.psus {
+		for _, name := range publicPseudonym {
+			if _, ok := ps.index[prefix+name]; !ok {
+				return nil, fmt.Errorf("%w: missing %s", ErrPublicSchema, prefix+name)
+			}
+		}
+	}
+	if len(names) != known {
+		return nil, fmt.Errorf("%w: %d public inputs, %d are known", ErrPublicSchema, len(names), known)
+	}
+	return ps, nil
+}
+
+// Len the size of the public witness
+func (ps *PublicSchema) Len() int {
+	return len(ps.Names)
+}
+
+// Pseudonyms the pseudonyms of a proof
+func (ps *PublicSchema) Pseudonyms() int {
+	return len(ps.psus)
+}
+
+// HiddenIssuer the issuer is proven to be in the set of IssuerRoot instead of revealed
+func (ps *PublicSchema) HiddenIssuer() bool {
+	return ps.hidden
+}
+
+// Expiry the credential validity window is checked at CurrentTime
+func (ps *PublicSchema) Expiry() bool {
+	return ps.expiry
+}
+
+// Bind read the public witness values with the schema
+func (ps *PublicSchema) Bind(values fr.Vector) (*PublicInputs, error) {
+	if len(values) != ps.Len() {
+		return nil, fmt.Errorf("%w: public witness has %d values, expected %d", ErrPublicSchema, len(values), ps.Len())
+	}
+	return &PublicInputs{
+		schema: ps,
+		values: values,
+	}, nil
+}
+
+// Read Bind of a BN254 public witness
+func (ps *PublicSchema) Read(publicWitness witness.Witness) (*PublicInputs, error) {
+	values, ok := publicWitness.Vector().(fr.Vector)
+	if !ok {
+		return nil, fmt.Errorf("%w: not a BN254 witness", ErrPublicSchema)
+	}
+	return ps.Bind(values)
+}
+
+// PublicInputs the public witness of a pseudonym proof, read by name
+type PublicInputs struct {
+	schema *PublicSchema
+	values fr.Vector
+}
+
+// get the value of name, 0 if the circuit has no such input
+func (p *PublicInputs) get(name string) fr.Element {
+	i, ok := p.schema.index[name]
+	if !ok {
+		return fr.Element{}
+	}
+	return p.values[i]
+}
+
+func (p *PublicInputs) point(name string) twistededwards.PointAffine {
+	return twistededwards.PointAffine{
+		X: p.get(name + "X"),
+		Y: p.get(name + "Y"),
+	}
+}
+
+// Root the ordered Merkle tree root
+func (p *PublicInputs) Root() fr.Element {
+	return p.get("Root")
+}
+
+// IssuerPK the issuer public key of the credential, (0, 0) if HiddenIssuer
+func (p *PublicInputs) IssuerPK() twistededwards.PointAffine {
+	return p.point("IPk")
+}
+
+// HiddenIssuer the proof only reveals the IssuerRoot of the issuer set
+func (p *PublicInputs) HiddenIssuer() bool {
+	return p.schema.HiddenIssuer()
+}
+
+// IssuerRoot the root of the issuer set, 0 if not HiddenIssuer
+func (p *PublicInputs) IssuerRoot() fr.Element {
+	return p.get("IssuerRoot")
+}
+
+// Expiry the proof shows the credential is valid at CurrentTime
+func (p *PublicInputs) Expiry() bool {
+	return p.schema.Expiry()
+}
+
+// CurrentTime the unix time the credential validity window is checked at, 0 without Expiry
+func (p *PublicInputs) CurrentTime() fr.Element {
+	return p.get(publicExpiry[0])
+}
+
+// Nonce H(nonce point)
+func (p *PublicInputs) Nonce() fr.Element {
+	return p.get("Nonce")
+}
+
+// SupervisorPK the ElGamal key of the ciphertexts
+func (p *PublicInputs) SupervisorPK() twistededwards.PointAffine {
+	return p.point("SPk")
+}
+
+// Pseudonyms the pseudonyms of the proof
+func (p *PublicInputs) Pseudonyms() int {
+	return p.schema.Pseudonyms()
+}
+
+// PseudonymPK the public key of pseudonym j, 0 <= j < Pseudonyms()
+func (p *PublicInputs) PseudonymPK(j int) twistededwards.PointAffine {
+	return p.point(p.schema.psus[j] + "PPk")
+}
+
+// Ciphertext the ElGamal ciphertext (C1, C2) of upk of pseudonym j
+func (p *PublicInputs) Ciphertext(j int) (twistededwards.PointAffine, twistededwards.PointAffine) {
+	return p.point(p.schema.psus[j] + "C1"), p.point(p.schema.psus[j] + "C2")
+}
@@ -0,0 +1,95 @@
+package s3cross
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPublicSchema the public witness order read by the chaincode (publicwitness.go and its
+// s3crossPublic structs), a change here needs a new circuit version and the chaincode updated
+func TestPublicSchema(t *testing.T) {
+	single, err := PublicSchemaOf(DefaultConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Root", "IPkX", "IPkY", "PPkX", "PPkY", "Nonce",
+		"SPkX", "SPkY", "C1X", "C1Y", "C2X", "C2Y",
+	}, single.Names)
+	assert.Equal(t, 1, single.Pseudonyms())
+
+	cfg := DefaultConfig
+	cfg.Pseudonyms = 2
+	multi, err := PublicSchemaOf(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Root", "IPkX", "IPkY", "Nonce", "SPkX", "SPkY",
+		"Psus_0_PPkX", "Psus_0_PPkY", "Psus_0_C1X", "Psus_0_C1Y", "Psus_0_C2X", "Psus_0_C2Y",
+		"Psus_1_PPkX", "Psus_1_PPkY", "Psus_1_C1X", "Psus_1_C1Y", "Psus_1_C2X", "Psus_1_C2Y",
+	}, multi.Names)
+	assert.Equal(t, 2, multi.Pseudonyms())
+
+	cfg = DefaultConfig
+	cfg.IssuerDepth = 4
+	hidden, err := PublicSchemaOf(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"Root", "IssuerRoot", "PPkX", "PPkY", "Nonce",
+		"SPkX", "SPkY", "C1X", "C1Y", "C2X", "C2Y",
+	}, hidden.Names)
+	assert.True(t, hidden.HiddenIssuer())
+	assert.False(t, single.HiddenIssuer())
+
+	cfg.Expiry = true
+	hiddenExpiry, err := PublicSchemaOf(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, append(append([]string{}, hidden.Names...), "Validity_0_CurrentTime"), hiddenExpiry.Names)
+	assert.True(t, hiddenExpiry.Expiry())
+	cfg = DefaultConfig
+	cfg.Pseudonyms = 2
+	cfg.Expiry = true
+	multiExpiry, err := PublicSchemaOf(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, append(append([]string{}, multi.Names...), "Validity_0_CurrentTime"), multiExpiry.Names)
+	assert.False(t, multi.Expiry())
+
+	// typed accessors of a real public witness
+	_, circuitWit := genS3CrossWitness(t, Config{Depth: 10, IndexBits: 4, Hash: HashMiMC})
+	fullWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+	assert.NoError(t, err)
+	publicWitness, err := fullWitness.Public()
+	assert.NoError(t, err)
+	in, err := single.Read(publicWitness)
+	assert.NoError(t, err)
+	assert.Equal(t, circuitWit.IPkX, in.IssuerPK().X)
+	assert.Equal(t, circuitWit.SPkY, in.SupervisorPK().Y)
+	assert.Equal(t, circuitWit.PPkX, in.PseudonymPK(0).X)
+	nonce := in.Nonce()
+	assert.Equal(t, new(fr.Element).SetBigInt(circuitWit.Nonce.(*big.Int)), &nonce)
+	c1, c2 := in.Ciphertext(0)
+	assert.Equal(t, circuitWit.C1Y, c1.Y)
+	assert.Equal(t, circuitWit.C2X, c2.X)
+
+	// the same witness read as another circuit
+	_, err = multi.Read(publicWitness)
+	assert.ErrorIs(t, err, ErrPublicSchema)
+
+	// a reordered circuit is read by name, an added or missing input fails
+	_, err = PublicSchemaFromNames([]string{
+		"Nonce", "Root", "IPkX", "IPkY", "SPkX", "SPkY", "C1X", "C1Y", "C2X", "C2Y", "PPkX", "PPkY",
+	})
+	assert.NoError(t, err)
+	for _, names := range [][]string{
+		append(append([]string{}, single.Names...), "Epoch"),
+		single.Names[1:],
+		append(append([]string{}, single.Names...), "Root"),
+		{"Root", "IPkX", "IPkY", "Nonce", "SPkX", "SPkY"},
+		append(append([]string{}, single.Names...), "IssuerRoot"),
+	} {
+		_, err = PublicSchemaFromNames(names)
+		assert.ErrorIs(t, err, ErrPublicSchema, "%v", names)
+	}
+}
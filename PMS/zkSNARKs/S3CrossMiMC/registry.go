@@ -0,0 +1,161 @@
+package s3cross
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/kzg"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+)
+
+// CircuitVersion a deployed circuit: its config, proof system and verifying key
+// the public witness layout is the same for all versions
+type CircuitVersion struct {
+	ID      string       `json:"id"`
+	Config  Config       `json:"config"`
+	Backend Backend      `json:"backend"`
+	VK      VerifyingKey `json:"-"`
+}
+
+// Registry circuit version ID -> CircuitVersion
+// a version is never overwritten, so proofs of older clients keep verifying after an upgrade
+type Registry struct {
+	mu       sync.RWMutex
+	versions map[string]*CircuitVersion
+}
+
+var (
+	ErrUnknownVersion = errors.New("unknown circuit version")
+	ErrVersionExists  = errors.New("circuit version already registered")
+)
+
+func NewRegistry() *Registry {
+	return &Registry{
+		versions: make(map[string]*CircuitVersion),
+	}
+}
+
+func (r *Registry) Register(v *CircuitVersion) error {
+	if v.ID == "" {
+		return errors.New("register circuit version: empty version id")
+	}
+	if v.VK == nil {
+		return errors.New("register circuit version: missing verifying key")
+	}
+	if err := v.Config.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.versions[v.ID]; ok {
+		return ErrVersionExists
+	}
+	r.versions[v.ID] = v
+	return nil
+}
+
+func (r *Registry) Get(id string) (*CircuitVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	v, ok := r.versions[id]
+	if !ok {
+		return nil, ErrUnknownVersion
+	}
+	return v, nil
+}
+
+// Setup compile the circuit of cfg, run the setup and register the verifying key as version id
+// srs, srsLagrange: see Backend.Setup
+func (r *Registry) Setup(id string, cfg Config, b Backend, srs, srsLagrange kzg.SRS) (constraint.ConstraintSystem, ProvingKey, error) {
+	circuit, err := NewCircuit(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	ccs, err := b.Compile(circuit)
+	if err != nil {
+		return nil, nil, err
+	}
+	pk, vk, err := b.Setup(ccs, srs, srsLagrange)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = r.Register(&CircuitVersion{
+		ID:      id,
+		Config:  cfg,
+		Backend: b,
+		VK:      vk,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return ccs, pk, nil
+}
+
+// Verify verify the proof with the verifying key of its version
+func (r *Registry) Verify(vp *VersionedProof, publicWitness witness.Witness) error {
+	v, err := r.Get(vp.Version)
+	if err != nil {
+		return err
+	}
+	return v.Backend.Verify(vp.Proof, v.VK, publicWitness)
+}
+
+// VersionedProof a proof tagged with the circuit version that produced it
+type VersionedProof struct {
+	Version string
+	Proof   Proof
+}
+
+// versionedProofJson the string submitted to the chaincode
+type versionedProofJson struct {
+	Version string `json:"version"`
+	Proof   string `json:"proof"` // base64
+}
+
+// Encode the proof string of CreatePseudonym: {"version": ..., "proof": base64}
+func (vp *VersionedProof) Encode() (string, error) {
+	var buf bytes.Buffer
+	if _, err := vp.Proof.WriteTo(&buf); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(versionedProofJson{
+		Version: vp.Version,
+		Proof:   base64.StdEncoding.EncodeToString(buf.Bytes()),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// DecodeVersionedProof parse the proof with the backend of its version
+func (r *Registry) DecodeVersionedProof(str string) (*VersionedProof, error) {
+	var vpj versionedProofJson
+	if err := json.Unmarshal([]byte(str), &vpj); err != nil {
+		return nil, errors.New("decode versioned proof -- " + err.Error())
+	}
+	v, err := r.Get(vpj.Version)
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(vpj.Proof)
+	if err != nil {
+		return nil, errors.New("decode versioned proof -- " + err.Error())
+	}
+	proof, err := v.Backend.NewProof()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = proof.ReadFrom(bytes.NewReader(data)); err != nil {
+		return nil, errors.New("decode versioned proof -- " + err.Error())
+	}
+	return &VersionedProof{
+		Version: vpj.Version,
+		Proof:   proof,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package s3cross
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	versions := map[string]Config{
+		"v1": {Depth: 10, IndexBits: 4, Hash: HashMiMC},
+		"v2": {Depth: 12, IndexBits: 6, Hash: HashPoseidon2},
+	}
+	reg := NewRegistry()
+
+	proofs := make(map[string]string)
+	for id, cfg := range versions {
+		ccs, pk, err := reg.Setup(id, cfg, BackendGroth16, nil, nil)
+		assert.NoError(t, err)
+
+		_, circuitWit := genS3CrossWitness(t, cfg)
+		secretWitness, err := frontend.NewWitness(circuitWit, ecc.BN254.ScalarField())
+		assert.NoError(t, err)
+		publicWitness, err := secretWitness.Public()
+		assert.NoError(t, err)
+
+		proof, err := BackendGroth16.Prove(ccs, pk, secretWitness)
+		assert.NoError(t, err)
+		proofs[id], err = (&VersionedProof{Version: id, Proof: proof}).Encode()
+		assert.NoError(t, err)
+
+		vp, err := reg.DecodeVersionedProof(proofs[id])
+		assert.NoError(t, err)
+		assert.Equal(t, id, vp.Version)
+		assert.NoError(t, reg.Verify(vp, publicWitness))
+
+		// the same proof under the other version
+		other := "v1"
+		if id == "v1" {
+			other = "v2"
+		}
+		vp.Version = other
+		assert.Error(t, reg.Verify(vp, publicWitness))
+	}
+
+	// a registered version is not overwritten
+	_, _, err := reg.Setup("v1", versions["v2"], BackendGroth16, nil, nil)
+	assert.ErrorIs(t, err, ErrVersionExists)
+
+	_, err = reg.Get("v3")
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+	_, err = reg.DecodeVersionedProof(`{"version":"v3","proof":""}`)
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+
+	_, err = NewS3CrossCircuit(Config{Depth: 0, IndexBits: 4})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = NewS3CrossCircuit(Config{Depth: 10, IndexBits: 4, Hash: "sha256"})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
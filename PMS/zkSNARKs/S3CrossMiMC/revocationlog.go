@@ -0,0 +1,231 @@
+package s3cross
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// RevocationEntry an entry of the RevocationLog, the revocation of a credential hash or its reinstatement
+type RevocationEntry struct {
+	Reinstate bool
+	Value     *big.Int
+}
+
+// revocationEntrySize op (1) | value (32)
+const revocationEntrySize = 1 + fr.Bytes
+
+// Marshal the log entry, op (1 for a reinstatement) | value
+func (e RevocationEntry) Marshal() []byte {
+	b := make([]byte, revocationEntrySize)
+	if e.Reinstate {
+		b[0] = 1
+	}
+	e.Value.FillBytes(b[1:])
+	return b
+}
+
+// ParseRevocationEntry the entry of a log entry (Marshal)
+func ParseRevocationEntry(b []byte) (RevocationEntry, error) {
+	if len(b) != revocationEntrySize || b[0] > 1 {
+		return RevocationEntry{}, errors.New("parse revocation entry: invalid entry")
+	}
+	v := new(big.Int).SetBytes(b[1:])
+	if v.Sign() <= 0 || v.Cmp(maxValue()) >= 0 {
+		return RevocationEntry{}, errors.New("parse revocation entry: the value is not in [1, p - 2]")
+	}
+	return RevocationEntry{Reinstate: b[0] == 1, Value: v}, nil
+}
+
+// ReplayRevocations the revoked hashes after the log entries, sorted, the sorted leaves of a TreeHead Root
+// are RevocationLeaves of them, so an auditor can recompute the Root (ApplyRevocations for an OrderedMerkleTree)
+func ReplayRevocations(entries [][]byte) ([]*big.Int, error) {
+	revoked := make(map[string]*big.Int)
+	for i, b := range entries {
+		e, err := ParseRevocationEntry(b)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i, err)
+		}
+		if err = applyRevocation(revoked, e); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+	return sortedRevoked(revoked), nil
+}
+
+// ApplyRevocations Insert or Delete the values of the log entries in order, the OrderedMerkleTree of a log
+// is the empty tree after its entries (its slots follow the updates, an insertion fills the lowest emptied slot)
+// the entries applied before an error stay in the tree
+func ApplyRevocations(tree *OrderedMerkleTree, entries [][]byte) error {
+	for i, b := range entries {
+		e, err := ParseRevocationEntry(b)
+		if err != nil {
+			return fmt.Errorf("entry %d: %v", i, err)
+		}
+		if e.Reinstate {
+			err = tree.Delete(e.Value)
+		} else {
+			err = tree.Insert(e.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// applyRevocation add or remove the value of the entry, a value is revoked once before it is reinstated
+func applyRevocation(revoked map[string]*big.Int, e RevocationEntry) error {
+	key := e.Value.String()
+	_, found := revoked[key]
+	switch {
+	case e.Reinstate && !found:
+		return ErrValueNotInTree
+	case e.Reinstate:
+		delete(revoked, key)
+	case found:
+		return ErrValueInTree
+	default:
+		revoked[key] = new(big.Int).Set(e.Value)
+	}
+	return nil
+}
+
+func sortedRevoked(revoked map[string]*big.Int) []*big.Int {
+	values := make([]*big.Int, 0, len(revoked))
+	for _, v := range revoked {
+		values = append(values, new(big.Int).Set(v))
+	}
+	slices.SortFunc(values, (*big.Int).Cmp)
+	return values
+}
+
+// RevocationLog the append-only log of the revocations kept by the supervisor, its root and size are signed
+// in the TreeHead of each published revocation root, and the chaincode checks the ConsistencyProof of
+// the log of the previous head, so a revoked hash leaves the tree only with a reinstatement entry
+// the entries are kept under the keys "log/..." of the store, it can be the store of the OrderedMerkleTree
+type RevocationLog struct {
+	mu      sync.RWMutex
+	store   TreeStore
+	entries [][]byte
+	revoked map[string]*big.Int
+}
+
+// NewRevocationLog open the log of the store, empty for a new store
+func NewRevocationLog(store TreeStore) (*RevocationLog, error) {
+	l := &RevocationLog{store: store, revoked: make(map[string]*big.Int)}
+	sizeBytes, err := store.Get("log/size")
+	if err != nil {
+		return nil, errors.New("open revocation log -- " + err.Error())
+	}
+	if sizeBytes == nil {
+		return l, nil
+	}
+	size, err := strconv.ParseUint(string(sizeBytes), 10, 64)
+	if err != nil {
+		return nil, errors.New("open revocation log -- " + err.Error())
+	}
+	l.entries = make([][]byte, size)
+	for i := range l.entries {
+		if l.entries[i], err = store.Get(logEntryKey(uint64(i))); err != nil {
+			return nil, errors.New("open revocation log -- " + err.Error())
+		}
+		e, err := ParseRevocationEntry(l.entries[i])
+		if err != nil {
+			return nil, fmt.Errorf("open revocation log -- entry %d: %v", i, err)
+		}
+		if err = applyRevocation(l.revoked, e); err != nil {
+			return nil, fmt.Errorf("open revocation log -- entry %d: %v", i, err)
+		}
+	}
+	return l, nil
+}
+
+func logEntryKey(i uint64) string {
+	return "log/" + strconv.FormatUint(i, 10)
+}
+
+// Append add the entries, all of them or none:
+// ErrValueInTree to revoke a revoked value, ErrValueNotInTree to reinstate a value that is not revoked
+func (l *RevocationLog) Append(entries ...RevocationEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	revoked := make(map[string]*big.Int, len(l.revoked))
+	for k, v := range l.revoked {
+		revoked[k] = v
+	}
+	batch := make(map[string][]byte)
+	appended := make([][]byte, len(entries))
+	for i, e := range entries {
+		if e.Value == nil || e.Value.Sign() <= 0 || e.Value.Cmp(maxValue()) >= 0 {
+			return errors.New("append revocation: the value should be in [1, p - 2]")
+		}
+		if err := applyRevocation(revoked, e); err != nil {
+			return fmt.Errorf("append revocation %s: %w", e.Value, err)
+		}
+		appended[i] = e.Marshal()
+		batch[logEntryKey(uint64(len(l.entries)+i))] = appended[i]
+	}
+	batch["log/size"] = []byte(strconv.Itoa(len(l.entries) + len(entries)))
+	if err := l.store.Write(batch); err != nil {
+		return err
+	}
+	l.entries = append(l.entries, appended...)
+	l.revoked = revoked
+	return nil
+}
+
+// Size the number of entries
+func (l *RevocationLog) Size() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return uint64(len(l.entries))
+}
+
+// Entries the entries [from, to), for the auditors of the published heads
+func (l *RevocationLog) Entries(from, to uint64) ([][]byte, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if from > to || to > uint64(len(l.entries)) {
+		return nil, errors.New("revocation log entries: out of range")
+	}
+	return slices.Clone(l.entries[from:to]), nil
+}
+
+// Root the LogRoot of all the entries
+func (l *RevocationLog) Root() []byte {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return LogRoot(l.entries)
+}
+
+// ConsistencyProof the proof that the log of the first oldSize entries is a prefix of the log
+func (l *RevocationLog) ConsistencyProof(oldSize uint64) ([][]byte, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return ConsistencyProof(l.entries, oldSize)
+}
+
+// Revoked the revoked hashes, sorted
+func (l *RevocationLog) Revoked() []*big.Int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return sortedRevoked(l.revoked)
+}
+
+// TreeHead the head of the log with the root of its zk tree, to be signed (SignTreeHead)
+func (l *RevocationLog) TreeHead(epoch uint64, root []byte) TreeHead {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return TreeHead{
+		Epoch:   epoch,
+		Size:    uint64(len(l.entries)),
+		Root:    slices.Clone(root),
+		LogRoot: LogRoot(l.entries),
+	}
+}
@@ -0,0 +1,113 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/stretchr/testify/assert"
+	treestore "zkMemMerkle/TreeStore"
+)
+
+func TestRevocationLog(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	sk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(t, err)
+	lpk := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk)
+
+	path := filepath.Join(t.TempDir(), "revocation.log")
+	store, err := treestore.OpenFileStore(path)
+	assert.NoError(t, err)
+	log, err := NewRevocationLog(store)
+	assert.NoError(t, err)
+	tree, err := NewOrderedMerkleTree(HashPoseidon2, 10, store)
+	assert.NoError(t, err)
+
+	// publish a head of the log and its tree
+	var applied uint64
+	publish := func(epoch uint64) *SignedTreeHead {
+		entries, err := log.Entries(applied, log.Size())
+		assert.NoError(t, err)
+		assert.NoError(t, ApplyRevocations(tree, entries))
+		applied = log.Size()
+		root, err := tree.Root()
+		assert.NoError(t, err)
+		sth, err := SignTreeHead(sk, log.TreeHead(epoch, root))
+		assert.NoError(t, err)
+		assert.NoError(t, VerifyTreeHead(lpk, sth))
+		return sth
+	}
+
+	values := make([]*big.Int, 6)
+	for i := range values {
+		values[i] = randomValue(t)
+	}
+	assert.NoError(t, log.Append(RevocationEntry{Value: values[0]}, RevocationEntry{Value: values[1]}))
+	head1 := publish(1)
+
+	assert.NoError(t, log.Append(RevocationEntry{Value: values[2]}))
+	assert.NoError(t, log.Append(RevocationEntry{Value: values[3]}, RevocationEntry{Value: values[4]}))
+	head2 := publish(2)
+	proof, err := log.ConsistencyProof(head1.Size)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyConsistency(head1.Size, head2.Size, head1.LogRoot, head2.LogRoot, proof))
+
+	// an auditor recomputes the tree of the head from the entries
+	entries, err := log.Entries(0, head2.Size)
+	assert.NoError(t, err)
+	revoked, err := ReplayRevocations(entries)
+	assert.NoError(t, err)
+	auditTree, err := NewOrderedMerkleTree(HashPoseidon2, 10, NewMemoryStore())
+	assert.NoError(t, err)
+	assert.NoError(t, ApplyRevocations(auditTree, entries))
+	assert.Equal(t, len(revoked), tree.Size())
+	for _, v := range revoked {
+		assert.True(t, tree.Contains(v))
+	}
+	auditRoot, err := auditTree.Root()
+	assert.NoError(t, err)
+	assert.Equal(t, head2.Root, auditRoot)
+	assert.Equal(t, head2.LogRoot, LogRoot(entries))
+
+	// the entries are transitions of the revoked set, all or none of a call
+	assert.ErrorIs(t, log.Append(RevocationEntry{Value: values[5]}, RevocationEntry{Value: values[0]}), ErrValueInTree)
+	assert.ErrorIs(t, log.Append(RevocationEntry{Reinstate: true, Value: values[5]}), ErrValueNotInTree)
+	assert.Error(t, log.Append(RevocationEntry{Value: maxValue()}))
+	assert.Equal(t, head2.Size, log.Size())
+
+	// reinstating is an entry of the log, dropping the entry is not an extension of the published log
+	assert.NoError(t, log.Append(RevocationEntry{Reinstate: true, Value: values[2]}))
+	head3 := publish(3)
+	proof, err = log.ConsistencyProof(head2.Size)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyConsistency(head2.Size, head3.Size, head2.LogRoot, head3.LogRoot, proof))
+	assert.NotContains(t, log.Revoked(), values[2])
+
+	silent := append(entries[:2:2], entries[3:]...)
+	silentProof, err := ConsistencyProof(silent, head1.Size)
+	assert.NoError(t, err)
+	assert.Error(t, VerifyConsistency(head2.Size, uint64(len(silent)), head2.LogRoot, LogRoot(silent), silentProof))
+
+	// the log and the tree of the same store are opened again
+	assert.NoError(t, store.Close())
+	store, err = treestore.OpenFileStore(path)
+	assert.NoError(t, err)
+	reopened, err := NewRevocationLog(store)
+	assert.NoError(t, err)
+	assert.Equal(t, log.Size(), reopened.Size())
+	assert.Equal(t, log.Root(), reopened.Root())
+	assert.Equal(t, log.Revoked(), reopened.Revoked())
+	tree, err = NewOrderedMerkleTree(HashPoseidon2, 10, store)
+	assert.NoError(t, err)
+	root, err := tree.Root()
+	assert.NoError(t, err)
+	assert.Equal(t, head3.Root, root)
+	assert.NoError(t, store.Close())
+
+	_, err = ReplayRevocations([][]byte{entries[0], entries[0]})
+	assert.ErrorIs(t, err, ErrValueInTree)
+	_, err = ReplayRevocations([][]byte{{2}})
+	assert.Error(t, err)
+}
@@ -1,7 +1,9 @@
 package s3cross
 
 import (
-	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"errors"
+	"fmt"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
 	"math/big"
 	"slices"
@@ -15,60 +17,146 @@ type S3Cross struct {
 const TreeDepth = 30
 
 // NewPseudonym generate new psu
+// the nonce, the pseudonym (and the tree in GenNonMemProof) use the hash of the credential
 func (s *S3Cross) NewPseudonym(i *big.Int, nonce *twistededwards.PointAffine) (*big.Int, *KeyPair, error) {
-	h := mimc.NewMiMC()
-	_, err := h.Write(nonce.X.Marshal())
+	nc, err := s.Hash.NonceHash(nonce)
 	if err != nil {
 		return &big.Int{}, &KeyPair{}, err
 	}
-	_, err = h.Write(nonce.Y.Marshal())
-	nc := new(big.Int).SetBytes(h.Sum(nil))
-	psu, err := GenPsu(s.Sk, i, nc)
+	psu, err := GenPsuWith(s.Hash, s.Sk, i, nc)
 
 	return nc, psu, err
 }
 
-// GenNonMemProof
-// leaves: current ordered merkle tree
-func (s *S3Cross) GenNonMemProof(leaves []*big.Int) (*MerkleProof, *big.Int, error) {
-	slices.SortFunc(leaves, func(a, b *big.Int) int {
-		return a.Cmp(b)
-	})
-
-	h := mimc.NewMiMC()
-	_, err := h.Write(s.Pk.X.Marshal())
+// NewPseudonyms the k pseudonyms of one nonce, proven together by a S3CrossMultiCircuit
+// is: the indices, strictly increasing
+func (s *S3Cross) NewPseudonyms(is []*big.Int, nonce *twistededwards.PointAffine) (*big.Int, []*KeyPair, error) {
+	nc, err := s.Hash.NonceHash(nonce)
 	if err != nil {
-		return &MerkleProof{}, nil, err
+		return &big.Int{}, nil, err
 	}
-	_, err = h.Write(s.Pk.Y.Marshal())
-	c := new(big.Int).SetBytes(h.Sum(nil))
-
-	var tl1, tl2 int
-	for i := 1; i < len(leaves); i++ {
-		if c.Cmp(leaves[i]) < 0 {
-			tl1 = i - 1
-			tl2 = i
-			break
+	psus := make([]*KeyPair, len(is))
+	for j, i := range is {
+		if j > 0 && i.Cmp(is[j-1]) <= 0 {
+			return nc, nil, errors.New("new pseudonyms: indices should be strictly increasing")
+		}
+		psus[j], err = GenPsuWith(s.Hash, s.Sk, i, nc)
+		if err != nil {
+			return nc, nil, err
 		}
 	}
+	return nc, psus, nil
+}
 
-	leavesBS := make([][]byte, len(leaves))
-	for i, v := range leaves {
-		leavesBS[i] = v.Bytes()
+// GenNonMemProof
+// leaves: current ordered merkle tree, with the boundary sentinels 0 and p - 1 (RevocationLeaves), in any order
+// return the paths of the two adjacent leaves around hash(upk), proof2.Index = proof1.Index+1
+// the tree is rebuilt for each proof, OrderedMerkleTree (Config.IndexedTree) is updated in place
+// leaves is not modified, ErrRevoked if hash(upk) is a leaf, ErrTreeMalformed for leaves that can not be proven against
+func (s *S3Cross) GenNonMemProof(leaves []*big.Int) (*MerkleProof, *MerkleProof, error) {
+	return s.GenNonMemProofDepth(leaves, TreeDepth)
+}
+
+// GenNonMemProofDepth GenNonMemProof for a tree of the given depth (Config.Depth)
+func (s *S3Cross) GenNonMemProofDepth(leaves []*big.Int, depth int) (*MerkleProof, *MerkleProof, error) {
+	sorted, err := sortLeaves(leaves, depth)
+	if err != nil {
+		return &MerkleProof{}, &MerkleProof{}, err
 	}
 
-	dl := computeMaxDefaultLevels(TreeDepth)
-	root := CalcRoot(leavesBS, dl)
-	nodes := BuildPartialTree(leavesBS, dl)
+	cBytes, err := s.Hash.hashBytes(s.Pk.X.Marshal(), s.Pk.Y.Marshal())
+	if err != nil {
+		return &MerkleProof{}, &MerkleProof{}, err
+	}
+	c := new(big.Int).SetBytes(cBytes)
+
+	// the sentinels 0 and p - 1 bound any hash, so 0 < tl2 < len(sorted)
+	tl2, found := slices.BinarySearchFunc(sorted, c, (*big.Int).Cmp)
+	if found {
+		return &MerkleProof{}, &MerkleProof{}, ErrRevoked
+	}
+	tl1 := tl2 - 1
+
+	// fixed width, otherwise the leaf 0 is written as an empty slice and hashed differently from the circuit
+	leavesBS := make([][]byte, len(sorted))
+	for i, v := range sorted {
+		leavesBS[i] = v.FillBytes(make([]byte, fr.Bytes))
+	}
+
+	dl, err := computeMaxDefaultLevels(s.Hash, depth)
+	if err != nil {
+		return &MerkleProof{}, &MerkleProof{}, err
+	}
+	root, err := CalcRoot(s.Hash, leavesBS, dl)
+	if err != nil {
+		return &MerkleProof{}, &MerkleProof{}, err
+	}
+	nodes, err := BuildPartialTree(s.Hash, leavesBS, dl)
+	if err != nil {
+		return &MerkleProof{}, &MerkleProof{}, err
+	}
 
 	// path left
 	proof1 := MerkleProofSiblings(nodes, dl, tl1)
-	//proof2 := MerkleProofSiblings(nodes, dl, tl2)
+	// path right
+	proof2 := MerkleProofSiblings(nodes, dl, tl2)
 
 	return &MerkleProof{
 		Root:  root,
 		Proof: proof1,
 		Index: tl1,
-		Leaf:  leaves[tl1],
-	}, leaves[tl2], nil
+		Leaf:  new(big.Int).Set(sorted[tl1]),
+		Hash:  s.Hash,
+	}, &MerkleProof{
+		Root:  root,
+		Proof: proof2,
+		Index: tl2,
+		Leaf:  new(big.Int).Set(sorted[tl2]),
+		Hash:  s.Hash,
+	}, nil
+}
+
+var (
+	ErrRevoked       = errors.New("credential is revoked")
+	ErrTreeMalformed = errors.New("malformed revocation tree")
+)
+
+// RevocationLeaves the leaves of the tree of the revoked hashes, sorted and without duplicates,
+// between the boundary sentinels 0 and p - 1 (the hashes equal to a sentinel are already in the tree)
+// revoked is not modified
+func RevocationLeaves(revoked []*big.Int) ([]*big.Int, error) {
+	leaves := []*big.Int{new(big.Int), maxValue()}
+	for i, v := range revoked {
+		if !isFieldElement(v) {
+			return nil, fmt.Errorf("%w: revoked hash %d is not a field element", ErrTreeMalformed, i)
+		}
+		leaves = append(leaves, new(big.Int).Set(v))
+	}
+	slices.SortFunc(leaves, (*big.Int).Cmp)
+	return slices.CompactFunc(leaves, func(a, b *big.Int) bool {
+		return a.Cmp(b) == 0
+	}), nil
+}
+
+// sortLeaves a sorted copy of the leaves of a tree of depth, the leaves are field elements without duplicates
+// and with the boundary sentinels, so any hash is strictly between two adjacent leaves or is a leaf
+func sortLeaves(leaves []*big.Int, depth int) ([]*big.Int, error) {
+	if len(leaves) > 1<<depth {
+		return nil, fmt.Errorf("%w: %d leaves for a tree of depth %d", ErrTreeMalformed, len(leaves), depth)
+	}
+	for i, v := range leaves {
+		if !isFieldElement(v) {
+			return nil, fmt.Errorf("%w: leaf %d is not a field element", ErrTreeMalformed, i)
+		}
+	}
+	sorted := slices.SortedFunc(slices.Values(leaves), (*big.Int).Cmp)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Cmp(sorted[i]) == 0 {
+			return nil, fmt.Errorf("%w: duplicate leaf %s", ErrTreeMalformed, sorted[i])
+		}
+	}
+	if len(sorted) < 2 || sorted[0].Sign() != 0 || sorted[len(sorted)-1].Cmp(maxValue()) != 0 {
+		return nil, fmt.Errorf("%w: the boundary sentinels 0 and p - 1 are missing", ErrTreeMalformed)
+	}
+	return sorted, nil
 }
@@ -5,20 +5,28 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/backend"
 	"github.com/consensys/gnark/backend/groth16"
 	"github.com/consensys/gnark/backend/witness"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/test"
 	"github.com/stretchr/testify/assert"
+	"io/fs"
 	"log"
 	"math/big"
+	mrand "math/rand/v2"
 	"os"
+	"path/filepath"
+	"slices"
+	"sort"
 	"testing"
+	ceremony "zkMemMerkle/CeremonySetup"
 )
 
 const numLeaves = 500
@@ -33,6 +41,7 @@ func Benchmark_Setup(b *testing.B) {
 	// circuit
 	var circuit S3CrossCircuit
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	for i := 0; i < b.N; i++ {
 		// circuit
@@ -114,7 +123,7 @@ func BenchmarkSnarkProve(b *testing.B) {
 	// generate leaves (with a "0")
 	leaves := make([]*big.Int, numLeaves+1)
 	leaves[0] = big.NewInt(0)
-	leaves[numLeaves] = new(big.Int).SetBytes(fr.Modulus().Bytes())
+	leaves[numLeaves] = maxValue()
 	for i := 1; i < numLeaves; i++ {
 		var err error
 		leaves[i], err = rand.Int(rand.Reader, fr.Modulus())
@@ -160,58 +169,15 @@ func BenchmarkSnarkProve(b *testing.B) {
 		sig,
 	}
 
-	// non-member proof
-	mp1, leafRight, err := s3cross.GenNonMemProof(leaves)
-	if err != nil {
-		panic(err)
-	}
-	// pseudonym
-	num := big.NewInt(3)
-	nc, psu, err := s3cross.NewPseudonym(num, nonce)
-	if err != nil {
-		panic(err)
-	}
-	// elgamal
-	ct, r, err := EncryptElGamal(user.Pk, supervisor.Pk)
+	// witness
+	pw, err := s3cross.NewPseudonymWitness(DefaultConfig, leaves, nonce, big.NewInt(3), supervisor.Pk)
 	if err != nil {
 		panic(err)
 	}
 
 	circuit := S3CrossCircuit{}
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	circuitWit := S3CrossCircuit{
-		Root:        mp1.Root,
-		ProofIndex1: mp1.Index,
-		Leaf1:       mp1.Leaf,
-		Leaf2:       leafRight,
-
-		IPkX:     sig.SPk.X,
-		IPkY:     sig.SPk.Y,
-		Sig:      s3cross.Sig,
-		RX:       s3cross.R.X,
-		RY:       s3cross.R.Y,
-		MessageX: user.Pk.X,
-		MessageY: user.Pk.Y,
-
-		PPkX:  psu.Pk.X,
-		PPkY:  psu.Pk.Y,
-		Nonce: nc,
-		//MaxI:  maxI,
-		USk: s3cross.Sk,
-		I:   num,
-
-		SPkX: supervisor.Pk.X,
-		SPkY: supervisor.Pk.Y,
-		C1X:  ct.C1.X,
-		C1Y:  ct.C1.Y,
-		C2X:  ct.C2.X,
-		C2Y:  ct.C2.Y,
-		R:    r,
-	}
-	circuitWit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	for i := 0; i < TreeDepth; i++ {
-		circuitWit.ProofElements1[i] = mp1.Proof[i]
-	}
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
 	if err != nil {
@@ -223,7 +189,7 @@ func BenchmarkSnarkProve(b *testing.B) {
 		b.Fatal(err)
 	}
 
-	secretWitness, err := frontend.NewWitness(&circuitWit, ecc.BN254.ScalarField())
+	secretWitness := pw.Witness
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -247,7 +213,7 @@ func BenchmarkSnarkVerify(b *testing.B) {
 	// generate leaves (with a "0")
 	leaves := make([]*big.Int, numLeaves+1)
 	leaves[0] = big.NewInt(0)
-	leaves[numLeaves] = new(big.Int).SetBytes(fr.Modulus().Bytes())
+	leaves[numLeaves] = maxValue()
 	for i := 1; i < numLeaves; i++ {
 		var err error
 		leaves[i], err = rand.Int(rand.Reader, fr.Modulus())
@@ -293,58 +259,15 @@ func BenchmarkSnarkVerify(b *testing.B) {
 		sig,
 	}
 
-	// non-member proof
-	mp1, leafRight, err := s3cross.GenNonMemProof(leaves)
-	if err != nil {
-		panic(err)
-	}
-	// pseudonym
-	num := big.NewInt(3)
-	nc, psu, err := s3cross.NewPseudonym(num, nonce)
-	if err != nil {
-		panic(err)
-	}
-	// elgamal
-	ct, r, err := EncryptElGamal(user.Pk, supervisor.Pk)
+	// witness
+	pw, err := s3cross.NewPseudonymWitness(DefaultConfig, leaves, nonce, big.NewInt(3), supervisor.Pk)
 	if err != nil {
 		panic(err)
 	}
 
 	circuit := S3CrossCircuit{}
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	circuitWit := S3CrossCircuit{
-		Root:        mp1.Root,
-		ProofIndex1: mp1.Index,
-		Leaf1:       mp1.Leaf,
-		Leaf2:       leafRight,
-
-		IPkX:     sig.SPk.X,
-		IPkY:     sig.SPk.Y,
-		Sig:      s3cross.Sig,
-		RX:       s3cross.R.X,
-		RY:       s3cross.R.Y,
-		MessageX: user.Pk.X,
-		MessageY: user.Pk.Y,
-
-		PPkX:  psu.Pk.X,
-		PPkY:  psu.Pk.Y,
-		Nonce: nc,
-		//MaxI:  maxI,
-		USk: s3cross.Sk,
-		I:   num,
-
-		SPkX: supervisor.Pk.X,
-		SPkY: supervisor.Pk.Y,
-		C1X:  ct.C1.X,
-		C1Y:  ct.C1.Y,
-		C2X:  ct.C2.X,
-		C2Y:  ct.C2.Y,
-		R:    r,
-	}
-	circuitWit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	for i := 0; i < TreeDepth; i++ {
-		circuitWit.ProofElements1[i] = mp1.Proof[i]
-	}
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
 	if err != nil {
@@ -356,14 +279,7 @@ func BenchmarkSnarkVerify(b *testing.B) {
 		b.Fatal(err)
 	}
 
-	secretWitness, err := frontend.NewWitness(&circuitWit, ecc.BN254.ScalarField())
-	if err != nil {
-		panic(err)
-	}
-	publicWitness, err := secretWitness.Public()
-	if err != nil {
-		panic(err)
-	}
+	secretWitness, publicWitness := pw.Witness, pw.PublicWitness
 	proof, err := groth16.Prove(ccs, gpk, secretWitness)
 	if err != nil {
 		panic(err)
@@ -384,7 +300,7 @@ func Benchmark_Revoke(b *testing.B) {
 
 	// generate leaves (with a "0")
 	leaves := make([]*big.Int, numLeaves+1)
-	leaves[numLeaves] = new(big.Int).SetBytes(fr.Modulus().Bytes())
+	leaves[numLeaves] = maxValue()
 	leaves[0] = big.NewInt(0)
 	for i := 1; i < numLeaves; i++ {
 		var err error
@@ -556,6 +472,251 @@ func Benchmark_Revoke(b *testing.B) {
 
 // ==================== Test ====================
 
+type nonMemCircuit struct {
+	Root           frontend.Variable `gnark:",public"`
+	Value          frontend.Variable
+	Leaf1, Leaf2   frontend.Variable
+	ProofIndex1    frontend.Variable
+	ProofElements1 []frontend.Variable
+	ProofElements2 []frontend.Variable
+	Hash           HashID `gnark:"-"`
+}
+
+func (circuit *nonMemCircuit) Define(api frontend.API) error {
+	h, err := circuit.Hash.NewCircuitHasher(api)
+	if err != nil {
+		return err
+	}
+	AssertNonMembership(api, h, circuit.Root, circuit.Value, circuit.Leaf1, circuit.Leaf2, circuit.ProofIndex1, circuit.ProofElements1, circuit.ProofElements2)
+	return nil
+}
+
+func TestNonMembershipAdjacency(t *testing.T) {
+	for _, id := range []HashID{HashMiMC, HashPoseidon2} {
+		t.Run(string(id), func(t *testing.T) {
+			testNonMembershipAdjacency(t, id)
+		})
+	}
+}
+
+func testNonMembershipAdjacency(t *testing.T, id HashID) {
+	const depth = 4
+	leaves := make([]*big.Int, 8)
+	for i := range leaves {
+		leaves[i] = big.NewInt(int64(10 * (i + 1)))
+	}
+	leavesBS := make([][]byte, len(leaves))
+	for i, v := range leaves {
+		leavesBS[i] = v.Bytes()
+	}
+	dl, err := computeMaxDefaultLevels(id, depth)
+	assert.NoError(t, err)
+	root, err := CalcRoot(id, leavesBS, dl)
+	assert.NoError(t, err)
+	nodes, err := BuildPartialTree(id, leavesBS, dl)
+	assert.NoError(t, err)
+
+	witness := func(value int64, idx1, idx2 int) *nonMemCircuit {
+		w := &nonMemCircuit{
+			Root:           root,
+			Value:          value,
+			Leaf1:          leaves[idx1],
+			Leaf2:          leaves[idx2],
+			ProofIndex1:    idx1,
+			ProofElements1: make([]frontend.Variable, depth),
+			ProofElements2: make([]frontend.Variable, depth),
+			Hash:           id,
+		}
+		proof1 := MerkleProofSiblings(nodes, dl, idx1)
+		proof2 := MerkleProofSiblings(nodes, dl, idx2)
+		for i := 0; i < depth; i++ {
+			w.ProofElements1[i] = proof1[i]
+			w.ProofElements2[i] = proof2[i]
+		}
+		return w
+	}
+	circuit := &nonMemCircuit{
+		ProofElements1: make([]frontend.Variable, depth),
+		ProofElements2: make([]frontend.Variable, depth),
+		Hash:           id,
+	}
+
+	ast := test.NewAssert(t)
+	// 30 < 35 < 40
+	ast.ProverSucceeded(circuit, witness(35, 2, 3), test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16))
+	// the value is a leaf
+	ast.ProverFailed(circuit, witness(30, 2, 3), test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16))
+	// 30 < 35 < 50, but the leaves are not adjacent
+	ast.ProverFailed(circuit, witness(35, 2, 4), test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16))
+}
+
+// naiveNonMembership the leaves around c by a linear scan, and the root of the leaves sorted apart
+func naiveNonMembership(t *testing.T, id HashID, depth int, leaves []*big.Int, c *big.Int) (*big.Int, *big.Int, []byte) {
+	var low, next *big.Int
+	for _, v := range leaves {
+		if v.Cmp(c) < 0 && (low == nil || v.Cmp(low) > 0) {
+			low = v
+		}
+		if v.Cmp(c) > 0 && (next == nil || v.Cmp(next) < 0) {
+			next = v
+		}
+	}
+	sorted := append([]*big.Int(nil), leaves...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+	leavesBS := make([][]byte, len(sorted))
+	for i, v := range sorted {
+		leavesBS[i] = v.FillBytes(make([]byte, fr.Bytes))
+	}
+	dl, err := computeMaxDefaultLevels(id, depth)
+	assert.NoError(t, err)
+	root, err := CalcRoot(id, leavesBS, dl)
+	assert.NoError(t, err)
+	return low, next, root
+}
+
+func TestGenNonMemProof(t *testing.T) {
+	const depth = 8
+	curve := twistededwards.GetEdwardsCurve()
+	for _, id := range []HashID{HashMiMC, HashPoseidon2} {
+		usk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		user := &KeyPair{Sk: usk, Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, usk)}
+		s3cross := &S3Cross{user, &Signature{Hash: id}}
+		cBytes, err := id.hashBytes(user.Pk.X.Marshal(), user.Pk.Y.Marshal())
+		assert.NoError(t, err)
+		c := new(big.Int).SetBytes(cBytes)
+
+		// revoked hashes anywhere, all below c, all above c, or right around c, with duplicates
+		below := func() *big.Int {
+			v, err := rand.Int(rand.Reader, c)
+			assert.NoError(t, err)
+			return v
+		}
+		above := func() *big.Int {
+			v, err := rand.Int(rand.Reader, new(big.Int).Sub(fr.Modulus(), c))
+			assert.NoError(t, err)
+			return v.Add(v, c)
+		}
+		for round := 0; round < 40; round++ {
+			var revoked []*big.Int
+			for n := mrand.IntN(30); len(revoked) < n; {
+				var v *big.Int
+				switch round % 4 {
+				case 0:
+					if v, err = rand.Int(rand.Reader, fr.Modulus()); err != nil {
+						t.Fatal(err)
+					}
+				case 1:
+					v = below()
+				case 2:
+					v = above()
+				case 3:
+					v = new(big.Int).Add(c, big.NewInt(int64(2*mrand.IntN(2)-1)))
+				}
+				if v.Cmp(c) == 0 {
+					continue
+				}
+				revoked = append(revoked, v)
+				if mrand.IntN(4) == 0 {
+					revoked = append(revoked, new(big.Int).Set(v))
+				}
+			}
+			leaves, err := RevocationLeaves(revoked)
+			assert.NoError(t, err)
+			mrand.Shuffle(len(leaves), func(i, j int) {
+				leaves[i], leaves[j] = leaves[j], leaves[i]
+			})
+			snapshot := make([]string, len(leaves))
+			for i, v := range leaves {
+				snapshot[i] = v.String()
+			}
+			unchanged := func() {
+				for i, v := range leaves {
+					assert.Equal(t, snapshot[i], v.String(), "the leaves are not modified")
+				}
+			}
+
+			mp1, mp2, err := s3cross.GenNonMemProofDepth(leaves, depth)
+			assert.NoError(t, err)
+			unchanged()
+			low, next, root := naiveNonMembership(t, id, depth, leaves, c)
+			assert.Equal(t, low, mp1.Leaf, "%s round %d", id, round)
+			assert.Equal(t, next, mp2.Leaf, "%s round %d", id, round)
+			assert.Equal(t, mp1.Index+1, mp2.Index)
+			assert.Equal(t, root, mp1.Root)
+			assert.Equal(t, root, mp2.Root)
+			assert.True(t, VerifyProof(mp1))
+			assert.True(t, VerifyProof(mp2))
+
+			// a proof leaf is a copy
+			mp1.Leaf.SetInt64(7)
+			unchanged()
+
+			_, _, err = s3cross.GenNonMemProofDepth(append(leaves, c), depth)
+			assert.ErrorIs(t, err, ErrRevoked)
+		}
+
+		leaves, err := RevocationLeaves([]*big.Int{below(), above()})
+		assert.NoError(t, err)
+		malformed := map[string][]*big.Int{
+			"duplicate":        append(slices.Clone(leaves), new(big.Int).Set(leaves[1])),
+			"no low sentinel":  leaves[1:],
+			"no high sentinel": leaves[:len(leaves)-1],
+			"modulus":          append(leaves[:len(leaves)-1:len(leaves)-1], fr.Modulus()),
+			"negative":         append(slices.Clone(leaves), big.NewInt(-1)),
+			"nil":              append(slices.Clone(leaves), nil),
+			"too many":         slices.Repeat([]*big.Int{big.NewInt(1)}, 1<<depth+1),
+		}
+		for name, leaves := range malformed {
+			_, _, err := s3cross.GenNonMemProofDepth(leaves, depth)
+			assert.ErrorIs(t, err, ErrTreeMalformed, "%s %s", id, name)
+		}
+		_, err = RevocationLeaves([]*big.Int{fr.Modulus()})
+		assert.ErrorIs(t, err, ErrTreeMalformed)
+	}
+}
+
+// TestGenNonMemProofBoundary a credential beyond every revoked hash is proven against the sentinel p - 1
+func TestGenNonMemProofBoundary(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	cfg := Config{Depth: 10, IndexBits: 4, Hash: HashPoseidon2}
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{Sk: sk, Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk)}
+	}
+	issuer, supervisor, user := newKeyPair(), newKeyPair(), newKeyPair()
+	sig, _, err := issuer.SignWith(cfg.Hash, user.Pk)
+	assert.NoError(t, err)
+	s3cross := &S3Cross{user, sig}
+	nonce, err := getRandomPointAffine()
+	assert.NoError(t, err)
+	cBytes, err := cfg.Hash.hashBytes(user.Pk.X.Marshal(), user.Pk.Y.Marshal())
+	assert.NoError(t, err)
+	c := new(big.Int).SetBytes(cBytes)
+
+	circuit, err := NewCircuit(cfg)
+	assert.NoError(t, err)
+	for _, revoked := range [][]*big.Int{
+		{new(big.Int).Sub(c, big.NewInt(1)), big.NewInt(1)}, // next is p - 1
+		{new(big.Int).Add(c, big.NewInt(1)), maxValue()},    // low is 0
+	} {
+		leaves, err := RevocationLeaves(revoked)
+		assert.NoError(t, err)
+		pw, err := s3cross.NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(1), supervisor.Pk)
+		assert.NoError(t, err)
+		assert.NoError(t, test.IsSolved(circuit, pw.Assignment, ecc.BN254.ScalarField()))
+	}
+
+	leaves, err := RevocationLeaves([]*big.Int{c})
+	assert.NoError(t, err)
+	_, err = s3cross.NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(1), supervisor.Pk)
+	assert.ErrorIs(t, err, ErrInvalidWitnessInput)
+	assert.ErrorIs(t, err, ErrRevoked)
+}
+
 type TestParams struct {
 	Leaves       [][]byte `json:"leaves"`    // Merkle树的叶节点：[]byte 数组
 	IssuerSK     []byte   `json:"issuer_sk"` // Issuer私钥（16进制字符串）
@@ -574,7 +735,7 @@ func TestS3CrossS3CrossCircuit(t *testing.T) {
 	// generate leaves (with a "0" and )
 	leaves := make([]*big.Int, numLeaves+1)
 	leaves[0] = big.NewInt(0)
-	leaves[numLeaves] = new(big.Int).SetBytes(fr.Modulus().Bytes())
+	leaves[numLeaves] = maxValue()
 	for i := 1; i < numLeaves; i++ {
 		var err error
 		leaves[i], err = rand.Int(rand.Reader, fr.Modulus())
@@ -621,11 +782,12 @@ func TestS3CrossS3CrossCircuit(t *testing.T) {
 	}
 
 	// non-member proof
-	mp1, leafRight, err := s3cross.GenNonMemProof(leaves)
+	mp1, mp2, err := s3cross.GenNonMemProof(leaves)
 	if err != nil {
 		panic(err)
 	}
-	//assert.Equal(t, mp1.Root, mp2.Root)
+	assert.Equal(t, mp1.Root, mp2.Root)
+	assert.Equal(t, mp1.Index+1, mp2.Index)
 	// pseudonym
 	i := big.NewInt(3)
 	nc, psu, err := s3cross.NewPseudonym(i, nonce)
@@ -640,6 +802,7 @@ func TestS3CrossS3CrossCircuit(t *testing.T) {
 
 	circuit := S3CrossCircuit{}
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	// constraints number
 	cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
@@ -652,7 +815,7 @@ func TestS3CrossS3CrossCircuit(t *testing.T) {
 		Root:        mp1.Root,
 		ProofIndex1: mp1.Index,
 		Leaf1:       mp1.Leaf,
-		Leaf2:       leafRight,
+		Leaf2:       mp2.Leaf,
 
 		IPkX:     sig.SPk.X,
 		IPkY:     sig.SPk.Y,
@@ -678,8 +841,10 @@ func TestS3CrossS3CrossCircuit(t *testing.T) {
 		R:    r,
 	}
 	circuitWit.ProofElements1 = make([]frontend.Variable, TreeDepth)
+	circuitWit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 	for i := 0; i < TreeDepth; i++ {
 		circuitWit.ProofElements1[i] = mp1.Proof[i]
+		circuitWit.ProofElements2[i] = mp2.Proof[i]
 	}
 
 	// Verify
@@ -700,7 +865,7 @@ func TestS3CrossS3CrossKeySize(t *testing.T) {
 	// generate leaves (with a "0" and )
 	leaves := make([]*big.Int, numLeaves+1)
 	leaves[0] = big.NewInt(0)
-	leaves[numLeaves] = new(big.Int).SetBytes(fr.Modulus().Bytes())
+	leaves[numLeaves] = maxValue()
 	for i := 1; i < numLeaves; i++ {
 		var err error
 		leaves[i], err = rand.Int(rand.Reader, fr.Modulus())
@@ -747,11 +912,12 @@ func TestS3CrossS3CrossKeySize(t *testing.T) {
 	}
 
 	// non-member proof
-	mp1, leafRight, err := s3cross.GenNonMemProof(leaves)
+	mp1, mp2, err := s3cross.GenNonMemProof(leaves)
 	if err != nil {
 		panic(err)
 	}
-	//assert.Equal(t, mp1.Root, mp2.Root)
+	assert.Equal(t, mp1.Root, mp2.Root)
+	assert.Equal(t, mp1.Index+1, mp2.Index)
 	// pseudonym
 	i := big.NewInt(3)
 	nc, psu, err := s3cross.NewPseudonym(i, nonce)
@@ -766,6 +932,7 @@ func TestS3CrossS3CrossKeySize(t *testing.T) {
 
 	circuit := S3CrossCircuit{}
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	// constraints number
 	cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
@@ -778,7 +945,7 @@ func TestS3CrossS3CrossKeySize(t *testing.T) {
 		Root:        mp1.Root,
 		ProofIndex1: mp1.Index,
 		Leaf1:       mp1.Leaf,
-		Leaf2:       leafRight,
+		Leaf2:       mp2.Leaf,
 
 		IPkX:     sig.SPk.X,
 		IPkY:     sig.SPk.Y,
@@ -804,8 +971,10 @@ func TestS3CrossS3CrossKeySize(t *testing.T) {
 		R:    r,
 	}
 	circuitWit.ProofElements1 = make([]frontend.Variable, TreeDepth)
+	circuitWit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 	for i := 0; i < TreeDepth; i++ {
 		circuitWit.ProofElements1[i] = mp1.Proof[i]
+		circuitWit.ProofElements2[i] = mp2.Proof[i]
 	}
 
 	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
@@ -846,7 +1015,7 @@ func TestCSAndPVKey(t *testing.T) {
 	// generate leaves (with a "0")
 	leaves := make([]*big.Int, numLeaves+1)
 	leaves[0] = big.NewInt(0)
-	leaves[numLeaves] = new(big.Int).SetBytes(fr.Modulus().Bytes())
+	leaves[numLeaves] = maxValue()
 	for i := 1; i < numLeaves; i++ {
 		var err error
 		leaves[i], err = rand.Int(rand.Reader, fr.Modulus())
@@ -892,66 +1061,23 @@ func TestCSAndPVKey(t *testing.T) {
 		sig,
 	}
 
-	// non-member proof
-	mp1, leafRight, err := s3cross.GenNonMemProof(leaves)
-	if err != nil {
-		panic(err)
-	}
-	// pseudonym
-	num := big.NewInt(3)
-	nc, psu, err := s3cross.NewPseudonym(num, nonce)
-	if err != nil {
-		panic(err)
-	}
-	// elgamal
-	ct, r, err := EncryptElGamal(user.Pk, supervisor.Pk)
+	// witness
+	pw, err := s3cross.NewPseudonymWitness(DefaultConfig, leaves, nonce, big.NewInt(3), supervisor.Pk)
 	if err != nil {
 		panic(err)
 	}
 
 	circuit := S3CrossCircuit{}
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	secWit := S3CrossCircuit{
-		Root:        mp1.Root,
-		ProofIndex1: mp1.Index,
-		Leaf1:       mp1.Leaf,
-		Leaf2:       leafRight,
-
-		IPkX:     sig.SPk.X,
-		IPkY:     sig.SPk.Y,
-		Sig:      s3cross.Sig,
-		RX:       s3cross.R.X,
-		RY:       s3cross.R.Y,
-		MessageX: user.Pk.X,
-		MessageY: user.Pk.Y,
-
-		PPkX:  psu.Pk.X,
-		PPkY:  psu.Pk.Y,
-		Nonce: nc,
-		//MaxI:  maxI,
-		USk: s3cross.Sk,
-		I:   num,
-
-		SPkX: supervisor.Pk.X,
-		SPkY: supervisor.Pk.Y,
-		C1X:  ct.C1.X,
-		C1Y:  ct.C1.Y,
-		C2X:  ct.C2.X,
-		C2Y:  ct.C2.Y,
-		R:    r,
-	}
-	secWit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	for i := 0; i < TreeDepth; i++ {
-		secWit.ProofElements1[i] = mp1.Proof[i]
-	}
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	pkPath := "PGK.text"
-	vkPath := "PVK.text"
+	pkPath := filepath.Join("testdata", "PGK.text")
+	vkPath := filepath.Join("testdata", "PVK.text")
 
 	// ---------- 存储 Key ----------
 
@@ -960,14 +1086,14 @@ func TestCSAndPVKey(t *testing.T) {
 	//	t.Fatal(err)
 	//}
 	//
-	//err = SaveGroth16PKVK(gpk, gvk, pkPath, vkPath)
+	//err = ceremony.SaveGroth16PKVK(gpk, gvk, pkPath, vkPath)
 	//if err != nil {
 	//	t.Fatal(errors.New("failed to save groth16 pk: " + err.Error()))
 	//}
 
 	// ---------- 读取 Key ----------
 
-	rGpk, rGvk, err := LoadGroth16PKVK(pkPath, vkPath)
+	rGpk, rGvk := loadTestKeys(t, pkPath, vkPath)
 
 	vkStr := VerifyingKeyToBase64String(rGvk)
 	sTest := vkStr
@@ -978,31 +1104,25 @@ func TestCSAndPVKey(t *testing.T) {
 
 	// ---------- 读取 Key ----------
 
-	secretWitness, err := frontend.NewWitness(&secWit, ecc.BN254.ScalarField())
-	if err != nil {
-		panic(err)
-	}
-	publicWitness, err := secretWitness.Public()
-	if err != nil {
-		panic(err)
-	}
+	secretWitness, publicWitness := pw.Witness, pw.PublicWitness
 	proof, err := groth16.Prove(ccs, rGpk, secretWitness)
 	if err != nil {
 		panic(err)
 	}
 
 	err = groth16.Verify(proof, *rGvk2, publicWitness)
+	assert.Nil(t, err)
 
 	values := publicWitness.Vector().(fr.Vector)
 	var elem = values[0]
 	bs := elem.Bytes() // bs 是 [32]byte
 
-	assert.Equal(t, bs[:], mp1.Root)
+	assert.Equal(t, bs[:], pw.Assignment.Root)
 }
 
 func TestStaticParams(t *testing.T) {
 	curve := twistededwards.GetEdwardsCurve()
-	leaves, issuerSK, supervisorSK, err := LoadTestParams("params")
+	leaves, issuerSK, supervisorSK, err := LoadTestParams(filepath.Join("testdata", "params"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1045,59 +1165,16 @@ func TestStaticParams(t *testing.T) {
 		sig,
 	}
 
-	// non-member proof
-	mp1, leafRight, err := s3cross.GenNonMemProof(leaves)
-	if err != nil {
-		panic(err)
-	}
-	fmt.Println("root: ", base64.StdEncoding.EncodeToString(mp1.Root))
-	// pseudonym
-	num := big.NewInt(3)
-	nc, psu, err := s3cross.NewPseudonym(num, nonce)
-	if err != nil {
-		panic(err)
-	}
-	// elgamal
-	ct, r, err := EncryptElGamal(user.Pk, supervisor.Pk)
+	// witness
+	pw, err := s3cross.NewPseudonymWitness(DefaultConfig, leaves, nonce, big.NewInt(3), supervisor.Pk)
 	if err != nil {
 		panic(err)
 	}
+	fmt.Println("root: ", base64.StdEncoding.EncodeToString(pw.Assignment.Root.([]byte)))
 
 	circuit := S3CrossCircuit{}
 	circuit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	secWit := S3CrossCircuit{
-		Root:        mp1.Root,
-		ProofIndex1: mp1.Index,
-		Leaf1:       mp1.Leaf,
-		Leaf2:       leafRight,
-
-		IPkX:     sig.SPk.X,
-		IPkY:     sig.SPk.Y,
-		Sig:      s3cross.Sig,
-		RX:       s3cross.R.X,
-		RY:       s3cross.R.Y,
-		MessageX: user.Pk.X,
-		MessageY: user.Pk.Y,
-
-		PPkX:  psu.Pk.X,
-		PPkY:  psu.Pk.Y,
-		Nonce: nc,
-		//MaxI:  maxI,
-		USk: s3cross.Sk,
-		I:   num,
-
-		SPkX: supervisor.Pk.X,
-		SPkY: supervisor.Pk.Y,
-		C1X:  ct.C1.X,
-		C1Y:  ct.C1.Y,
-		C2X:  ct.C2.X,
-		C2Y:  ct.C2.Y,
-		R:    r,
-	}
-	secWit.ProofElements1 = make([]frontend.Variable, TreeDepth)
-	for i := 0; i < TreeDepth; i++ {
-		secWit.ProofElements1[i] = mp1.Proof[i]
-	}
+	circuit.ProofElements2 = make([]frontend.Variable, TreeDepth)
 
 	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
 	if err != nil {
@@ -1106,10 +1183,10 @@ func TestStaticParams(t *testing.T) {
 
 	// ---------- 存储 Key ----------
 
-	pkPath := "PGK.text"
-	vkPath := "PVK.text"
+	pkPath := filepath.Join("testdata", "PGK.text")
+	vkPath := filepath.Join("testdata", "PVK.text")
 
-	rGpk, rGvk, err := LoadGroth16PKVK(pkPath, vkPath)
+	rGpk, rGvk := loadTestKeys(t, pkPath, vkPath)
 
 	vkStr := VerifyingKeyToBase64String(rGvk)
 	fmt.Println("VerifyingKeyToHexString:", *vkStr)
@@ -1121,14 +1198,7 @@ func TestStaticParams(t *testing.T) {
 
 	// ---------- 读取 Key ----------
 
-	secretWitness, err := frontend.NewWitness(&secWit, ecc.BN254.ScalarField())
-	if err != nil {
-		panic(err)
-	}
-	publicWitness, err := secretWitness.Public()
-	if err != nil {
-		panic(err)
-	}
+	secretWitness, publicWitness := pw.Witness, pw.PublicWitness
 	proof, err := groth16.Prove(ccs, rGpk, secretWitness)
 	if err != nil {
 		panic(err)
@@ -1147,14 +1217,14 @@ func TestStaticParams(t *testing.T) {
 	fmt.Println("WitnessToBase64String:", *witnessStr)
 	fmt.Println("WitnessToBase64String-len:", len(*witnessStr))
 
-	indPPK := psu.Pk.Bytes()
+	indPPK := pw.Pseudonym.Pk.Bytes()
 	fmt.Println("psuStr: ", base64.StdEncoding.EncodeToString(indPPK[:]))
 
 	//values := publicWitness.Vector().(fr.Vector)
 	//var elem = values[0]
 	//bs := elem.Bytes() // bs 是 [32]byte
 	//
-	//assert.Equal(t, bs[:], mp1.Root)
+	//assert.Equal(t, bs[:], pw.Assignment.Root)
 }
 
 func SaveTestParams(filename string, leaves []*big.Int, issuerSK, supervisorSK *big.Int) error {
@@ -1192,68 +1262,6 @@ func LoadTestParams(filename string) ([]*big.Int, *big.Int, *big.Int, error) {
 	return leaves, issuerSK, supervisorSK, nil
 }
 
-func SaveGroth16PKVK(pk groth16.ProvingKey, vk groth16.VerifyingKey, pkFile, vkFile string) error {
-	pkf, err := os.Create(pkFile)
-	if err != nil {
-		return err
-	}
-	defer func(pkf *os.File) {
-		err = pkf.Close()
-		if err != nil {
-			panic(err)
-		}
-	}(pkf)
-	if _, err := pk.WriteTo(pkf); err != nil {
-		return err
-	}
-
-	vkf, err := os.Create(vkFile)
-	if err != nil {
-		return err
-	}
-	defer func(vkf *os.File) {
-		err = vkf.Close()
-		if err != nil {
-			panic(err)
-		}
-	}(vkf)
-	_, err = vk.WriteTo(vkf)
-	return err
-}
-
-func LoadGroth16PKVK(pkFile, vkFile string) (groth16.ProvingKey, groth16.VerifyingKey, error) {
-	pkf, err := os.Open(pkFile)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer func(pkf *os.File) {
-		err = pkf.Close()
-		if err != nil {
-			panic(err)
-		}
-	}(pkf)
-	pk := groth16.NewProvingKey(ecc.BN254) // curve: 你使用的曲线类型，如 ecc.BN254
-	if _, err := pk.ReadFrom(pkf); err != nil {
-		return nil, nil, err
-	}
-
-	vkf, err := os.Open(vkFile)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer func(vkf *os.File) {
-		err = vkf.Close()
-		if err != nil {
-			panic(err)
-		}
-	}(vkf)
-	vk := groth16.NewVerifyingKey(ecc.BN254)
-	if _, err := vk.ReadFrom(vkf); err != nil {
-		return nil, nil, err
-	}
-	return pk, vk, nil
-}
-
 func VerifyingKeyToBase64String(vk groth16.VerifyingKey) *string {
 	var buf bytes.Buffer
 	_, err := vk.WriteTo(&buf)
@@ -1338,3 +1346,15 @@ func base64StringToWitness(str *string) (witness.Witness, error) {
 	_, err = wit.ReadFrom(buf)
 	return wit, err
 }
+
+// loadTestKeys the groth16 keys written by SaveGroth16PKVK, the test is skipped without the key files
+func loadTestKeys(t *testing.T, pkPath, vkPath string) (groth16.ProvingKey, groth16.VerifyingKey) {
+	pk, vk, err := ceremony.LoadGroth16PKVK(pkPath, vkPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("the groth16 keys are not generated: %v", err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pk, vk
+}
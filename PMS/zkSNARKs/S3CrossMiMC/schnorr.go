@@ -5,7 +5,6 @@ import (
 	"errors"
 	"math/big"
 
-	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
 )
 
@@ -14,14 +13,64 @@ type KeyPair struct {
 	Pk *twistededwards.PointAffine `json:"pk"`
 }
 
+// CredentialScheme the signature scheme of the issuer credential, "" is the legacy schnorr
+type CredentialScheme string
+
+const (
+	SchemeSchnorr CredentialScheme = "schnorr" // legacy, s·G = R + H(pk || R || m)·pk
+	SchemeEdDSA   CredentialScheme = "eddsa"   // standard EdDSA (gnark-crypto signature/eddsa) of H(m)
+)
+
+var ErrUnknownScheme = errors.New("unknown credential scheme")
+
+// String "" is reported as schnorr
+func (cs CredentialScheme) String() string {
+	if cs == "" {
+		return string(SchemeSchnorr)
+	}
+	return string(cs)
+}
+
+func (cs CredentialScheme) check() error {
+	switch cs {
+	case "", SchemeSchnorr, SchemeEdDSA:
+		return nil
+	}
+	return ErrUnknownScheme
+}
+
 type Signature struct {
 	Sig *big.Int                    `json:"sig"`
 	R   *twistededwards.PointAffine `json:"r"`
 	M   *twistededwards.PointAffine `json:"m"`
 	SPk *twistededwards.PointAffine `json:"spk"`
+	// hash of the challenge, the credential holder uses it for the tree and the pseudonym as well
+	Hash HashID `json:"hash"`
+	// "" for the schnorr credentials of SignWith
+	Scheme CredentialScheme `json:"scheme,omitempty"`
+	// signed with M, nil for a credential valid until revoked
+	Validity *Validity `json:"validity,omitempty"`
 }
 
+// Sign sign with the MiMC challenge
 func (kp *KeyPair) Sign(message *twistededwards.PointAffine) (*Signature, *big.Int, error) {
+	return kp.SignWith(HashMiMC, message)
+}
+
+// SignWith sign with the challenge hash id
+func (kp *KeyPair) SignWith(id HashID, message *twistededwards.PointAffine) (*Signature, *big.Int, error) {
+	return kp.signSchnorr(id, message, nil)
+}
+
+// SignWithValidity SignWith a credential valid in the window v only, for the circuits of a Config with Expiry
+func (kp *KeyPair) SignWithValidity(id HashID, message *twistededwards.PointAffine, v Validity) (*Signature, *big.Int, error) {
+	if err := v.check(); err != nil {
+		return &Signature{}, nil, err
+	}
+	return kp.signSchnorr(id, message, &v)
+}
+
+func (kp *KeyPair) signSchnorr(id HashID, message *twistededwards.PointAffine, v *Validity) (*Signature, *big.Int, error) {
 	curve := twistededwards.GetEdwardsCurve()
 
 	r, err := rand.Int(rand.Reader, &curve.Order)
@@ -32,52 +81,46 @@ func (kp *KeyPair) Sign(message *twistededwards.PointAffine) (*Signature, *big.I
 	// 计算 R = r·G
 	R := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, r)
 
-	// Mimc hash: H(pk || R || m)
-	h := mimc.NewMiMC()
-
-	// 写入 Pk
-	_, err = h.Write(kp.Pk.X.Marshal())
+	// H(pk || R || m [|| notBefore || notAfter])
+	c, err := challenge(id, kp.Pk, R, message, v)
 	if err != nil {
 		return &Signature{}, r, err
 	}
-	_, err = h.Write(kp.Pk.Y.Marshal())
-	// 写入 R
-	_, err = h.Write(R.X.Marshal())
-	_, err = h.Write(R.Y.Marshal())
-	// 写入消息
-	_, err = h.Write(message.X.Marshal())
-	_, err = h.Write(message.Y.Marshal())
-
-	c := new(big.Int).SetBytes(h.Sum(nil))
 
 	// s = r + c·sk
 	s := new(big.Int).Add(r, new(big.Int).Mul(c, kp.Sk))
 	s.Mod(s, &curve.Order) // 需要手动mod
 
 	return &Signature{
-		Sig: s,
-		R:   R,
-		M:   message,
-		SPk: kp.Pk,
+		Sig:      s,
+		R:        R,
+		M:        message,
+		SPk:      kp.Pk,
+		Hash:     id,
+		Validity: v,
 	}, r, nil
 }
 
 func (s *Signature) Verify() error {
+	switch s.Scheme {
+	case "", SchemeSchnorr:
+	case SchemeEdDSA:
+		return s.verifyEdDSA()
+	default:
+		return ErrUnknownScheme
+	}
 	curve := twistededwards.GetEdwardsCurve()
+	if s.Validity != nil {
+		if err := s.Validity.check(); err != nil {
+			return err
+		}
+	}
 
-	// Mimc hash: H(pk || R || m)
-	h := mimc.NewMiMC()
-	_, err := h.Write(s.SPk.X.Marshal())
+	// H(pk || R || m [|| notBefore || notAfter])
+	c, err := challenge(s.Hash, s.SPk, s.R, s.M, s.Validity)
 	if err != nil {
 		return err
 	}
-	_, err = h.Write(s.SPk.Y.Marshal())
-	_, err = h.Write(s.R.X.Marshal())
-	_, err = h.Write(s.R.Y.Marshal())
-	_, err = h.Write(s.M.X.Marshal())
-	_, err = h.Write(s.M.Y.Marshal())
-
-	c := new(big.Int).SetBytes(h.Sum(nil))
 
 	// 验证：s·G == R + c·X
 	sG := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, s.Sig)
@@ -90,3 +133,16 @@ func (s *Signature) Verify() error {
 	}
 	return nil
 }
+
+// challenge c = H(pk || R || m), the validity window v is signed after m
+func challenge(id HashID, pk, R, message *twistededwards.PointAffine, v *Validity) (*big.Int, error) {
+	cBytes, err := id.hashBytes(append([][]byte{
+		pk.X.Marshal(), pk.Y.Marshal(),
+		R.X.Marshal(), R.Y.Marshal(),
+		message.X.Marshal(), message.Y.Marshal(),
+	}, v.message()...)...)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(cBytes), nil
+}
@@ -0,0 +1,291 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+)
+
+// Threshold supervisor key (joint Feldman DKG): the n supervisors each deal a random polynomial f_i of degree t-1,
+// supervisor j keeps sk_j = Σ f_i(j), the joint key SPk = Σ f_i(0)·G is the usual supervisor key of the circuit and the chaincode,
+// and upk is only decrypted from t partial decryptions sk_j·C1, each proven against the public VK_j = sk_j·G
+
+var (
+	ErrInvalidShare    = errors.New("invalid threshold share")
+	ErrTooFewPartials  = errors.New("not enough valid partial decryptions")
+	ErrInvalidDKGParam = errors.New("invalid threshold parameters")
+)
+
+// DKGDealer the contribution of supervisor Index (1..N) to the DKG
+type DKGDealer struct {
+	Index, T, N int
+	// Commitments[k] = a_k·G of the coefficients of f, broadcast to all supervisors
+	Commitments []*twistededwards.PointAffine
+
+	coeffs []*big.Int // a_0 .. a_{T-1}, secret
+}
+
+// ThresholdKey the key of supervisor Index after the DKG
+type ThresholdKey struct {
+	Index, T, N int
+	Sk          *big.Int                      // sk_j, secret
+	SPk         *twistededwards.PointAffine   // joint supervisor key, the SPK of the ledger
+	VKs         []*twistededwards.PointAffine // VKs[j-1] = sk_j·G, public
+}
+
+// PartialDecryption D = sk_j·C1 of supervisor Index with the DLEQ proof against VK_Index
+type PartialDecryption struct {
+	Index int                         `json:"index"`
+	D     *twistededwards.PointAffine `json:"d"`
+	Proof *DecryptionProof            `json:"proof"`
+}
+
+func checkThreshold(t, n int) error {
+	if t < 1 || n < t {
+		return fmt.Errorf("%w: need 1 <= t <= n, got t=%d n=%d", ErrInvalidDKGParam, t, n)
+	}
+	return nil
+}
+
+// NewDKGDealer sample the polynomial of supervisor index for a t of n key
+func NewDKGDealer(index, t, n int) (*DKGDealer, error) {
+	if err := checkThreshold(t, n); err != nil {
+		return nil, err
+	}
+	if index < 1 || index > n {
+		return nil, fmt.Errorf("%w: index should be in [1, %d]", ErrInvalidDKGParam, n)
+	}
+	curve := twistededwards.GetEdwardsCurve()
+	d := &DKGDealer{
+		Index:       index,
+		T:           t,
+		N:           n,
+		Commitments: make([]*twistededwards.PointAffine, t),
+		coeffs:      make([]*big.Int, t),
+	}
+	for k := 0; k < t; k++ {
+		a, err := rand.Int(rand.Reader, &curve.Order)
+		if err != nil {
+			return nil, errors.New("new dkg dealer: failed to generate coefficient -- " + err.Error())
+		}
+		d.coeffs[k] = a
+		d.Commitments[k] = new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, a)
+	}
+	return d, nil
+}
+
+// Share f(j), sent privately to supervisor j
+func (d *DKGDealer) Share(j int) (*big.Int, error) {
+	if j < 1 || j > d.N {
+		return nil, fmt.Errorf("%w: index should be in [1, %d]", ErrInvalidDKGParam, d.N)
+	}
+	curve := twistededwards.GetEdwardsCurve()
+	// Horner
+	x := big.NewInt(int64(j))
+	share := new(big.Int)
+	for k := len(d.coeffs) - 1; k >= 0; k-- {
+		share.Mul(share, x)
+		share.Add(share, d.coeffs[k])
+		share.Mod(share, &curve.Order)
+	}
+	return share, nil
+}
+
+// VerifyDKGShare check the share f(j) received by supervisor j against the dealer commitments:
+// f(j)·G = Σ A_k·j^k, a failure is a complaint against the dealer
+func VerifyDKGShare(j int, share *big.Int, commitments []*twistededwards.PointAffine) error {
+	if share == nil {
+		return fmt.Errorf("%w: missing share", ErrInvalidShare)
+	}
+	expected, err := evalCommitments(j, commitments)
+	if err != nil {
+		return err
+	}
+	curve := twistededwards.GetEdwardsCurve()
+	got := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, share)
+	if !got.Equal(expected) {
+		return fmt.Errorf("%w: share of %d does not match the commitments", ErrInvalidShare, j)
+	}
+	return nil
+}
+
+// evalCommitments Σ A_k·j^k
+func evalCommitments(j int, commitments []*twistededwards.PointAffine) (*twistededwards.PointAffine, error) {
+	if len(commitments) == 0 {
+		return nil, fmt.Errorf("%w: missing commitments", ErrInvalidShare)
+	}
+	curve := twistededwards.GetEdwardsCurve()
+	x := big.NewInt(int64(j))
+	xk := big.NewInt(1)
+	res := identity()
+	for _, A := range commitments {
+		if !inSubgroup(A) {
+			return nil, fmt.Errorf("%w: commitment is not a point of the subgroup", ErrInvalidShare)
+		}
+		res.Add(res, new(twistededwards.PointAffine).ScalarMultiplication(A, xk))
+		xk = new(big.Int).Mul(xk, x)
+		xk.Mod(xk, &curve.Order)
+	}
+	return res, nil
+}
+
+// DKGPublicKeys the joint key SPk = Σ A_i0 and the verification keys VK_j of the n supervisors
+// commitments: the commitments of the qualified dealers
+func DKGPublicKeys(n int, commitments [][]*twistededwards.PointAffine) (*twistededwards.PointAffine, []*twistededwards.PointAffine, error) {
+	if len(commitments) == 0 {
+		return nil, nil, fmt.Errorf("%w: no dealer", ErrInvalidDKGParam)
+	}
+	spk, err := sumEval(0, commitments)
+	if err != nil {
+		return nil, nil, err
+	}
+	vks := make([]*twistededwards.PointAffine, n)
+	for j := 1; j <= n; j++ {
+		vks[j-1], err = sumEval(j, commitments)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return spk, vks, nil
+}
+
+func sumEval(j int, commitments [][]*twistededwards.PointAffine) (*twistededwards.PointAffine, error) {
+	res := identity()
+	for _, c := range commitments {
+		p, err := evalCommitments(j, c)
+		if err != nil {
+			return nil, err
+		}
+		res.Add(res, p)
+	}
+	return res, nil
+}
+
+// FinishDKG the key of supervisor j from the shares it received from the qualified dealers,
+// shares[i] is from the dealer of commitments[i]; an invalid share is reported before anything is combined
+func FinishDKG(j, t, n int, shares []*big.Int, commitments [][]*twistededwards.PointAffine) (*ThresholdKey, error) {
+	if err := checkThreshold(t, n); err != nil {
+		return nil, err
+	}
+	if j < 1 || j > n {
+		return nil, fmt.Errorf("%w: index should be in [1, %d]", ErrInvalidDKGParam, n)
+	}
+	if len(shares) != len(commitments) {
+		return nil, fmt.Errorf("%w: %d shares for %d dealers", ErrInvalidDKGParam, len(shares), len(commitments))
+	}
+	curve := twistededwards.GetEdwardsCurve()
+	sk := new(big.Int)
+	for i := range shares {
+		if len(commitments[i]) != t {
+			return nil, fmt.Errorf("%w: dealer %d committed to degree %d", ErrInvalidShare, i, len(commitments[i])-1)
+		}
+		if err := VerifyDKGShare(j, shares[i], commitments[i]); err != nil {
+			return nil, fmt.Errorf("dealer %d: %w", i, err)
+		}
+		sk.Add(sk, shares[i])
+	}
+	sk.Mod(sk, &curve.Order)
+
+	spk, vks, err := DKGPublicKeys(n, commitments)
+	if err != nil {
+		return nil, err
+	}
+	return &ThresholdKey{
+		Index: j,
+		T:     t,
+		N:     n,
+		Sk:    sk,
+		SPk:   spk,
+		VKs:   vks,
+	}, nil
+}
+
+// PartialDecrypt D_j = sk_j·C1 with its DLEQ proof
+func (tk *ThresholdKey) PartialDecrypt(eg *ElGamal) (*PartialDecryption, error) {
+	if eg == nil || eg.C1 == nil || eg.C2 == nil {
+		return nil, errors.New("partial decrypt: missing ciphertext")
+	}
+	D := new(twistededwards.PointAffine).ScalarMultiplication(eg.C1, tk.Sk)
+	proof, err := ProveDLEQ(tk.Sk, tk.VKs[tk.Index-1], eg.C1, D)
+	if err != nil {
+		return nil, err
+	}
+	return &PartialDecryption{
+		Index: tk.Index,
+		D:     D,
+		Proof: proof,
+	}, nil
+}
+
+// VerifyPartialDecryption check the partial decryption of eg against the verification keys of the DKG
+func VerifyPartialDecryption(vks []*twistededwards.PointAffine, eg *ElGamal, pd *PartialDecryption) error {
+	if pd == nil || pd.Index < 1 || pd.Index > len(vks) {
+		return fmt.Errorf("%w: unknown supervisor", ErrInvalidShare)
+	}
+	if eg == nil || eg.C1 == nil {
+		return errors.New("verify partial decryption: missing ciphertext")
+	}
+	if err := VerifyDLEQ(vks[pd.Index-1], eg.C1, pd.D, pd.Proof); err != nil {
+		return fmt.Errorf("%w: supervisor %d: %v", ErrInvalidShare, pd.Index, err)
+	}
+	return nil
+}
+
+// CombineDecryption upk = C2 - Σ λ_j·D_j over t valid partial decryptions of distinct supervisors,
+// the invalid ones are skipped
+func CombineDecryption(eg *ElGamal, t int, vks []*twistededwards.PointAffine, partials []*PartialDecryption) (*twistededwards.PointAffine, error) {
+	if err := checkThreshold(t, len(vks)); err != nil {
+		return nil, err
+	}
+	if eg == nil || eg.C2 == nil {
+		return nil, errors.New("combine decryption: missing ciphertext")
+	}
+	if !inSubgroup(eg.C2) {
+		return nil, errors.New("combine decryption: not a point of the subgroup")
+	}
+	var valid []*PartialDecryption
+	seen := make(map[int]bool)
+	for _, pd := range partials {
+		if len(valid) == t {
+			break
+		}
+		if pd == nil || seen[pd.Index] || VerifyPartialDecryption(vks, eg, pd) != nil {
+			continue
+		}
+		seen[pd.Index] = true
+		valid = append(valid, pd)
+	}
+	if len(valid) < t {
+		return nil, fmt.Errorf("%w: %d of %d", ErrTooFewPartials, len(valid), t)
+	}
+
+	// sk·C1 = Σ λ_j·D_j, λ_j = Π_{m != j} m/(m - j)
+	curve := twistededwards.GetEdwardsCurve()
+	skC1 := identity()
+	for _, pj := range valid {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for _, pm := range valid {
+			if pm.Index == pj.Index {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(pm.Index)))
+			den.Mul(den, big.NewInt(int64(pm.Index-pj.Index)))
+		}
+		den.Mod(den, &curve.Order)
+		lambda := num.Mul(num, den.ModInverse(den, &curve.Order))
+		lambda.Mod(lambda, &curve.Order)
+		skC1.Add(skC1, new(twistededwards.PointAffine).ScalarMultiplication(pj.D, lambda))
+	}
+	return decryptionShare(eg.C2, skC1), nil
+}
+
+// identity the neutral point (0, 1)
+func identity() *twistededwards.PointAffine {
+	p := new(twistededwards.PointAffine)
+	p.X.SetZero()
+	p.Y.SetOne()
+	return p
+}
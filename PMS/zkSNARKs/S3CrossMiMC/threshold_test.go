@@ -0,0 +1,142 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/stretchr/testify/assert"
+)
+
+// runDKG a t of n DKG where every supervisor is honest
+func runDKG(tb testing.TB, t, n int) []*ThresholdKey {
+	dealers := make([]*DKGDealer, n)
+	commitments := make([][]*twistededwards.PointAffine, n)
+	for i := range dealers {
+		d, err := NewDKGDealer(i+1, t, n)
+		assert.NoError(tb, err)
+		dealers[i] = d
+		commitments[i] = d.Commitments
+	}
+	keys := make([]*ThresholdKey, n)
+	for j := 1; j <= n; j++ {
+		shares := make([]*big.Int, n)
+		for i, d := range dealers {
+			share, err := d.Share(j)
+			assert.NoError(tb, err)
+			shares[i] = share
+		}
+		tk, err := FinishDKG(j, t, n, shares, commitments)
+		assert.NoError(tb, err)
+		keys[j-1] = tk
+	}
+	return keys
+}
+
+func TestThresholdDecryption(t *testing.T) {
+	const th, n = 3, 5
+	curve := twistededwards.GetEdwardsCurve()
+	keys := runDKG(t, th, n)
+	spk, vks := keys[0].SPk, keys[0].VKs
+	for _, tk := range keys {
+		assert.True(t, tk.SPk.Equal(spk))
+		assert.True(t, new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, tk.Sk).Equal(vks[tk.Index-1]))
+	}
+
+	// the joint key is an ordinary supervisor key for EncryptElGamal
+	usk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(t, err)
+	upk := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, usk)
+	ct, _, err := EncryptElGamal(upk, spk)
+	assert.NoError(t, err)
+
+	partials := make([]*PartialDecryption, n)
+	for i, tk := range keys {
+		pd, err := tk.PartialDecrypt(ct)
+		assert.NoError(t, err)
+		assert.NoError(t, VerifyPartialDecryption(vks, ct, pd))
+		partials[i] = pd
+	}
+
+	// any t partial decryptions
+	for _, set := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		var sub []*PartialDecryption
+		for _, i := range set {
+			sub = append(sub, partials[i])
+		}
+		m, err := CombineDecryption(ct, th, vks, sub)
+		assert.NoError(t, err, "partials %v", set)
+		assert.True(t, m.Equal(upk), "partials %v", set)
+	}
+
+	// the json of a partial decryption
+	data, err := json.Marshal(partials[1])
+	assert.NoError(t, err)
+	var decoded PartialDecryption
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.NoError(t, VerifyPartialDecryption(vks, ct, &decoded))
+
+	// t-1 partials, repeated partials
+	_, err = CombineDecryption(ct, th, vks, partials[:th-1])
+	assert.ErrorIs(t, err, ErrTooFewPartials)
+	_, err = CombineDecryption(ct, th, vks, []*PartialDecryption{partials[0], partials[0], partials[1]})
+	assert.ErrorIs(t, err, ErrTooFewPartials)
+
+	// a wrong partial decryption is rejected and skipped
+	bad := *partials[2]
+	bad.D = new(twistededwards.PointAffine).Add(bad.D, &curve.Base)
+	assert.ErrorIs(t, VerifyPartialDecryption(vks, ct, &bad), ErrInvalidShare)
+	relabeled := *partials[2]
+	relabeled.Index = 4
+	assert.ErrorIs(t, VerifyPartialDecryption(vks, ct, &relabeled), ErrInvalidShare)
+	_, err = CombineDecryption(ct, th, vks, []*PartialDecryption{partials[0], &bad, partials[1]})
+	assert.ErrorIs(t, err, ErrTooFewPartials)
+	m, err := CombineDecryption(ct, th, vks, []*PartialDecryption{partials[0], &bad, partials[1], partials[3]})
+	assert.NoError(t, err)
+	assert.True(t, m.Equal(upk))
+
+	// D_j plus a point of order 2, with a proof of an even challenge, would shift upk by λ_j·T
+	torsioned := *partials[2]
+	torsioned.D, torsioned.Proof = forgeTorsion(t, keys[2].Sk, vks[2], ct.C1, partials[2].D)
+	assert.ErrorIs(t, VerifyPartialDecryption(vks, ct, &torsioned), ErrInvalidShare)
+	m, err = CombineDecryption(ct, th, vks, []*PartialDecryption{&torsioned, partials[0], partials[1], partials[3]})
+	assert.NoError(t, err)
+	assert.True(t, m.Equal(upk))
+	torsionedCt := &ElGamal{C1: new(twistededwards.PointAffine).Add(ct.C1, torsion()), C2: ct.C2}
+	assert.Error(t, VerifyPartialDecryption(vks, torsionedCt, partials[0]))
+	_, err = evalCommitments(1, []*twistededwards.PointAffine{new(twistededwards.PointAffine).Add(spk, torsion())})
+	assert.ErrorIs(t, err, ErrInvalidShare)
+}
+
+func TestDKGInvalidShare(t *testing.T) {
+	const th, n = 2, 3
+	dealers := make([]*DKGDealer, n)
+	commitments := make([][]*twistededwards.PointAffine, n)
+	for i := range dealers {
+		d, err := NewDKGDealer(i+1, th, n)
+		assert.NoError(t, err)
+		dealers[i] = d
+		commitments[i] = d.Commitments
+	}
+	shares := make([]*big.Int, n)
+	for i, d := range dealers {
+		share, err := d.Share(2)
+		assert.NoError(t, err)
+		shares[i] = share
+	}
+	assert.NoError(t, VerifyDKGShare(2, shares[0], commitments[0]))
+	assert.ErrorIs(t, VerifyDKGShare(3, shares[0], commitments[0]), ErrInvalidShare)
+
+	shares[1] = new(big.Int).Add(shares[1], big.NewInt(1))
+	_, err := FinishDKG(2, th, n, shares, commitments)
+	assert.ErrorIs(t, err, ErrInvalidShare)
+
+	_, err = NewDKGDealer(1, 4, 3)
+	assert.ErrorIs(t, err, ErrInvalidDKGParam)
+	_, err = NewDKGDealer(0, 2, 3)
+	assert.ErrorIs(t, err, ErrInvalidDKGParam)
+	_, err = dealers[0].Share(4)
+	assert.ErrorIs(t, err, ErrInvalidDKGParam)
+}
@@ -0,0 +1,242 @@
+package s3cross
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+)
+
+// TreeHead a revocation root published by the supervisor (UpdateRoot of the chaincode)
+// Root: root of the zk revocation tree, the public Root of the proofs
+// Size, LogRoot: RFC 6962 Merkle tree hash of the first Size entries of the revocation log (RevocationLog),
+// Root is the tree of these entries (ReplayRevocations, ApplyRevocations)
+// Epoch: +1 for each published head, an older head can not be published again
+type TreeHead struct {
+	Epoch   uint64 `json:"epoch"`
+	Size    uint64 `json:"size"`
+	Root    []byte `json:"root"`
+	LogRoot []byte `json:"logRoot"`
+}
+
+// SignedTreeHead a TreeHead with the signature of the log key (LPK of the chaincode)
+type SignedTreeHead struct {
+	TreeHead
+	Signature *TreeHeadSignature `json:"signature"`
+}
+
+// TreeHeadSignature Schnorr signature of a TreeHead, R = s·G - c·lpk and c = H(G, lpk, R, head) mod l
+type TreeHeadSignature struct {
+	C *big.Int `json:"c"`
+	S *big.Int `json:"s"`
+}
+
+// SignTreeHead sign the head with the log key sk of lpk = sk·G, a single key the supervisor keeps apart from
+// the ElGamal key SPK, so SPK can be a threshold key whose shares decrypt without signing the tree heads
+func SignTreeHead(sk *big.Int, head TreeHead) (*SignedTreeHead, error) {
+	curve := twistededwards.GetEdwardsCurve()
+	if err := head.check(); err != nil {
+		return nil, err
+	}
+	lpk := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk)
+
+	// random nonce
+	k, err := rand.Int(rand.Reader, &curve.Order)
+	if err != nil {
+		return nil, errors.New("sign tree head: failed to generate random nonce -- " + err.Error())
+	}
+	R := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, k)
+
+	c := treeHeadChallenge(lpk, R, &head)
+	// s = k + c·sk
+	s := new(big.Int).Add(k, new(big.Int).Mul(c, sk))
+	s.Mod(s, &curve.Order)
+	return &SignedTreeHead{
+		TreeHead:  head,
+		Signature: &TreeHeadSignature{C: c, S: s},
+	}, nil
+}
+
+// VerifyTreeHead check the signature of the head by the log key lpk
+func VerifyTreeHead(lpk *twistededwards.PointAffine, sth *SignedTreeHead) error {
+	curve := twistededwards.GetEdwardsCurve()
+	if sth == nil || sth.Signature == nil || sth.Signature.C == nil || sth.Signature.S == nil {
+		return errors.New("verify tree head: missing signature")
+	}
+	if lpk == nil || !lpk.IsOnCurve() {
+		return errors.New("verify tree head: not a curve point")
+	}
+	if err := sth.check(); err != nil {
+		return err
+	}
+
+	// R = s·G - c·lpk
+	R := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sth.Signature.S)
+	R.Add(R, new(twistededwards.PointAffine).Neg(new(twistededwards.PointAffine).ScalarMultiplication(lpk, sth.Signature.C)))
+
+	c := treeHeadChallenge(lpk, R, &sth.TreeHead)
+	if c.Cmp(sth.Signature.C) != 0 {
+		return errors.New("verify tree head: invalid signature")
+	}
+	return nil
+}
+
+// check the roots are 32 bytes hashes
+func (h *TreeHead) check() error {
+	if len(h.Root) != 32 || len(h.LogRoot) != sha256.Size {
+		return errors.New("tree head: the roots should be 32 bytes")
+	}
+	return nil
+}
+
+// treeHeadChallenge c = H(G, lpk, R, epoch, size, root, logRoot) mod l
+func treeHeadChallenge(lpk, R *twistededwards.PointAffine, head *TreeHead) *big.Int {
+	curve := twistededwards.GetEdwardsCurve()
+	hs := sha256.New()
+	hs.Write([]byte("s3cross/tree-head"))
+	for _, p := range []*twistededwards.PointAffine{&curve.Base, lpk, R} {
+		hs.Write(p.Marshal())
+	}
+	hs.Write(binary.BigEndian.AppendUint64(nil, head.Epoch))
+	hs.Write(binary.BigEndian.AppendUint64(nil, head.Size))
+	hs.Write(head.Root)
+	hs.Write(head.LogRoot)
+	c := new(big.Int).SetBytes(hs.Sum(nil))
+	return c.Mod(c, &curve.Order)
+}
+
+// ===== RFC 6962 log =====
+
+// LogLeafHash the RFC 6962 hash of a log entry, SHA-256(0x00 || entry)
+func LogLeafHash(entry []byte) []byte {
+	hs := sha256.New()
+	hs.Write([]byte{0})
+	hs.Write(entry)
+	return hs.Sum(nil)
+}
+
+// logNodeHash SHA-256(0x01 || left || right)
+func logNodeHash(left, right []byte) []byte {
+	hs := sha256.New()
+	hs.Write([]byte{1})
+	hs.Write(left)
+	hs.Write(right)
+	return hs.Sum(nil)
+}
+
+// LogRoot the RFC 6962 Merkle tree hash of the entries, SHA-256() for no entry
+func LogRoot(entries [][]byte) []byte {
+	leaves := make([][]byte, len(entries))
+	for i, e := range entries {
+		leaves[i] = LogLeafHash(e)
+	}
+	return logSubtreeRoot(leaves)
+}
+
+// logSubtreeRoot MTH of the leaf hashes
+func logSubtreeRoot(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		h := sha256.Sum256(nil)
+		return h[:]
+	case 1:
+		return leaves[0]
+	}
+	k := splitSize(uint64(len(leaves)))
+	return logNodeHash(logSubtreeRoot(leaves[:k]), logSubtreeRoot(leaves[k:]))
+}
+
+// splitSize the largest power of 2 smaller than n (n > 1)
+func splitSize(n uint64) uint64 {
+	return 1 << (bits.Len64(n-1) - 1)
+}
+
+// ConsistencyProof the RFC 6962 proof that the log of the first oldSize entries is a prefix of the entries,
+// empty for oldSize 0 or len(entries)
+func ConsistencyProof(entries [][]byte, oldSize uint64) ([][]byte, error) {
+	if oldSize > uint64(len(entries)) {
+		return nil, errors.New("consistency proof: the old size is larger than the log")
+	}
+	if oldSize == 0 || oldSize == uint64(len(entries)) {
+		return [][]byte{}, nil
+	}
+	leaves := make([][]byte, len(entries))
+	for i, e := range entries {
+		leaves[i] = LogLeafHash(e)
+	}
+	return consistencySubproof(oldSize, leaves, true), nil
+}
+
+// consistencySubproof SUBPROOF(m, D[n], b) of RFC 6962 2.1.2
+func consistencySubproof(m uint64, leaves [][]byte, complete bool) [][]byte {
+	n := uint64(len(leaves))
+	if m == n {
+		if complete {
+			return [][]byte{}
+		}
+		return [][]byte{logSubtreeRoot(leaves)}
+	}
+	k := splitSize(n)
+	if m <= k {
+		return append(consistencySubproof(m, leaves[:k], complete), logSubtreeRoot(leaves[k:]))
+	}
+	return append(consistencySubproof(m-k, leaves[k:], false), logSubtreeRoot(leaves[:k]))
+}
+
+// VerifyConsistency check the proof that the log of newRoot (newSize entries) extends the log of oldRoot
+// (oldSize entries), as RFC 9162 2.1.4.2, any log extends the empty one
+func VerifyConsistency(oldSize, newSize uint64, oldRoot, newRoot []byte, proof [][]byte) error {
+	switch {
+	case oldSize > newSize:
+		return errors.New("verify consistency: the new log is smaller")
+	case oldSize == 0:
+		if len(proof) != 0 {
+			return errors.New("verify consistency: unexpected proof for the empty log")
+		}
+		return nil
+	case oldSize == newSize:
+		if len(proof) != 0 || !bytes.Equal(oldRoot, newRoot) {
+			return errors.New("verify consistency: different roots of the same size")
+		}
+		return nil
+	case len(proof) == 0:
+		return errors.New("verify consistency: missing proof")
+	}
+
+	// the old root is the first node of the path when the old tree is a complete subtree
+	if oldSize&(oldSize-1) == 0 {
+		proof = append([][]byte{oldRoot}, proof...)
+	}
+	fn, sn := oldSize-1, newSize-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	oldHash, newHash := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return errors.New("verify consistency: proof too long")
+		}
+		if fn&1 == 1 || fn == sn {
+			oldHash = logNodeHash(c, oldHash)
+			newHash = logNodeHash(c, newHash)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			newHash = logNodeHash(newHash, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(oldHash, oldRoot) || !bytes.Equal(newHash, newRoot) {
+		return errors.New("verify consistency: invalid proof")
+	}
+	return nil
+}
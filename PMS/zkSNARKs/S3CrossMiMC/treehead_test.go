@@ -0,0 +1,90 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignTreeHead(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	sk, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(t, err)
+	lpk := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk)
+
+	root := sha256.Sum256([]byte("root"))
+	head := TreeHead{Epoch: 3, Size: 10, Root: root[:], LogRoot: LogRoot([][]byte{[]byte("a")})}
+	sth, err := SignTreeHead(sk, head)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyTreeHead(lpk, sth))
+
+	// json round trip, as UpdateRoot gets it
+	data, err := json.Marshal(sth)
+	assert.NoError(t, err)
+	var parsed SignedTreeHead
+	assert.NoError(t, json.Unmarshal(data, &parsed))
+	assert.NoError(t, VerifyTreeHead(lpk, &parsed))
+
+	// any field of the head is signed
+	for name, tamper := range map[string]func(h *TreeHead){
+		"epoch":    func(h *TreeHead) { h.Epoch++ },
+		"size":     func(h *TreeHead) { h.Size-- },
+		"root":     func(h *TreeHead) { h.Root = append([]byte{1}, h.Root[1:]...) },
+		"log root": func(h *TreeHead) { h.LogRoot = LogRoot(nil) },
+	} {
+		forged := *sth
+		tamper(&forged.TreeHead)
+		assert.Error(t, VerifyTreeHead(lpk, &forged), name)
+	}
+	other := new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk.Add(sk, sk))
+	assert.Error(t, VerifyTreeHead(other, sth))
+	assert.Error(t, VerifyTreeHead(lpk, &SignedTreeHead{TreeHead: head}))
+
+	_, err = SignTreeHead(sk, TreeHead{Epoch: 1, Root: []byte{1}, LogRoot: LogRoot(nil)})
+	assert.Error(t, err)
+}
+
+func TestConsistencyProof(t *testing.T) {
+	const n = 33
+	entries := make([][]byte, n)
+	for i := range entries {
+		entries[i] = []byte(strconv.Itoa(i))
+	}
+	roots := make([][]byte, n+1)
+	for size := range roots {
+		roots[size] = LogRoot(entries[:size])
+	}
+	// RFC 6962 test vectors shape: the root of a single entry is its leaf hash
+	assert.Equal(t, LogLeafHash(entries[0]), roots[1])
+	assert.Equal(t, logNodeHash(roots[1], LogLeafHash(entries[1])), roots[2])
+
+	for size := uint64(0); size <= n; size++ {
+		for old := uint64(0); old <= size; old++ {
+			proof, err := ConsistencyProof(entries[:size], old)
+			assert.NoError(t, err)
+			assert.NoError(t, VerifyConsistency(old, size, roots[old], roots[size], proof), "%d -> %d", old, size)
+			if old == 0 || old == size {
+				continue
+			}
+
+			// a changed entry of the old log, of the new log or of the proof
+			forged := make([][]byte, size)
+			copy(forged, entries[:size])
+			forged[old-1] = []byte("changed")
+			assert.Error(t, VerifyConsistency(old, size, roots[old], LogRoot(forged), proof), "%d -> %d", old, size)
+			assert.Error(t, VerifyConsistency(old, size, roots[old-1], roots[size], proof))
+			assert.Error(t, VerifyConsistency(old, size, roots[old], roots[size], proof[:len(proof)-1]))
+			assert.Error(t, VerifyConsistency(old, size, roots[old], roots[size], append(proof, roots[0])))
+		}
+	}
+	assert.Error(t, VerifyConsistency(3, 2, roots[3], roots[2], nil))
+	assert.Error(t, VerifyConsistency(2, 2, roots[2], roots[3], nil))
+	assert.Error(t, VerifyConsistency(0, 2, roots[0], roots[2], [][]byte{roots[1]}))
+	_, err := ConsistencyProof(entries[:2], 3)
+	assert.Error(t, err)
+}
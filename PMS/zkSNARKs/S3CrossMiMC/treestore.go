@@ -0,0 +1,49 @@
+package s3cross
+
+import "sync"
+
+// TreeStore the key-value store of an OrderedMerkleTree
+// Write applies a batch atomically, a nil value deletes its key
+type TreeStore interface {
+	Get(key string) ([]byte, error) // nil for a missing key
+	Write(batch map[string][]byte) error
+	Close() error
+}
+
+// MemoryStore a TreeStore in memory
+type MemoryStore struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{data: make(map[string][]byte)}
+}
+
+func (m *MemoryStore) Get(key string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.data[key], nil
+}
+
+func (m *MemoryStore) Write(batch map[string][]byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	apply(m.data, batch)
+	return nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+// apply the batch to data
+func apply(data map[string][]byte, batch map[string][]byte) {
+	for k, v := range batch {
+		if v == nil {
+			delete(data, k)
+			continue
+		}
+		data[k] = v
+	}
+}
@@ -0,0 +1,108 @@
+package s3cross
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+var (
+	ErrInvalidValidity       = errors.New("invalid credential validity window")
+	ErrCredentialExpired     = errors.New("credential expired")
+	ErrCredentialNotYetValid = errors.New("credential not yet valid")
+)
+
+// Validity the window [NotBefore, NotAfter] (unix seconds) signed with upk by the issuer,
+// a credential without one is valid until revoked
+type Validity struct {
+	NotBefore int64 `json:"notBefore"`
+	NotAfter  int64 `json:"notAfter"`
+}
+
+func (v *Validity) check() error {
+	if v.NotBefore < 0 || v.NotAfter < v.NotBefore {
+		return fmt.Errorf("%w: need 0 <= notBefore <= notAfter, got [%d, %d]", ErrInvalidValidity, v.NotBefore, v.NotAfter)
+	}
+	return nil
+}
+
+// ValidAt check the unix time t is in the window
+func (v *Validity) ValidAt(t int64) error {
+	if t < v.NotBefore {
+		return fmt.Errorf("%w: valid from %s", ErrCredentialNotYetValid, time.Unix(v.NotBefore, 0).UTC())
+	}
+	if t > v.NotAfter {
+		return fmt.Errorf("%w: valid until %s", ErrCredentialExpired, time.Unix(v.NotAfter, 0).UTC())
+	}
+	return nil
+}
+
+// message the signed words of the window, appended to the credential message
+func (v *Validity) message() [][]byte {
+	if v == nil {
+		return nil
+	}
+	nb := new(fr.Element).SetUint64(uint64(v.NotBefore)).Marshal()
+	na := new(fr.Element).SetUint64(uint64(v.NotAfter)).Marshal()
+	return [][]byte{nb, na}
+}
+
+// ValidAt check the credential is valid at the unix time t, always true without a validity window
+func (s *Signature) ValidAt(t int64) error {
+	if s.Validity == nil {
+		return nil
+	}
+	return s.Validity.ValidAt(t)
+}
+
+// ValidityWindow the validity window of the credential in a circuit of a Config with Expiry,
+// CurrentTime is set by the prover and checked against the transaction timestamp by the chaincode
+type ValidityWindow struct {
+	CurrentTime frontend.Variable `gnark:",public"`
+	NotBefore   frontend.Variable // private, signed
+	NotAfter    frontend.Variable // private, signed
+}
+
+// validityWindows the Validity field of a circuit shape, one window with Expiry
+func validityWindows(cfg Config) []ValidityWindow {
+	if !cfg.Expiry {
+		return nil
+	}
+	return make([]ValidityWindow, 1)
+}
+
+// credentialWindow the words the credential signs after upk, none without a window
+func credentialWindow(validity []ValidityWindow) ([]frontend.Variable, error) {
+	switch len(validity) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []frontend.Variable{validity[0].NotBefore, validity[0].NotAfter}, nil
+	}
+	return nil, fmt.Errorf("%w: %d validity windows", ErrInvalidConfig, len(validity))
+}
+
+// AssertValidity check NotBefore <= CurrentTime <= NotAfter, all of them unix seconds on 63 bits
+func AssertValidity(api frontend.API, rc frontend.Rangechecker, v ValidityWindow) {
+	rc.Check(v.CurrentTime, 63)
+	rc.Check(v.NotBefore, 63)
+	rc.Check(v.NotAfter, 63)
+	// // the differences are in [0, 2^63) only if they are not negative
+	rc.Check(api.Sub(v.CurrentTime, v.NotBefore), 63)
+	rc.Check(api.Sub(v.NotAfter, v.CurrentTime), 63)
+}
+
+// assertValidity AssertValidity of the window of the circuit, if any
+func assertValidity(api frontend.API, validity []ValidityWindow) {
+	if len(validity) == 0 {
+		return
+	}
+	rc := rangecheck.New(api)
+	for _, v := range validity {
+		AssertValidity(api, rc, v)
+	}
+}
@@ -0,0 +1,132 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignWithValidity(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	issuer, user := newKeyPair(), newKeyPair()
+	v := Validity{NotBefore: 1_700_000_000, NotAfter: 1_700_086_400}
+
+	schnorr, _, err := issuer.SignWithValidity(HashPoseidon2, user.Pk, v)
+	assert.NoError(t, err)
+	eddsa, err := issuer.SignEdDSAWithValidity(HashPoseidon2, user.Pk, v)
+	assert.NoError(t, err)
+	for _, sig := range []*Signature{schnorr, eddsa} {
+		assert.NoError(t, sig.Verify())
+		assert.NoError(t, sig.ValidAt(v.NotBefore))
+		assert.NoError(t, sig.ValidAt(v.NotAfter))
+		assert.ErrorIs(t, sig.ValidAt(v.NotBefore-1), ErrCredentialNotYetValid)
+		assert.ErrorIs(t, sig.ValidAt(v.NotAfter+1), ErrCredentialExpired)
+
+		// the window is signed
+		extended := *sig
+		extended.Validity = &Validity{NotBefore: v.NotBefore, NotAfter: v.NotAfter + 1}
+		assert.Error(t, extended.Verify())
+		dropped := *sig
+		dropped.Validity = nil
+		assert.Error(t, dropped.Verify())
+	}
+
+	// a credential without a window is valid until revoked
+	plain, _, err := issuer.SignWith(HashPoseidon2, user.Pk)
+	assert.NoError(t, err)
+	assert.NoError(t, plain.ValidAt(0))
+
+	_, _, err = issuer.SignWithValidity(HashPoseidon2, user.Pk, Validity{NotBefore: 2, NotAfter: 1})
+	assert.ErrorIs(t, err, ErrInvalidValidity)
+	_, err = issuer.SignEdDSAWithValidity(HashPoseidon2, user.Pk, Validity{NotBefore: -1, NotAfter: 1})
+	assert.ErrorIs(t, err, ErrInvalidValidity)
+}
+
+func TestExpiryCircuit(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	nonce, err := getRandomPointAffine()
+	assert.NoError(t, err)
+	leaves := []*big.Int{maxValue(), big.NewInt(0)}
+	for i := 0; i < 30; i++ {
+		leaf, err := rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	issuer, supervisor, user := newKeyPair(), newKeyPair(), newKeyPair()
+	now := time.Now().Unix()
+	valid := Validity{NotBefore: now - 3600, NotAfter: now + 3600}
+
+	for _, scheme := range []CredentialScheme{SchemeSchnorr, SchemeEdDSA} {
+		cfg := Config{Depth: 10, IndexBits: 4, Hash: HashMiMC, Credential: scheme, Expiry: true}
+		sign := func(v Validity) *S3Cross {
+			var sig *Signature
+			var err error
+			if scheme == SchemeEdDSA {
+				sig, err = issuer.SignEdDSAWithValidity(cfg.Hash, user.Pk, v)
+			} else {
+				sig, _, err = issuer.SignWithValidity(cfg.Hash, user.Pk, v)
+				sig.Scheme = SchemeSchnorr
+			}
+			assert.NoError(t, err)
+			return &S3Cross{user, sig}
+		}
+
+		pw, err := sign(valid).NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(1), supervisor.Pk)
+		assert.NoError(t, err)
+		circuit, err := NewCircuit(cfg)
+		assert.NoError(t, err)
+		assert.NoError(t, test.IsSolved(circuit, pw.Assignment, ecc.BN254.ScalarField()), scheme)
+
+		schema, err := PublicSchemaOf(cfg)
+		assert.NoError(t, err)
+		in, err := schema.Read(pw.PublicWitness)
+		assert.NoError(t, err)
+		assert.True(t, in.Expiry())
+		currentTime := in.CurrentTime()
+		assert.Equal(t, pw.Assignment.Validity[0].CurrentTime, int64(currentTime.Uint64()))
+
+		// a CurrentTime out of the window, or a window that is not the signed one
+		for _, w := range []ValidityWindow{
+			{CurrentTime: valid.NotAfter + 1, NotBefore: valid.NotBefore, NotAfter: valid.NotAfter},
+			{CurrentTime: valid.NotBefore - 1, NotBefore: valid.NotBefore, NotAfter: valid.NotAfter},
+			{CurrentTime: valid.NotAfter + 1, NotBefore: valid.NotBefore, NotAfter: valid.NotAfter + 10},
+		} {
+			wrong := *pw.Assignment
+			wrong.Validity = []ValidityWindow{w}
+			assert.Error(t, test.IsSolved(circuit, &wrong, ecc.BN254.ScalarField()), "%v", w)
+		}
+
+		// the native checks
+		_, err = sign(Validity{NotBefore: now - 7200, NotAfter: now - 3600}).NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrCredentialExpired)
+		_, err = sign(Validity{NotBefore: now + 3600, NotAfter: now + 7200}).NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrCredentialNotYetValid)
+		noExpiry := cfg
+		noExpiry.Expiry = false
+		_, err = sign(valid).NewPseudonymWitness(noExpiry, leaves, nonce, big.NewInt(1), supervisor.Pk)
+		assert.ErrorIs(t, err, ErrInvalidWitnessInput)
+	}
+}
@@ -0,0 +1,123 @@
+package s3cross
+
+import (
+	"container/heap"
+	"math/big"
+	"math/rand/v2"
+)
+
+// valueIndex the sorted values of an OrderedMerkleTree with their leaf slots, a skip list:
+// the low leaf of a value, an insert and a delete in O(log n) expected
+type valueIndex struct {
+	head  indexNode // before the first value, head.value is nil
+	level int
+	size  int
+}
+
+type indexNode struct {
+	value *big.Int
+	slot  int
+	next  []*indexNode
+}
+
+const (
+	indexMaxLevel = 32 // 4^32 values
+	indexP        = 4  // a node is on the level i+1 with the probability 1/indexP
+)
+
+func newValueIndex() *valueIndex {
+	return &valueIndex{head: indexNode{next: make([]*indexNode, indexMaxLevel)}, level: 1}
+}
+
+// Len the number of values
+func (x *valueIndex) Len() int {
+	return x.size
+}
+
+// first the node of the smallest value, nil for an empty index
+func (x *valueIndex) first() *indexNode {
+	return x.head.next[0]
+}
+
+// before the last node of each level with a value < v, the head if there is none
+func (x *valueIndex) before(v *big.Int) []*indexNode {
+	update := make([]*indexNode, indexMaxLevel)
+	n := &x.head
+	for i := x.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].value.Cmp(v) < 0 {
+			n = n.next[i]
+		}
+		update[i] = n
+	}
+	return update
+}
+
+// low the node of the largest value < v (nil if there is none), and whether v is in the index
+func (x *valueIndex) low(v *big.Int) (*indexNode, bool) {
+	n := x.before(v)[0]
+	found := n.next[0] != nil && n.next[0].value.Cmp(v) == 0
+	if n == &x.head {
+		return nil, found
+	}
+	return n, found
+}
+
+// insert v in the leaf slot, v is not in the index
+func (x *valueIndex) insert(v *big.Int, slot int) {
+	update := x.before(v)
+	level := 1
+	for level < indexMaxLevel && rand.IntN(indexP) == 0 {
+		level++
+	}
+	for i := x.level; i < level; i++ {
+		update[i] = &x.head
+	}
+	if level > x.level {
+		x.level = level
+	}
+	n := &indexNode{value: new(big.Int).Set(v), slot: slot, next: make([]*indexNode, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	x.size++
+}
+
+// delete v, v is in the index
+func (x *valueIndex) delete(v *big.Int) {
+	update := x.before(v)
+	n := update[0].next[0]
+	for i := 0; i < len(n.next); i++ {
+		update[i].next[i] = n.next[i]
+	}
+	for x.level > 1 && x.head.next[x.level-1] == nil {
+		x.level--
+	}
+	x.size--
+}
+
+// nextValue the successor of the value of n, p - 1 for the max
+func (n *indexNode) nextValue() *big.Int {
+	if n.next[0] != nil {
+		return new(big.Int).Set(n.next[0].value)
+	}
+	return maxValue()
+}
+
+// freeSlots the slots of the deleted values, a min-heap: the lowest one is reused first,
+// so the slots only depend on the updates, and a reopened tree reuses them as the tree it was
+type freeSlots []int
+
+func (f freeSlots) Len() int           { return len(f) }
+func (f freeSlots) Less(i, j int) bool { return f[i] < f[j] }
+func (f freeSlots) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+func (f *freeSlots) Push(x any)        { *f = append(*f, x.(int)) }
+
+func (f *freeSlots) Pop() any {
+	old := *f
+	x := old[len(old)-1]
+	*f = old[:len(old)-1]
+	return x
+}
+
+var _ heap.Interface = (*freeSlots)(nil)
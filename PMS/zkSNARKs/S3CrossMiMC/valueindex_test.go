@@ -0,0 +1,62 @@
+package s3cross
+
+import (
+	"container/heap"
+	"math/big"
+	mrand "math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueIndex(t *testing.T) {
+	index := newValueIndex()
+	var sorted []*big.Int
+	slot := make(map[string]int)
+	for i := 0; i < 2000; i++ {
+		v := big.NewInt(mrand.Int63n(500) + 1)
+		pos, found := slices.BinarySearchFunc(sorted, v, (*big.Int).Cmp)
+		low, inIndex := index.low(v)
+		assert.Equal(t, found, inIndex)
+		if pos == 0 {
+			assert.Nil(t, low)
+		} else {
+			assert.Equal(t, sorted[pos-1], low.value)
+			assert.Equal(t, slot[sorted[pos-1].String()], low.slot)
+		}
+		if found {
+			index.delete(v)
+			sorted = slices.Delete(sorted, pos, pos+1)
+			delete(slot, v.String())
+		} else {
+			index.insert(v, i)
+			sorted = slices.Insert(sorted, pos, v)
+			slot[v.String()] = i
+		}
+		assert.Equal(t, len(sorted), index.Len())
+	}
+
+	// the values in order, the last one is followed by p - 1
+	n := index.first()
+	for _, v := range sorted {
+		assert.Equal(t, v, n.value)
+		if n.next[0] == nil {
+			assert.Equal(t, maxValue(), n.nextValue())
+		}
+		n = n.next[0]
+	}
+	assert.Nil(t, n)
+}
+
+func TestFreeSlots(t *testing.T) {
+	var free freeSlots
+	for _, slot := range []int{7, 2, 9, 4} {
+		heap.Push(&free, slot)
+	}
+	var order []int
+	for free.Len() > 0 {
+		order = append(order, heap.Pop(&free).(int))
+	}
+	assert.Equal(t, []int{2, 4, 7, 9}, order)
+}
@@ -0,0 +1,359 @@
+package s3cross
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/frontend"
+)
+
+var ErrInvalidWitnessInput = errors.New("invalid pseudonym witness input")
+
+// PseudonymWitness a S3CrossCircuit assignment with the values derived for it
+type PseudonymWitness struct {
+	Assignment    *S3CrossCircuit
+	HiddenIssuer  *S3CrossHiddenIssuerCircuit // the assignment of a hidden issuer config, the witness is built from it
+	Witness       witness.Witness             // full witness, input of Backend.Prove
+	PublicWitness witness.Witness             // input of Backend.Verify and CreatePseudonym
+	Nonce         *big.Int                    // H(nonce point), public
+	Pseudonym     *KeyPair
+	Ciphertext    *ElGamal // upk encrypted for the supervisor
+}
+
+// NewPseudonymWitness assemble the witness of the pseudonym i of nonce,
+// with the non-membership proof of the credential in the revocation tree leaves (a snapshot, not modified)
+// and the ElGamal encryption of upk under the supervisor key spk
+// cfg: the circuit the proof is for, its hash and credential scheme should be the ones of the credential,
+// with Expiry the credential validity window is proven at the current time
+func (s *S3Cross) NewPseudonymWitness(cfg Config, leaves []*big.Int, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine) (*PseudonymWitness, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Pseudonyms > 0 {
+		return nil, fmt.Errorf("%w: %d pseudonyms is a S3CrossMultiCircuit", ErrInvalidConfig, cfg.Pseudonyms)
+	}
+	if cfg.IssuerDepth > 0 {
+		return nil, fmt.Errorf("%w: a hidden issuer witness is built by NewHiddenIssuerWitness", ErrInvalidConfig)
+	}
+	if cfg.IndexedTree {
+		return nil, fmt.Errorf("%w: an indexed tree witness is built by NewPseudonymWitnessFromTree", ErrInvalidConfig)
+	}
+	pw, err := s.newPseudonymWitness(cfg, s.sortedLeavesProof(leaves), nonce, i, spk)
+	if err != nil {
+		return nil, err
+	}
+	return pw, pw.build(pw.Assignment)
+}
+
+// NewPseudonymWitnessFromTree NewPseudonymWitness for a Config with IndexedTree,
+// with the low leaf path of the credential in the revocation tree (of the circuit depth and hash)
+func (s *S3Cross) NewPseudonymWitnessFromTree(cfg Config, tree *OrderedMerkleTree, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine) (*PseudonymWitness, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Pseudonyms > 0 {
+		return nil, fmt.Errorf("%w: %d pseudonyms is a S3CrossMultiCircuit", ErrInvalidConfig, cfg.Pseudonyms)
+	}
+	if cfg.IssuerDepth > 0 {
+		return nil, fmt.Errorf("%w: a hidden issuer witness is built by NewHiddenIssuerWitnessFromTree", ErrInvalidConfig)
+	}
+	if !cfg.IndexedTree {
+		return nil, fmt.Errorf("%w: the circuit is for sorted leaves, use NewPseudonymWitness", ErrInvalidConfig)
+	}
+	pw, err := s.newPseudonymWitness(cfg, s.treeProof(tree), nonce, i, spk)
+	if err != nil {
+		return nil, err
+	}
+	return pw, pw.build(pw.Assignment)
+}
+
+// NewHiddenIssuerWitness NewPseudonymWitness for a S3CrossHiddenIssuerCircuit (Config.IssuerDepth > 0),
+// the issuer of the credential should be in issuers, a set of the same depth and hash as the circuit
+func (s *S3Cross) NewHiddenIssuerWitness(cfg Config, issuers *IssuerSet, leaves []*big.Int, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine) (*PseudonymWitness, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.IndexedTree {
+		return nil, fmt.Errorf("%w: an indexed tree witness is built by NewHiddenIssuerWitnessFromTree", ErrInvalidConfig)
+	}
+	return s.newHiddenIssuerWitness(cfg, issuers, s.sortedLeavesProof(leaves), nonce, i, spk)
+}
+
+// NewHiddenIssuerWitnessFromTree NewHiddenIssuerWitness for a Config with IndexedTree
+func (s *S3Cross) NewHiddenIssuerWitnessFromTree(cfg Config, issuers *IssuerSet, tree *OrderedMerkleTree, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine) (*PseudonymWitness, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if !cfg.IndexedTree {
+		return nil, fmt.Errorf("%w: the circuit is for sorted leaves, use NewHiddenIssuerWitness", ErrInvalidConfig)
+	}
+	return s.newHiddenIssuerWitness(cfg, issuers, s.treeProof(tree), nonce, i, spk)
+}
+
+func (s *S3Cross) newHiddenIssuerWitness(cfg Config, issuers *IssuerSet, revocation nonMembershipSource, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine) (*PseudonymWitness, error) {
+	if cfg.IssuerDepth <= 0 {
+		return nil, fmt.Errorf("%w: the circuit reveals the issuer, use NewPseudonymWitness", ErrInvalidConfig)
+	}
+	if issuers == nil || issuers.Depth != cfg.IssuerDepth || issuers.Hash.String() != cfg.Hash.String() {
+		return nil, fmt.Errorf("%w: the issuer set is not of the circuit depth and hash", ErrInvalidWitnessInput)
+	}
+	pw, err := s.newPseudonymWitness(cfg, revocation, nonce, i, spk)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := issuers.Proof(s.SPk)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWitnessInput, err)
+	}
+
+	a := pw.Assignment
+	pw.HiddenIssuer = &S3CrossHiddenIssuerCircuit{
+		Root:           a.Root,
+		ProofElements1: a.ProofElements1,
+		ProofIndex1:    a.ProofIndex1,
+		Leaf1:          a.Leaf1,
+		ProofElements2: a.ProofElements2,
+		Leaf2:          a.Leaf2,
+
+		IssuerRoot:  mp.Root,
+		IssuerPath:  make([]frontend.Variable, cfg.IssuerDepth),
+		IssuerIndex: mp.Index,
+
+		IPkX:     a.IPkX,
+		IPkY:     a.IPkY,
+		Sig:      a.Sig,
+		RX:       a.RX,
+		RY:       a.RY,
+		MessageX: a.MessageX,
+		MessageY: a.MessageY,
+
+		PPkX:  a.PPkX,
+		PPkY:  a.PPkY,
+		Nonce: a.Nonce,
+		USk:   a.USk,
+		I:     a.I,
+
+		SPkX: a.SPkX,
+		SPkY: a.SPkY,
+		C1X:  a.C1X,
+		C1Y:  a.C1Y,
+		C2X:  a.C2X,
+		C2Y:  a.C2Y,
+		R:    a.R,
+
+		Validity: a.Validity,
+
+		IndexBits:   cfg.IndexBits,
+		Hash:        cfg.Hash,
+		Credential:  cfg.Credential,
+		IndexedTree: cfg.IndexedTree,
+	}
+	for j := 0; j < cfg.IssuerDepth; j++ {
+		pw.HiddenIssuer.IssuerPath[j] = mp.Proof[j]
+	}
+	return pw, pw.build(pw.HiddenIssuer)
+}
+
+// nonMembership the revocation tree part of an assignment, Leaf1 < H(upk) < Leaf2
+type nonMembership struct {
+	root         []byte
+	index1       int
+	leaf1, leaf2 *big.Int
+	path1, path2 [][]byte // no path2 for an indexed tree
+}
+
+// nonMembershipSource the non-membership proof of the credential for a checked config
+type nonMembershipSource func(cfg Config) (*nonMembership, error)
+
+// sortedLeavesProof the paths of the two leaves around the credential in the sorted leaves (GenNonMemProof)
+func (s *S3Cross) sortedLeavesProof(leaves []*big.Int) nonMembershipSource {
+	return func(cfg Config) (*nonMembership, error) {
+		mp1, mp2, err := s.GenNonMemProofDepth(leaves, cfg.Depth)
+		if errors.Is(err, ErrRevoked) || errors.Is(err, ErrTreeMalformed) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidWitnessInput, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &nonMembership{
+			root:   mp1.Root,
+			index1: mp1.Index,
+			leaf1:  mp1.Leaf,
+			leaf2:  mp2.Leaf,
+			path1:  mp1.Proof,
+			path2:  mp2.Proof,
+		}, nil
+	}
+}
+
+// treeProof the path of the low leaf of the credential in the tree (OrderedMerkleTree.NonMembershipProof)
+func (s *S3Cross) treeProof(tree *OrderedMerkleTree) nonMembershipSource {
+	return func(cfg Config) (*nonMembership, error) {
+		if tree == nil || tree.Depth != cfg.Depth || tree.Hash.String() != cfg.Hash.String() {
+			return nil, fmt.Errorf("%w: the revocation tree is not of the circuit depth and hash", ErrInvalidWitnessInput)
+		}
+		cBytes, err := s.Hash.hashBytes(s.Pk.X.Marshal(), s.Pk.Y.Marshal())
+		if err != nil {
+			return nil, err
+		}
+		mp, err := tree.NonMembershipProof(new(big.Int).SetBytes(cBytes))
+		if errors.Is(err, ErrValueInTree) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidWitnessInput, ErrRevoked)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &nonMembership{
+			root:   mp.Root,
+			index1: mp.Index,
+			leaf1:  mp.Low,
+			leaf2:  mp.Next,
+			path1:  mp.Proof,
+		}, nil
+	}
+}
+
+// newPseudonymWitness the checked input and the S3CrossCircuit assignment, without the witness
+func (s *S3Cross) newPseudonymWitness(cfg Config, revocation nonMembershipSource, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine) (*PseudonymWitness, error) {
+	now := time.Now().Unix()
+	if err := s.checkWitnessInput(cfg, nonce, i, spk, now); err != nil {
+		return nil, err
+	}
+
+	// non-member proof
+	nm, err := revocation(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// pseudonym
+	nc, psu, err := s.NewPseudonym(i, nonce)
+	if err != nil {
+		return nil, err
+	}
+	// elgamal
+	ct, r, err := EncryptElGamal(s.Pk, spk)
+	if err != nil {
+		return nil, err
+	}
+
+	path1, path2 := nonMembershipPaths(cfg)
+	assignment := &S3CrossCircuit{
+		Root:           nm.root,
+		ProofElements1: path1,
+		ProofIndex1:    nm.index1,
+		Leaf1:          nm.leaf1,
+		ProofElements2: path2,
+		Leaf2:          nm.leaf2,
+
+		IPkX:     s.SPk.X,
+		IPkY:     s.SPk.Y,
+		Sig:      s.Sig,
+		RX:       s.R.X,
+		RY:       s.R.Y,
+		MessageX: s.Pk.X,
+		MessageY: s.Pk.Y,
+
+		PPkX:  psu.Pk.X,
+		PPkY:  psu.Pk.Y,
+		Nonce: nc,
+		USk:   s.Sk,
+		I:     i,
+
+		SPkX: spk.X,
+		SPkY: spk.Y,
+		C1X:  ct.C1.X,
+		C1Y:  ct.C1.Y,
+		C2X:  ct.C2.X,
+		C2Y:  ct.C2.Y,
+		R:    r,
+
+		Validity: validityWindows(cfg),
+
+		IndexBits:   cfg.IndexBits,
+		Hash:        cfg.Hash,
+		Credential:  cfg.Credential,
+		IndexedTree: cfg.IndexedTree,
+	}
+	if cfg.Expiry {
+		assignment.Validity[0] = ValidityWindow{
+			CurrentTime: now,
+			NotBefore:   s.Validity.NotBefore,
+			NotAfter:    s.Validity.NotAfter,
+		}
+	}
+	for j := range path1 {
+		path1[j] = nm.path1[j]
+	}
+	for j := range path2 {
+		path2[j] = nm.path2[j]
+	}
+
+	return &PseudonymWitness{
+		Assignment: assignment,
+		Nonce:      nc,
+		Pseudonym:  psu,
+		Ciphertext: ct,
+	}, nil
+}
+
+// build the full and public witness of the assignment
+func (pw *PseudonymWitness) build(assignment frontend.Circuit) error {
+	fullWitness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return errors.New("new pseudonym witness -- " + err.Error())
+	}
+	publicWitness, err := fullWitness.Public()
+	if err != nil {
+		return errors.New("new pseudonym witness -- " + err.Error())
+	}
+	pw.Witness = fullWitness
+	pw.PublicWitness = publicWitness
+	return nil
+}
+
+// checkWitnessInput the checks the circuit would only report as an unsatisfied constraint
+// now: the CurrentTime of a Config with Expiry
+func (s *S3Cross) checkWitnessInput(cfg Config, nonce *twistededwards.PointAffine, i *big.Int, spk *twistededwards.PointAffine, now int64) error {
+	// credential
+	if s == nil || s.KeyPair == nil || s.Signature == nil || s.Sk == nil || s.Pk == nil {
+		return fmt.Errorf("%w: missing credential", ErrInvalidWitnessInput)
+	}
+	if s.Sig == nil || s.R == nil || s.M == nil || s.SPk == nil {
+		return fmt.Errorf("%w: incomplete issuer signature", ErrInvalidWitnessInput)
+	}
+	if s.Hash.String() != cfg.Hash.String() {
+		return fmt.Errorf("%w: credential hash %s, circuit hash %s", ErrInvalidWitnessInput, s.Hash, cfg.Hash)
+	}
+	if s.Scheme.String() != cfg.Credential.String() {
+		return fmt.Errorf("%w: %s credential, circuit for %s", ErrInvalidWitnessInput, s.Scheme, cfg.Credential)
+	}
+	if !s.M.Equal(s.Pk) {
+		return fmt.Errorf("%w: the credential does not sign the user public key", ErrInvalidWitnessInput)
+	}
+	if err := s.Signature.Verify(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWitnessInput, err)
+	}
+	if cfg.Expiry != (s.Validity != nil) {
+		return fmt.Errorf("%w: credential validity window and circuit expiry differ", ErrInvalidWitnessInput)
+	}
+	if err := s.ValidAt(now); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWitnessInput, err)
+	}
+
+	// pseudonym index, 0 < i < 2^IndexBits
+	if i == nil || i.Sign() <= 0 || i.BitLen() > cfg.IndexBits {
+		return fmt.Errorf("%w: index should be in [1, 2^%d - 1]", ErrInvalidWitnessInput, cfg.IndexBits)
+	}
+	if nonce == nil || !nonce.IsOnCurve() {
+		return fmt.Errorf("%w: nonce is not a curve point", ErrInvalidWitnessInput)
+	}
+	if spk == nil || !spk.IsOnCurve() {
+		return fmt.Errorf("%w: supervisor key is not a curve point", ErrInvalidWitnessInput)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package s3cross
+
+import (
+	"crypto/rand"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPseudonymWitness(t *testing.T) {
+	curve := twistededwards.GetEdwardsCurve()
+	cfg := Config{Depth: 10, IndexBits: 4, Hash: HashPoseidon2}
+
+	nonce, err := getRandomPointAffine()
+	assert.NoError(t, err)
+	leaves := []*big.Int{maxValue(), big.NewInt(0)}
+	for i := 0; i < 20; i++ {
+		leaf, err := rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	snapshot := slices.Clone(leaves)
+
+	newKeyPair := func() *KeyPair {
+		sk, err := rand.Int(rand.Reader, &curve.Order)
+		assert.NoError(t, err)
+		return &KeyPair{
+			Sk: sk,
+			Pk: new(twistededwards.PointAffine).ScalarMultiplication(&curve.Base, sk),
+		}
+	}
+	issuer, supervisor, user := newKeyPair(), newKeyPair(), newKeyPair()
+	sig, _, err := issuer.SignWith(cfg.Hash, user.Pk)
+	assert.NoError(t, err)
+	s3cross := &S3Cross{user, sig}
+
+	pw, err := s3cross.NewPseudonymWitness(cfg, leaves, nonce, big.NewInt(5), supervisor.Pk)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot, leaves, "the tree snapshot is not modified")
+
+	circuit, err := NewS3CrossCircuit(cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, test.IsSolved(circuit, pw.Assignment, ecc.BN254.ScalarField()))
+
+	// the derived values are the public ones
+	_, psu, err := s3cross.NewPseudonym(big.NewInt(5), nonce)
+	assert.NoError(t, err)
+	assert.True(t, psu.Pk.Equal(pw.Pseudonym.Pk))
+	values := pw.PublicWitness.Vector().(fr.Vector)
+	assert.Equal(t, psu.Pk.X, values[3])
+	assert.Equal(t, new(fr.Element).SetBigInt(pw.Nonce), &values[5])
+	upk, err := supervisor.Decrypt(pw.Ciphertext)
+	assert.NoError(t, err)
+	assert.True(t, upk.Equal(user.Pk))
+
+	// invalid inputs are reported before the witness is built
+	hUpk, err := cfg.Hash.hashBytes(user.Pk.X.Marshal(), user.Pk.Y.Marshal())
+	assert.NoError(t, err)
+	revoked := new(big.Int).SetBytes(hUpk)
+	otherSig, _, err := issuer.SignWith(cfg.Hash, supervisor.Pk)
+	assert.NoError(t, err)
+	var offCurve twistededwards.PointAffine
+	offCurve.X.SetOne()
+	offCurve.Y.SetOne()
+
+	tests := []struct {
+		name   string
+		s      *S3Cross
+		cfg    Config
+		leaves []*big.Int
+		nonce  *twistededwards.PointAffine
+		i      *big.Int
+		spk    *twistededwards.PointAffine
+	}{
+		{"missing credential", &S3Cross{user, nil}, cfg, leaves, nonce, big.NewInt(5), supervisor.Pk},
+		{"other hash", s3cross, Config{Depth: 10, IndexBits: 4, Hash: HashMiMC}, leaves, nonce, big.NewInt(5), supervisor.Pk},
+		{"other credential scheme", s3cross, Config{Depth: 10, IndexBits: 4, Hash: HashPoseidon2, Credential: SchemeEdDSA}, leaves, nonce, big.NewInt(5), supervisor.Pk},
+		{"signature of another key", &S3Cross{user, otherSig}, cfg, leaves, nonce, big.NewInt(5), supervisor.Pk},
+		{"index 0", s3cross, cfg, leaves, nonce, big.NewInt(0), supervisor.Pk},
+		{"index out of range", s3cross, cfg, leaves, nonce, big.NewInt(16), supervisor.Pk},
+		{"nonce off curve", s3cross, cfg, leaves, &offCurve, big.NewInt(5), supervisor.Pk},
+		{"missing supervisor key", s3cross, cfg, leaves, nonce, big.NewInt(5), nil},
+		{"revoked", s3cross, cfg, append(slices.Clone(leaves), revoked), nonce, big.NewInt(5), supervisor.Pk},
+		{"no boundary leaf", s3cross, cfg, []*big.Int{big.NewInt(0), big.NewInt(1)}, nonce, big.NewInt(5), supervisor.Pk},
+		{"tree too small", s3cross, Config{Depth: 4, IndexBits: 4, Hash: HashPoseidon2}, leaves, nonce, big.NewInt(5), supervisor.Pk},
+	}
+	for _, tt := range tests {
+		_, err := tt.s.NewPseudonymWitness(tt.cfg, tt.leaves, tt.nonce, tt.i, tt.spk)
+		assert.ErrorIs(t, err, ErrInvalidWitnessInput, tt.name)
+	}
+
+	// a S3CrossMultiCircuit config
+	_, err = s3cross.NewPseudonymWitness(Config{Depth: 10, IndexBits: 4, Hash: HashPoseidon2, Pseudonyms: 2}, leaves, nonce, big.NewInt(5), supervisor.Pk)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
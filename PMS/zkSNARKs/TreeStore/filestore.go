@@ -0,0 +1,196 @@
+// TreeStore the file store of the revocation trees, out of S3CrossMiMC so the chaincode does not vendor it
+package treestore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// FileStore a s3cross.TreeStore of an append-only file, each Write is a checksummed record synced to disk,
+// a torn record at the end (a crash during a Write) is dropped when the file is opened again
+// the content is also kept in memory, Compact rewrites the file with only the live keys
+type FileStore struct {
+	mu   sync.RWMutex
+	path string
+	f    *os.File
+	data map[string][]byte
+}
+
+// record: length (4) | crc32 (4) | entries, entry: key length (2) | key | value length (4, tombstone for a delete) | value
+const tombstone = ^uint32(0)
+
+// OpenFileStore open (or create) the store of the file path
+func OpenFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, errors.New("open file store -- " + err.Error())
+	}
+	fs := &FileStore{path: path, f: f, data: make(map[string][]byte)}
+	end, err := fs.replay()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	// drop a torn record
+	if err = f.Truncate(end); err != nil {
+		f.Close()
+		return nil, errors.New("open file store -- " + err.Error())
+	}
+	if _, err = f.Seek(end, io.SeekStart); err != nil {
+		f.Close()
+		return nil, errors.New("open file store -- " + err.Error())
+	}
+	return fs, nil
+}
+
+// replay apply the records of the file, return the end of the last complete one
+func (fs *FileStore) replay() (int64, error) {
+	r := bufio.NewReader(fs.f)
+	var end int64
+	header := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return end, nil
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(header[:4]))
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return end, nil
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			return end, nil
+		}
+		batch, err := decodeBatch(payload)
+		if err != nil {
+			return 0, fmt.Errorf("open file store -- record at %d: %v", end, err)
+		}
+		apply(fs.data, batch)
+		end += int64(len(header) + len(payload))
+	}
+}
+
+func (fs *FileStore) Get(key string) ([]byte, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	return fs.data[key], nil
+}
+
+func (fs *FileStore) Write(batch map[string][]byte) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return errors.New("file store is closed")
+	}
+	if _, err := fs.f.Write(encodeRecord(batch)); err != nil {
+		return errors.New("file store write -- " + err.Error())
+	}
+	if err := fs.f.Sync(); err != nil {
+		return errors.New("file store write -- " + err.Error())
+	}
+	apply(fs.data, batch)
+	return nil
+}
+
+// Compact rewrite the file with one record of the live keys
+func (fs *FileStore) Compact() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return errors.New("file store is closed")
+	}
+	tmp := fs.path + ".compact"
+	if err := os.WriteFile(tmp, encodeRecord(fs.data), 0o644); err != nil {
+		return errors.New("file store compact -- " + err.Error())
+	}
+	if err := os.Rename(tmp, fs.path); err != nil {
+		return errors.New("file store compact -- " + err.Error())
+	}
+	f, err := os.OpenFile(fs.path, os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return errors.New("file store compact -- " + err.Error())
+	}
+	fs.f.Close()
+	fs.f = f
+	return nil
+}
+
+func (fs *FileStore) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return nil
+	}
+	err := fs.f.Close()
+	fs.f = nil
+	return err
+}
+
+func encodeRecord(batch map[string][]byte) []byte {
+	// sorted, the same batch is the same record
+	keys := make([]string, 0, len(batch))
+	for k := range batch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	payload := make([]byte, 0, 64*len(batch))
+	for _, k := range keys {
+		v := batch[k]
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(k)))
+		payload = append(payload, k...)
+		if v == nil {
+			payload = binary.BigEndian.AppendUint32(payload, tombstone)
+			continue
+		}
+		payload = binary.BigEndian.AppendUint32(payload, uint32(len(v)))
+		payload = append(payload, v...)
+	}
+	record := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint32(record[:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:], crc32.ChecksumIEEE(payload))
+	return append(record, payload...)
+}
+
+func decodeBatch(payload []byte) (map[string][]byte, error) {
+	batch := make(map[string][]byte)
+	for len(payload) > 0 {
+		if len(payload) < 2 {
+			return nil, errors.New("truncated key length")
+		}
+		n := int(binary.BigEndian.Uint16(payload))
+		payload = payload[2:]
+		if len(payload) < n+4 {
+			return nil, errors.New("truncated key")
+		}
+		k := string(payload[:n])
+		m := binary.BigEndian.Uint32(payload[n:])
+		payload = payload[n+4:]
+		if m == tombstone {
+			batch[k] = nil
+			continue
+		}
+		if uint32(len(payload)) < m {
+			return nil, errors.New("truncated value")
+		}
+		batch[k] = payload[:m:m]
+		payload = payload[m:]
+	}
+	return batch, nil
+}
+
+// apply the batch to data, a nil value deletes its key
+func apply(data map[string][]byte, batch map[string][]byte) {
+	for k, v := range batch {
+		if v == nil {
+			delete(data, k)
+			continue
+		}
+		data[k] = v
+	}
+}